@@ -2,6 +2,7 @@ package contact
 
 import (
 	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 )
@@ -203,6 +204,70 @@ func TestPhoneNumber_IsZero(t *testing.T) {
 	}
 }
 
+func TestPhoneNumber_GenerateOTP(t *testing.T) {
+	phone := MustParsePhoneNumber("+258841234567")
+
+	t.Run("length and digits", func(t *testing.T) {
+		for length := MinOTPLength; length <= MaxOTPLength; length++ {
+			otp, err := phone.GenerateOTP(length)
+			if err != nil {
+				t.Fatalf("GenerateOTP(%d) error = %v", length, err)
+			}
+			if len(otp) != length {
+				t.Errorf("GenerateOTP(%d) = %q, want %d digits", length, otp, length)
+			}
+			for _, r := range otp {
+				if r < '0' || r > '9' {
+					t.Errorf("GenerateOTP(%d) = %q, contains non-digit %q", length, otp, r)
+				}
+			}
+		}
+	})
+
+	t.Run("invalid length", func(t *testing.T) {
+		for _, length := range []int{-1, 0, MinOTPLength - 1, MaxOTPLength + 1, 20} {
+			if _, err := phone.GenerateOTP(length); !errors.Is(err, ErrInvalidOTPLength) {
+				t.Errorf("GenerateOTP(%d) error = %v, want ErrInvalidOTPLength", length, err)
+			}
+		}
+	})
+
+	t.Run("zero phone number", func(t *testing.T) {
+		var zero PhoneNumber
+		if _, err := zero.GenerateOTP(6); !errors.Is(err, ErrZeroPhoneNumber) {
+			t.Errorf("GenerateOTP() on zero value error = %v, want ErrZeroPhoneNumber", err)
+		}
+	})
+
+	t.Run("uniqueness", func(t *testing.T) {
+		const n = 1000
+		seen := make(map[string]bool, n)
+		var digitCounts [10]int
+		for range n {
+			otp, err := phone.GenerateOTP(6)
+			if err != nil {
+				t.Fatalf("GenerateOTP(6) error = %v", err)
+			}
+			seen[otp] = true
+			for _, r := range otp {
+				digitCounts[r-'0']++
+			}
+		}
+
+		// With 10^6 possible codes, about 0.5 collisions are expected in 1000
+		// draws; more than 10 means the generator is not uniform.
+		if len(seen) < n-10 {
+			t.Errorf("%d unique OTPs in %d generations, want at least %d", len(seen), n, n-10)
+		}
+		// Each digit is expected 600 times in 6000; allow over 6 standard deviations.
+		for d, count := range digitCounts {
+			if count < 450 || count > 750 {
+				t.Errorf("digit %d appeared %d times in %d digits, want about 600", d, count, n*6)
+			}
+		}
+	})
+}
+
 func TestPhoneNumber_JSON(t *testing.T) {
 	t.Run("marshal", func(t *testing.T) {
 		p := MustParsePhoneNumber("841234567")
@@ -522,6 +587,181 @@ func TestEmail_Parts(t *testing.T) {
 	})
 }
 
+func TestEmail_IsSubdomain(t *testing.T) {
+	tests := []struct {
+		email  string
+		parent string
+		want   bool
+	}{
+		{"user@mail.google.com", "google.com", true},
+		{"user@a.b.mail.google.com", "google.com", true},
+		{"user@a.b.mail.google.com", "mail.google.com", true},
+		{"user@mail.google.com", "Google.COM", true},
+		{"user@google.com", "google.com", false},
+		{"user@notgoogle.com", "google.com", false},
+		{"user@mail.google.com", "oogle.com", false},
+		{"user@mail.google.com", "", false},
+		{"user@empresa.co.mz", "co.mz", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.email+" in "+tt.parent, func(t *testing.T) {
+			e := MustParseEmail(tt.email)
+			if got := e.IsSubdomain(tt.parent); got != tt.want {
+				t.Errorf("IsSubdomain(%q) = %v, want %v", tt.parent, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("zero value", func(t *testing.T) {
+		var e Email
+		if e.IsSubdomain("google.com") {
+			t.Error("IsSubdomain() on zero value = true, want false")
+		}
+	})
+}
+
+func TestEmail_RegisteredDomain(t *testing.T) {
+	tests := []struct {
+		email string
+		want  string
+	}{
+		{"user@google.com", "google.com"},
+		{"user@mail.google.com", "google.com"},
+		{"user@a.b.c.mail.google.com", "google.com"},
+		{"user@empresa.co.mz", "empresa.co.mz"},
+		{"user@mail.empresa.co.mz", "empresa.co.mz"},
+		{"user@x.y.bbc.co.uk", "bbc.co.uk"},
+		{"user@uem.mz", "uem.mz"},
+		{"user@co.mz", "co.mz"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.email, func(t *testing.T) {
+			e := MustParseEmail(tt.email)
+			if got := e.RegisteredDomain(); got != tt.want {
+				t.Errorf("RegisteredDomain() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("zero value", func(t *testing.T) {
+		var e Email
+		if got := e.RegisteredDomain(); got != "" {
+			t.Errorf("RegisteredDomain() on zero value = %q, want empty", got)
+		}
+	})
+}
+
+func TestEmail_ToIDN(t *testing.T) {
+	tests := []struct {
+		name    string
+		email   Email
+		want    string
+		wantErr bool
+	}{
+		{"ASCII domain is unchanged", MustParseEmail("user@example.co.mz"), "user@example.co.mz", false},
+		{"punycode domain is unchanged", MustParseEmail("joao@xn--caf-dma.mz"), "joao@xn--caf-dma.mz", false},
+		{"malformed punycode label", MustParseEmail("user@xn--a.mz"), "", true},
+		{"zero value", Email{}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.email.ToIDN()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ToIDN() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidEmail) {
+				t.Errorf("ToIDN() error = %v, want ErrInvalidEmail", err)
+			}
+			if got != tt.want {
+				t.Errorf("ToIDN() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmail_ToUnicode(t *testing.T) {
+	tests := []struct {
+		name    string
+		email   Email
+		want    string
+		wantErr bool
+	}{
+		{"punycode domain", MustParseEmail("user@xn--caf-dma.co.mz"), "user@café.co.mz", false},
+		{"punycode subdomain", MustParseEmail("joao@xn--maputo-praa-v9a.co.mz"), "joao@maputo-praça.co.mz", false},
+		{"ASCII domain is unchanged", MustParseEmail("user@example.co.mz"), "user@example.co.mz", false},
+		{"malformed punycode label", MustParseEmail("user@xn--a.mz"), "", true},
+		{"zero value", Email{}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.email.ToUnicode()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ToUnicode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidEmail) {
+				t.Errorf("ToUnicode() error = %v, want ErrInvalidEmail", err)
+			}
+			if got != tt.want {
+				t.Errorf("ToUnicode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromIDN(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"ASCII domain", "user@example.co.mz", "user@example.co.mz", false},
+		{"accented domain", "joao@café.mz", "joao@xn--caf-dma.mz", false},
+		{"accented uppercase domain", "Joao@CAFÉ.mz", "joao@xn--caf-dma.mz", false},
+		{"with whitespace", "  joao@maputo-praça.co.mz  ", "joao@xn--maputo-praa-v9a.co.mz", false},
+		{"missing at sign", "joao.café.mz", "", true},
+		{"missing local part", "@café.mz", "", true},
+		{"invalid domain", "joao@café..mz", "", true},
+		{"empty", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromIDN(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromIDN(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, ErrInvalidEmail) {
+					t.Errorf("FromIDN(%q) error = %v, want ErrInvalidEmail", tt.input, err)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("FromIDN(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+
+			roundtrip, err := got.ToIDN()
+			if err != nil {
+				t.Fatalf("ToIDN() error = %v", err)
+			}
+			if roundtrip != tt.want {
+				t.Errorf("ToIDN() = %v, want %v", roundtrip, tt.want)
+			}
+
+			unicode, err := got.ToUnicode()
+			if err != nil {
+				t.Fatalf("ToUnicode() error = %v", err)
+			}
+			if back, err := FromIDN(unicode); err != nil || back != got {
+				t.Errorf("FromIDN(ToUnicode()) = %v, %v, want %v", back, err, got)
+			}
+		})
+	}
+}
+
 func TestEmail_IsZero(t *testing.T) {
 	tests := []struct {
 		name  string
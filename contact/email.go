@@ -7,6 +7,9 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
 )
 
 // Email represents a validated email address.
@@ -99,6 +102,86 @@ func (e Email) Domain() string {
 	return ""
 }
 
+// IsSubdomain returns true if the email's domain is a strict subdomain of
+// parent, so "user@mail.google.com" is a subdomain of "google.com" but
+// "user@google.com" is not. The comparison is case-insensitive.
+func (e Email) IsSubdomain(parent string) bool {
+	parent = strings.Trim(strings.ToLower(strings.TrimSpace(parent)), ".")
+	if parent == "" {
+		return false
+	}
+	return strings.HasSuffix(e.Domain(), "."+parent)
+}
+
+// RegisteredDomain returns the domain registered under a public suffix,
+// stripping any subdomains: "user@mail.google.com" and "user@google.com" both
+// return "google.com", and "user@mail.empresa.co.mz" returns "empresa.co.mz".
+// Suffixes come from the Public Suffix List. Returns the domain unchanged if
+// it is itself a public suffix, and "" for the zero value.
+func (e Email) RegisteredDomain() string {
+	domain := e.Domain()
+	if domain == "" {
+		return ""
+	}
+	registered, err := publicsuffix.EffectiveTLDPlusOne(domain)
+	if err != nil {
+		return domain
+	}
+	return registered
+}
+
+// FromIDN parses an email address whose domain may contain non-ASCII
+// characters. The domain is converted to its IDNA ASCII (punycode) form
+// before validation, so "joao@café.mz" is stored as "joao@xn--caf-dma.mz".
+func FromIDN(s string) (Email, error) {
+	trimmed := strings.TrimSpace(s)
+	at := strings.LastIndex(trimmed, "@")
+	if at <= 0 {
+		return Email{}, ErrInvalidEmail
+	}
+
+	domain, err := idna.Lookup.ToASCII(trimmed[at+1:])
+	if err != nil {
+		return Email{}, fmt.Errorf("%w: %w", ErrInvalidEmail, err)
+	}
+
+	return ParseEmail(trimmed[:at] + "@" + domain)
+}
+
+// ToIDN returns the email address with its domain encoded in IDNA ASCII
+// (punycode) form, suitable for SMTP and DNS lookups. Addresses with plain
+// ASCII domains are returned unchanged. Returns an error if the domain is
+// not a valid IDNA name, such as a malformed "xn--" label.
+func (e Email) ToIDN() (string, error) {
+	if e.email == "" {
+		return "", ErrInvalidEmail
+	}
+
+	domain, err := idna.Lookup.ToASCII(e.Domain())
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidEmail, err)
+	}
+
+	return e.LocalPart() + "@" + domain, nil
+}
+
+// ToUnicode returns the email address with its domain decoded from IDNA
+// ASCII (punycode) to Unicode, for display. It is the inverse of FromIDN:
+// "joao@xn--caf-dma.mz" is returned as "joao@café.mz". Returns an error if
+// the domain is not a valid IDNA name.
+func (e Email) ToUnicode() (string, error) {
+	if e.email == "" {
+		return "", ErrInvalidEmail
+	}
+
+	domain, err := idna.Display.ToUnicode(e.Domain())
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidEmail, err)
+	}
+
+	return e.LocalPart() + "@" + domain, nil
+}
+
 // IsZero returns true if the email is empty.
 func (e Email) IsZero() bool {
 	return e.email == ""
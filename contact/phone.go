@@ -2,10 +2,12 @@
 package contact
 
 import (
+	"crypto/rand"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"regexp"
 	"strings"
 )
@@ -30,6 +32,20 @@ var ErrInvalidPhoneNumber = errors.New("invalid phone number")
 // ErrInvalidMobilePrefix is returned when the phone number has an invalid Mozambique mobile prefix.
 var ErrInvalidMobilePrefix = errors.New("invalid Mozambique mobile prefix")
 
+// ErrZeroPhoneNumber is returned when an operation requires a phone number
+// but the receiver is the zero value.
+var ErrZeroPhoneNumber = errors.New("phone number is empty")
+
+// ErrInvalidOTPLength is returned when an OTP length is outside
+// MinOTPLength-MaxOTPLength.
+var ErrInvalidOTPLength = errors.New("invalid OTP length: must be between 4 and 8")
+
+// OTP length limits accepted by GenerateOTP.
+const (
+	MinOTPLength = 4
+	MaxOTPLength = 8
+)
+
 // Operator represents a Mozambique mobile network operator.
 type Operator string
 
@@ -164,6 +180,30 @@ func (p PhoneNumber) IsZero() bool {
 	return p.number == ""
 }
 
+// GenerateOTP generates a cryptographically random numeric one-time
+// password of the given length for verifying the phone number by SMS.
+// Leading zeros are kept, so every code has exactly length digits.
+// Returns ErrZeroPhoneNumber for the zero value and ErrInvalidOTPLength if
+// length is outside MinOTPLength-MaxOTPLength.
+func (p PhoneNumber) GenerateOTP(length int) (string, error) {
+	if p.IsZero() {
+		return "", ErrZeroPhoneNumber
+	}
+	if length < MinOTPLength || length > MaxOTPLength {
+		return "", ErrInvalidOTPLength
+	}
+
+	digits := make([]byte, length)
+	for i := range digits {
+		n, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", fmt.Errorf("failed to generate random number: %w", err)
+		}
+		digits[i] = byte('0' + n.Int64())
+	}
+	return string(digits), nil
+}
+
 // MarshalJSON implements json.Marshaler.
 func (p PhoneNumber) MarshalJSON() ([]byte, error) {
 	return json.Marshal(p.number)
@@ -0,0 +1,149 @@
+package enums
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// consistencyGarbage is a value no enum accepts, used to check that parsing
+// rejects unknown input.
+const consistencyGarbage = "\x00not-a-valid-enum-value"
+
+// CheckEnumConsistency verifies that an enum's declared values and its
+// parse function agree. It is exported for tests, in this package and in
+// packages declaring their own enums:
+//
+//	if err := enums.CheckEnumConsistency(enums.RideStatusValues(), enums.ParseRideStatus); err != nil {
+//		t.Error(err)
+//	}
+//
+// For every value it checks that the value is non-empty and unique, parses
+// to itself, is Valid, and survives JSON, text and SQL round trips. It also
+// checks that parse rejects, and Valid refuses, a garbage value. All problems
+// found are returned joined, each naming the type and value.
+func CheckEnumConsistency[T ~string](values []T, parse func(string) (T, error)) error {
+	typeName := reflect.TypeFor[T]().Name()
+	var errs []error
+	fail := func(v T, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s(%q): %s", typeName, string(v), fmt.Sprintf(format, args...)))
+	}
+
+	if len(values) == 0 {
+		return fmt.Errorf("%s: no values", typeName)
+	}
+
+	seen := make(map[T]bool, len(values))
+	for _, v := range values {
+		if v == "" {
+			fail(v, "empty value listed")
+			continue
+		}
+		if seen[v] {
+			fail(v, "listed more than once")
+		}
+		seen[v] = true
+
+		if parsed, err := parse(string(v)); err != nil {
+			fail(v, "parse failed: %v", err)
+		} else if parsed != v {
+			fail(v, "parses to %q", string(parsed))
+		}
+
+		if validator, ok := any(v).(interface{ Valid() bool }); !ok {
+			fail(v, "missing Valid method")
+		} else if !validator.Valid() {
+			fail(v, "listed but not Valid")
+		}
+
+		if err := checkJSONRoundTrip(v); err != nil {
+			fail(v, "JSON round trip: %v", err)
+		}
+		if err := checkTextRoundTrip(v); err != nil {
+			fail(v, "text round trip: %v", err)
+		}
+		if err := checkSQLRoundTrip(v); err != nil {
+			fail(v, "SQL round trip: %v", err)
+		}
+	}
+
+	garbage := T(consistencyGarbage)
+	if _, err := parse(consistencyGarbage); err == nil {
+		fail(garbage, "parse accepted garbage")
+	}
+	if validator, ok := any(garbage).(interface{ Valid() bool }); ok && validator.Valid() {
+		fail(garbage, "Valid accepted garbage")
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkJSONRoundTrip marshals v to JSON and decodes it back.
+func checkJSONRoundTrip[T ~string](v T) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var decoded T
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	if decoded != v {
+		return fmt.Errorf("decoded as %q", string(decoded))
+	}
+	return nil
+}
+
+// checkTextRoundTrip marshals v as text and decodes it back.
+func checkTextRoundTrip[T ~string](v T) error {
+	marshaler, ok := any(v).(encoding.TextMarshaler)
+	if !ok {
+		return errors.New("missing MarshalText method")
+	}
+	var decoded T
+	unmarshaler, ok := any(&decoded).(encoding.TextUnmarshaler)
+	if !ok {
+		return errors.New("missing UnmarshalText method")
+	}
+
+	data, err := marshaler.MarshalText()
+	if err != nil {
+		return err
+	}
+	if err := unmarshaler.UnmarshalText(data); err != nil {
+		return err
+	}
+	if decoded != v {
+		return fmt.Errorf("decoded as %q", string(decoded))
+	}
+	return nil
+}
+
+// checkSQLRoundTrip stores v with driver.Valuer and reads it back with sql.Scanner.
+func checkSQLRoundTrip[T ~string](v T) error {
+	valuer, ok := any(v).(driver.Valuer)
+	if !ok {
+		return errors.New("missing Value method")
+	}
+	var decoded T
+	scanner, ok := any(&decoded).(sql.Scanner)
+	if !ok {
+		return errors.New("missing Scan method")
+	}
+
+	stored, err := valuer.Value()
+	if err != nil {
+		return err
+	}
+	if err := scanner.Scan(stored); err != nil {
+		return err
+	}
+	if decoded != v {
+		return fmt.Errorf("decoded as %q", string(decoded))
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package enums
+
+// displayNames holds the localized names for a single enum value.
+type displayNames map[Language]string
+
+// name returns the name for lang, falling back to English when no
+// translation exists.
+func (d displayNames) name(lang Language) string {
+	if n, ok := d[lang]; ok && n != "" {
+		return n
+	}
+	return d[LanguageEnglish]
+}
+
+var rideStatusDisplayNames = map[RideStatus]displayNames{
+	RideStatusRequested:       {LanguagePortuguese: "Solicitada", LanguageEnglish: "Requested"},
+	RideStatusSearching:       {LanguagePortuguese: "À procura de motorista", LanguageEnglish: "Searching for driver"},
+	RideStatusDriverAssigned:  {LanguagePortuguese: "Motorista atribuído", LanguageEnglish: "Driver assigned"},
+	RideStatusDriverArriving:  {LanguagePortuguese: "Motorista a caminho", LanguageEnglish: "Driver on the way"},
+	RideStatusWaitingForRider: {LanguagePortuguese: "À espera do passageiro", LanguageEnglish: "Waiting for rider"},
+	RideStatusInProgress:      {LanguagePortuguese: "Em curso", LanguageEnglish: "In progress"},
+	RideStatusCompleted:       {LanguagePortuguese: "Concluída", LanguageEnglish: "Completed"},
+	RideStatusCancelled:       {LanguagePortuguese: "Cancelada", LanguageEnglish: "Cancelled"},
+}
+
+var paymentMethodDisplayNames = map[PaymentMethod]displayNames{
+	PaymentMethodCash:   {LanguagePortuguese: "Dinheiro", LanguageEnglish: "Cash"},
+	PaymentMethodMPesa:  {LanguagePortuguese: "M-Pesa", LanguageEnglish: "M-Pesa"},
+	PaymentMethodCard:   {LanguagePortuguese: "Cartão", LanguageEnglish: "Card"},
+	PaymentMethodWallet: {LanguagePortuguese: "Carteira", LanguageEnglish: "Wallet"},
+}
+
+var serviceTypeDisplayNames = map[ServiceType]displayNames{
+	ServiceTypeStandard: {LanguagePortuguese: "Padrão", LanguageEnglish: "Standard"},
+	ServiceTypeComfort:  {LanguagePortuguese: "Conforto", LanguageEnglish: "Comfort"},
+	ServiceTypePremium:  {LanguagePortuguese: "Premium", LanguageEnglish: "Premium"},
+	ServiceTypeMoto:     {LanguagePortuguese: "Moto", LanguageEnglish: "Moto"},
+}
+
+var cancellationReasonDisplayNames = map[CancellationReason]displayNames{
+	CancellationReasonRiderCancelled:     {LanguagePortuguese: "Cancelada pelo passageiro", LanguageEnglish: "Cancelled by rider"},
+	CancellationReasonDriverCancelled:    {LanguagePortuguese: "Cancelada pelo motorista", LanguageEnglish: "Cancelled by driver"},
+	CancellationReasonNoDriversAvailable: {LanguagePortuguese: "Nenhum motorista disponível", LanguageEnglish: "No drivers available"},
+	CancellationReasonRiderNoShow:        {LanguagePortuguese: "Passageiro não compareceu", LanguageEnglish: "Rider did not show up"},
+	CancellationReasonDriverNoShow:       {LanguagePortuguese: "Motorista não compareceu", LanguageEnglish: "Driver did not show up"},
+	CancellationReasonSafetyConcern:      {LanguagePortuguese: "Questão de segurança", LanguageEnglish: "Safety concern"},
+	CancellationReasonOther:              {LanguagePortuguese: "Outro", LanguageEnglish: "Other"},
+}
+
+// DisplayName returns the user-facing name of the ride status in lang,
+// falling back to English for missing translations. Invalid values are
+// returned as their raw string.
+func (r RideStatus) DisplayName(lang Language) string {
+	if names, ok := rideStatusDisplayNames[r]; ok {
+		return names.name(lang)
+	}
+	return string(r)
+}
+
+// DisplayName returns the user-facing name of the payment method in lang,
+// falling back to English for missing translations. Invalid values are
+// returned as their raw string.
+func (p PaymentMethod) DisplayName(lang Language) string {
+	if names, ok := paymentMethodDisplayNames[p]; ok {
+		return names.name(lang)
+	}
+	return string(p)
+}
+
+// DisplayName returns the user-facing name of the service type in lang,
+// falling back to English for missing translations. Invalid values are
+// returned as their raw string.
+func (s ServiceType) DisplayName(lang Language) string {
+	if names, ok := serviceTypeDisplayNames[s]; ok {
+		return names.name(lang)
+	}
+	return string(s)
+}
+
+// DisplayName returns the user-facing name of the cancellation reason in
+// lang, falling back to English for missing translations. Invalid values are
+// returned as their raw string.
+func (c CancellationReason) DisplayName(lang Language) string {
+	if names, ok := cancellationReasonDisplayNames[c]; ok {
+		return names.name(lang)
+	}
+	return string(c)
+}
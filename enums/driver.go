@@ -2,10 +2,11 @@ package enums
 
 import (
 	"database/sql/driver"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 )
 
 // DriverStatus represents the onboarding/approval status of a driver.
@@ -39,7 +40,7 @@ func ParseDriverStatus(s string) (DriverStatus, error) {
 	case "suspended":
 		return DriverStatusSuspended, nil
 	default:
-		return "", ErrInvalidDriverStatus
+		return "", parseError(ErrInvalidDriverStatus, s)
 	}
 }
 
@@ -59,71 +60,83 @@ func (d DriverStatus) Valid() bool {
 	}
 }
 
+// DriverStatusValues returns all valid DriverStatus values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func DriverStatusValues() []DriverStatus {
+	return []DriverStatus{
+		DriverStatusPending,
+		DriverStatusDocumentsSubmitted,
+		DriverStatusUnderReview,
+		DriverStatusApproved,
+		DriverStatusRejected,
+		DriverStatusSuspended,
+	}
+}
+
+// driverStatusTransitions defines the allowed driver onboarding transitions.
+// Rejected drivers must resubmit documents and go through review again
+// before they can be approved.
+var driverStatusTransitions = map[DriverStatus][]DriverStatus{
+	DriverStatusPending:            {DriverStatusDocumentsSubmitted},
+	DriverStatusDocumentsSubmitted: {DriverStatusUnderReview},
+	DriverStatusUnderReview:        {DriverStatusApproved, DriverStatusRejected},
+	DriverStatusApproved:           {DriverStatusSuspended},
+	DriverStatusRejected:           {DriverStatusDocumentsSubmitted},
+	DriverStatusSuspended:          {DriverStatusApproved},
+}
+
+// CanTransitionTo returns true if a driver may move from d to next
+// in the onboarding flow.
+func (d DriverStatus) CanTransitionTo(next DriverStatus) bool {
+	for _, s := range driverStatusTransitions[d] {
+		if s == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTerminalRejection returns true if the driver has been rejected.
+// A rejected driver can never be approved directly and must resubmit
+// documents to re-enter review.
+func (d DriverStatus) IsTerminalRejection() bool {
+	return d == DriverStatusRejected
+}
+
+// RequiresDocuments returns true if the driver must submit documents
+// before onboarding can continue (pending or rejected).
+func (d DriverStatus) RequiresDocuments() bool {
+	return d == DriverStatusPending || d == DriverStatusRejected
+}
+
 // MarshalJSON implements json.Marshaler.
 func (d DriverStatus) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(d))
+	return marshalEnumJSON(d)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (d *DriverStatus) UnmarshalJSON(data []byte) error {
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
-		return err
-	}
-	parsed, err := ParseDriverStatus(s)
-	if err != nil {
-		return err
-	}
-	*d = parsed
-	return nil
+	return unmarshalEnumJSON(data, d, ParseDriverStatus)
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (d DriverStatus) MarshalText() ([]byte, error) {
-	return []byte(d), nil
+	return marshalEnumText(d)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (d *DriverStatus) UnmarshalText(data []byte) error {
-	parsed, err := ParseDriverStatus(string(data))
-	if err != nil {
-		return err
-	}
-	*d = parsed
-	return nil
+	return unmarshalEnumText(data, d, ParseDriverStatus)
 }
 
 // Scan implements sql.Scanner.
 func (d *DriverStatus) Scan(src interface{}) error {
-	switch v := src.(type) {
-	case string:
-		parsed, err := ParseDriverStatus(v)
-		if err != nil {
-			return err
-		}
-		*d = parsed
-		return nil
-	case []byte:
-		parsed, err := ParseDriverStatus(string(v))
-		if err != nil {
-			return err
-		}
-		*d = parsed
-		return nil
-	case nil:
-		*d = ""
-		return nil
-	default:
-		return fmt.Errorf("cannot scan %T into DriverStatus", src)
-	}
+	return scanEnum(src, d, ParseDriverStatus)
 }
 
 // Value implements driver.Valuer.
 func (d DriverStatus) Value() (driver.Value, error) {
-	if d == "" {
-		return nil, nil
-	}
-	return string(d), nil
+	return enumValue(d)
 }
 
 // AvailabilityStatus represents a driver's availability for rides.
@@ -138,6 +151,10 @@ const (
 // ErrInvalidAvailabilityStatus is returned when parsing an invalid availability status.
 var ErrInvalidAvailabilityStatus = errors.New("invalid availability status")
 
+// ErrInvalidAvailabilityTransition is returned when a driver availability
+// change is not allowed.
+var ErrInvalidAvailabilityTransition = errors.New("invalid availability status transition")
+
 // ParseAvailabilityStatus parses a string into an AvailabilityStatus.
 func ParseAvailabilityStatus(s string) (AvailabilityStatus, error) {
 	switch strings.ToLower(strings.TrimSpace(s)) {
@@ -148,7 +165,7 @@ func ParseAvailabilityStatus(s string) (AvailabilityStatus, error) {
 	case "on_trip":
 		return AvailabilityStatusOnTrip, nil
 	default:
-		return "", ErrInvalidAvailabilityStatus
+		return "", parseError(ErrInvalidAvailabilityStatus, s)
 	}
 }
 
@@ -167,71 +184,84 @@ func (a AvailabilityStatus) Valid() bool {
 	}
 }
 
+// AvailabilityStatusValues returns all valid AvailabilityStatus values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func AvailabilityStatusValues() []AvailabilityStatus {
+	return []AvailabilityStatus{
+		AvailabilityStatusOffline,
+		AvailabilityStatusOnline,
+		AvailabilityStatusOnTrip,
+	}
+}
+
+// availabilityStatusTransitions defines the allowed driver availability
+// changes. A driver on a trip must complete it and come back online before
+// going offline.
+var availabilityStatusTransitions = map[AvailabilityStatus][]AvailabilityStatus{
+	AvailabilityStatusOffline: {AvailabilityStatusOnline},
+	AvailabilityStatusOnline:  {AvailabilityStatusOffline, AvailabilityStatusOnTrip},
+	AvailabilityStatusOnTrip:  {AvailabilityStatusOnline},
+}
+
+// CanTransitionTo returns true if a driver may move from a to next.
+func (a AvailabilityStatus) CanTransitionTo(next AvailabilityStatus) bool {
+	for _, s := range availabilityStatusTransitions[a] {
+		if s == next {
+			return true
+		}
+	}
+	return false
+}
+
+// CanAcceptRide returns true if the driver may be dispatched a new ride.
+// Only online drivers qualify; drivers on a trip are busy.
+func (a AvailabilityStatus) CanAcceptRide() bool {
+	return a == AvailabilityStatusOnline
+}
+
+// IsWorking returns true if the driver is on shift (online or on a trip).
+func (a AvailabilityStatus) IsWorking() bool {
+	return a == AvailabilityStatusOnline || a == AvailabilityStatusOnTrip
+}
+
+// ValidateAvailabilityTransition returns an error wrapping
+// ErrInvalidAvailabilityTransition if a driver may not move from one
+// availability status to another.
+func ValidateAvailabilityTransition(from, to AvailabilityStatus) error {
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %q to %q", ErrInvalidAvailabilityTransition, from, to)
+	}
+	return nil
+}
+
 // MarshalJSON implements json.Marshaler.
 func (a AvailabilityStatus) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(a))
+	return marshalEnumJSON(a)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (a *AvailabilityStatus) UnmarshalJSON(data []byte) error {
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
-		return err
-	}
-	parsed, err := ParseAvailabilityStatus(s)
-	if err != nil {
-		return err
-	}
-	*a = parsed
-	return nil
+	return unmarshalEnumJSON(data, a, ParseAvailabilityStatus)
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (a AvailabilityStatus) MarshalText() ([]byte, error) {
-	return []byte(a), nil
+	return marshalEnumText(a)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (a *AvailabilityStatus) UnmarshalText(data []byte) error {
-	parsed, err := ParseAvailabilityStatus(string(data))
-	if err != nil {
-		return err
-	}
-	*a = parsed
-	return nil
+	return unmarshalEnumText(data, a, ParseAvailabilityStatus)
 }
 
 // Scan implements sql.Scanner.
 func (a *AvailabilityStatus) Scan(src interface{}) error {
-	switch v := src.(type) {
-	case string:
-		parsed, err := ParseAvailabilityStatus(v)
-		if err != nil {
-			return err
-		}
-		*a = parsed
-		return nil
-	case []byte:
-		parsed, err := ParseAvailabilityStatus(string(v))
-		if err != nil {
-			return err
-		}
-		*a = parsed
-		return nil
-	case nil:
-		*a = ""
-		return nil
-	default:
-		return fmt.Errorf("cannot scan %T into AvailabilityStatus", src)
-	}
+	return scanEnum(src, a, ParseAvailabilityStatus)
 }
 
 // Value implements driver.Valuer.
 func (a AvailabilityStatus) Value() (driver.Value, error) {
-	if a == "" {
-		return nil, nil
-	}
-	return string(a), nil
+	return enumValue(a)
 }
 
 // DocumentType represents the type of driver document.
@@ -262,7 +292,7 @@ func ParseDocumentType(s string) (DocumentType, error) {
 	case "id_card":
 		return DocumentTypeIDCard, nil
 	default:
-		return "", ErrInvalidDocumentType
+		return "", parseError(ErrInvalidDocumentType, s)
 	}
 }
 
@@ -282,71 +312,63 @@ func (d DocumentType) Valid() bool {
 	}
 }
 
+// DocumentTypeValues returns all valid DocumentType values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func DocumentTypeValues() []DocumentType {
+	return []DocumentType{
+		DocumentTypeDriversLicense,
+		DocumentTypeVehicleRegistration,
+		DocumentTypeInsurance,
+		DocumentTypeInspectionCertificate,
+		DocumentTypeIDCard,
+	}
+}
+
+// RequiredFor returns true if drivers must provide this document to offer
+// the given service type.
+func (d DocumentType) RequiredFor(s ServiceType) bool {
+	return slices.Contains(serviceTypeMetadata[s].requiredDocuments, d)
+}
+
+// HasExpiry returns true if the document carries an expiry date and must be
+// renewed. Vehicle registrations do not expire.
+func (d DocumentType) HasExpiry() bool {
+	switch d {
+	case DocumentTypeDriversLicense, DocumentTypeInsurance, DocumentTypeInspectionCertificate, DocumentTypeIDCard:
+		return true
+	default:
+		return false
+	}
+}
+
 // MarshalJSON implements json.Marshaler.
 func (d DocumentType) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(d))
+	return marshalEnumJSON(d)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (d *DocumentType) UnmarshalJSON(data []byte) error {
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
-		return err
-	}
-	parsed, err := ParseDocumentType(s)
-	if err != nil {
-		return err
-	}
-	*d = parsed
-	return nil
+	return unmarshalEnumJSON(data, d, ParseDocumentType)
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (d DocumentType) MarshalText() ([]byte, error) {
-	return []byte(d), nil
+	return marshalEnumText(d)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (d *DocumentType) UnmarshalText(data []byte) error {
-	parsed, err := ParseDocumentType(string(data))
-	if err != nil {
-		return err
-	}
-	*d = parsed
-	return nil
+	return unmarshalEnumText(data, d, ParseDocumentType)
 }
 
 // Scan implements sql.Scanner.
 func (d *DocumentType) Scan(src interface{}) error {
-	switch v := src.(type) {
-	case string:
-		parsed, err := ParseDocumentType(v)
-		if err != nil {
-			return err
-		}
-		*d = parsed
-		return nil
-	case []byte:
-		parsed, err := ParseDocumentType(string(v))
-		if err != nil {
-			return err
-		}
-		*d = parsed
-		return nil
-	case nil:
-		*d = ""
-		return nil
-	default:
-		return fmt.Errorf("cannot scan %T into DocumentType", src)
-	}
+	return scanEnum(src, d, ParseDocumentType)
 }
 
 // Value implements driver.Valuer.
 func (d DocumentType) Value() (driver.Value, error) {
-	if d == "" {
-		return nil, nil
-	}
-	return string(d), nil
+	return enumValue(d)
 }
 
 // DocumentStatus represents the verification status of a document.
@@ -374,7 +396,7 @@ func ParseDocumentStatus(s string) (DocumentStatus, error) {
 	case "expired":
 		return DocumentStatusExpired, nil
 	default:
-		return "", ErrInvalidDocumentStatus
+		return "", parseError(ErrInvalidDocumentStatus, s)
 	}
 }
 
@@ -393,71 +415,45 @@ func (d DocumentStatus) Valid() bool {
 	}
 }
 
+// DocumentStatusValues returns all valid DocumentStatus values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func DocumentStatusValues() []DocumentStatus {
+	return []DocumentStatus{
+		DocumentStatusPending,
+		DocumentStatusApproved,
+		DocumentStatusRejected,
+		DocumentStatusExpired,
+	}
+}
+
 // MarshalJSON implements json.Marshaler.
 func (d DocumentStatus) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(d))
+	return marshalEnumJSON(d)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (d *DocumentStatus) UnmarshalJSON(data []byte) error {
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
-		return err
-	}
-	parsed, err := ParseDocumentStatus(s)
-	if err != nil {
-		return err
-	}
-	*d = parsed
-	return nil
+	return unmarshalEnumJSON(data, d, ParseDocumentStatus)
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (d DocumentStatus) MarshalText() ([]byte, error) {
-	return []byte(d), nil
+	return marshalEnumText(d)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (d *DocumentStatus) UnmarshalText(data []byte) error {
-	parsed, err := ParseDocumentStatus(string(data))
-	if err != nil {
-		return err
-	}
-	*d = parsed
-	return nil
+	return unmarshalEnumText(data, d, ParseDocumentStatus)
 }
 
 // Scan implements sql.Scanner.
 func (d *DocumentStatus) Scan(src interface{}) error {
-	switch v := src.(type) {
-	case string:
-		parsed, err := ParseDocumentStatus(v)
-		if err != nil {
-			return err
-		}
-		*d = parsed
-		return nil
-	case []byte:
-		parsed, err := ParseDocumentStatus(string(v))
-		if err != nil {
-			return err
-		}
-		*d = parsed
-		return nil
-	case nil:
-		*d = ""
-		return nil
-	default:
-		return fmt.Errorf("cannot scan %T into DocumentStatus", src)
-	}
+	return scanEnum(src, d, ParseDocumentStatus)
 }
 
 // Value implements driver.Valuer.
 func (d DocumentStatus) Value() (driver.Value, error) {
-	if d == "" {
-		return nil, nil
-	}
-	return string(d), nil
+	return enumValue(d)
 }
 
 // VehicleStatus represents the status of a vehicle.
@@ -485,7 +481,7 @@ func ParseVehicleStatus(s string) (VehicleStatus, error) {
 	case "retired":
 		return VehicleStatusRetired, nil
 	default:
-		return "", ErrInvalidVehicleStatus
+		return "", parseError(ErrInvalidVehicleStatus, s)
 	}
 }
 
@@ -504,69 +500,1061 @@ func (v VehicleStatus) Valid() bool {
 	}
 }
 
+// VehicleStatusValues returns all valid VehicleStatus values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func VehicleStatusValues() []VehicleStatus {
+	return []VehicleStatus{
+		VehicleStatusPending,
+		VehicleStatusActive,
+		VehicleStatusSuspended,
+		VehicleStatusRetired,
+	}
+}
+
 // MarshalJSON implements json.Marshaler.
 func (v VehicleStatus) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(v))
+	return marshalEnumJSON(v)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (v *VehicleStatus) UnmarshalJSON(data []byte) error {
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
-		return err
-	}
-	parsed, err := ParseVehicleStatus(s)
-	if err != nil {
-		return err
-	}
-	*v = parsed
-	return nil
+	return unmarshalEnumJSON(data, v, ParseVehicleStatus)
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (v VehicleStatus) MarshalText() ([]byte, error) {
-	return []byte(v), nil
+	return marshalEnumText(v)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (v *VehicleStatus) UnmarshalText(data []byte) error {
-	parsed, err := ParseVehicleStatus(string(data))
-	if err != nil {
-		return err
-	}
-	*v = parsed
-	return nil
+	return unmarshalEnumText(data, v, ParseVehicleStatus)
 }
 
 // Scan implements sql.Scanner.
 func (v *VehicleStatus) Scan(src interface{}) error {
-	switch val := src.(type) {
-	case string:
-		parsed, err := ParseVehicleStatus(val)
-		if err != nil {
-			return err
-		}
-		*v = parsed
-		return nil
-	case []byte:
-		parsed, err := ParseVehicleStatus(string(val))
-		if err != nil {
-			return err
-		}
-		*v = parsed
-		return nil
-	case nil:
-		*v = ""
-		return nil
+	return scanEnum(src, v, ParseVehicleStatus)
+}
+
+// Value implements driver.Valuer.
+func (v VehicleStatus) Value() (driver.Value, error) {
+	return enumValue(v)
+}
+
+// BiometricType represents a biometric method used for driver identity verification.
+type BiometricType string
+
+const (
+	BiometricTypeFingerprint BiometricType = "fingerprint"
+	BiometricTypeFaceID      BiometricType = "face_id"
+	BiometricTypeIris        BiometricType = "iris"
+	BiometricTypeNone        BiometricType = "none"
+)
+
+// ErrInvalidBiometricType is returned when parsing an invalid biometric type.
+var ErrInvalidBiometricType = errors.New("invalid biometric type")
+
+// ParseBiometricType parses a string into a BiometricType.
+func ParseBiometricType(s string) (BiometricType, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "fingerprint":
+		return BiometricTypeFingerprint, nil
+	case "face_id":
+		return BiometricTypeFaceID, nil
+	case "iris":
+		return BiometricTypeIris, nil
+	case "none":
+		return BiometricTypeNone, nil
+	default:
+		return "", parseError(ErrInvalidBiometricType, s)
+	}
+}
+
+// String returns the string representation.
+func (b BiometricType) String() string {
+	return string(b)
+}
+
+// Valid returns true if the BiometricType is valid.
+func (b BiometricType) Valid() bool {
+	switch b {
+	case BiometricTypeFingerprint, BiometricTypeFaceID, BiometricTypeIris, BiometricTypeNone:
+		return true
+	default:
+		return false
+	}
+}
+
+// BiometricTypeValues returns all valid BiometricType values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func BiometricTypeValues() []BiometricType {
+	return []BiometricType{
+		BiometricTypeFingerprint,
+		BiometricTypeFaceID,
+		BiometricTypeIris,
+		BiometricTypeNone,
+	}
+}
+
+// IsSupported returns true if the BiometricType is a usable biometric method.
+// Returns false for BiometricTypeNone and invalid values.
+func (b BiometricType) IsSupported() bool {
+	return b.Valid() && b != BiometricTypeNone
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b BiometricType) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(b)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BiometricType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, b, ParseBiometricType)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (b BiometricType) MarshalText() ([]byte, error) {
+	return marshalEnumText(b)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (b *BiometricType) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, b, ParseBiometricType)
+}
+
+// Scan implements sql.Scanner.
+func (b *BiometricType) Scan(src interface{}) error {
+	return scanEnum(src, b, ParseBiometricType)
+}
+
+// Value implements driver.Valuer.
+func (b BiometricType) Value() (driver.Value, error) {
+	return enumValue(b)
+}
+
+// VehicleType represents the body type of a vehicle.
+type VehicleType string
+
+const (
+	VehicleTypeSedan      VehicleType = "sedan"
+	VehicleTypeHatchback  VehicleType = "hatchback"
+	VehicleTypeSUV        VehicleType = "suv"
+	VehicleTypeMinivan    VehicleType = "minivan"
+	VehicleTypePickup     VehicleType = "pickup"
+	VehicleTypeMotorcycle VehicleType = "motorcycle"
+	VehicleTypeTukTuk     VehicleType = "tuk_tuk"
+)
+
+// ErrInvalidVehicleType is returned when parsing an invalid vehicle type.
+var ErrInvalidVehicleType = errors.New("invalid vehicle type")
+
+// ParseVehicleType parses a string into a VehicleType.
+// The alias "motorbike" is accepted for VehicleTypeMotorcycle.
+func ParseVehicleType(s string) (VehicleType, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "sedan":
+		return VehicleTypeSedan, nil
+	case "hatchback":
+		return VehicleTypeHatchback, nil
+	case "suv":
+		return VehicleTypeSUV, nil
+	case "minivan":
+		return VehicleTypeMinivan, nil
+	case "pickup":
+		return VehicleTypePickup, nil
+	case "motorcycle", "motorbike":
+		return VehicleTypeMotorcycle, nil
+	case "tuk_tuk":
+		return VehicleTypeTukTuk, nil
+	default:
+		return "", parseError(ErrInvalidVehicleType, s)
+	}
+}
+
+// String returns the string representation.
+func (v VehicleType) String() string {
+	return string(v)
+}
+
+// Valid returns true if the VehicleType is valid.
+func (v VehicleType) Valid() bool {
+	switch v {
+	case VehicleTypeSedan, VehicleTypeHatchback, VehicleTypeSUV, VehicleTypeMinivan,
+		VehicleTypePickup, VehicleTypeMotorcycle, VehicleTypeTukTuk:
+		return true
 	default:
-		return fmt.Errorf("cannot scan %T into VehicleStatus", src)
+		return false
+	}
+}
+
+// VehicleTypeValues returns all valid VehicleType values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func VehicleTypeValues() []VehicleType {
+	return []VehicleType{
+		VehicleTypeSedan,
+		VehicleTypeHatchback,
+		VehicleTypeSUV,
+		VehicleTypeMinivan,
+		VehicleTypePickup,
+		VehicleTypeMotorcycle,
+		VehicleTypeTukTuk,
 	}
 }
 
+// MarshalJSON implements json.Marshaler.
+func (v VehicleType) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *VehicleType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, v, ParseVehicleType)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v VehicleType) MarshalText() ([]byte, error) {
+	return marshalEnumText(v)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *VehicleType) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, v, ParseVehicleType)
+}
+
+// Scan implements sql.Scanner.
+func (v *VehicleType) Scan(src interface{}) error {
+	return scanEnum(src, v, ParseVehicleType)
+}
+
 // Value implements driver.Valuer.
-func (v VehicleStatus) Value() (driver.Value, error) {
-	if v == "" {
-		return nil, nil
+func (v VehicleType) Value() (driver.Value, error) {
+	return enumValue(v)
+}
+
+// VehicleColor represents the exterior color of a vehicle.
+type VehicleColor string
+
+const (
+	VehicleColorWhite  VehicleColor = "white"
+	VehicleColorBlack  VehicleColor = "black"
+	VehicleColorSilver VehicleColor = "silver"
+	VehicleColorGrey   VehicleColor = "grey"
+	VehicleColorRed    VehicleColor = "red"
+	VehicleColorBlue   VehicleColor = "blue"
+	VehicleColorGreen  VehicleColor = "green"
+	VehicleColorYellow VehicleColor = "yellow"
+	VehicleColorOrange VehicleColor = "orange"
+	VehicleColorBrown  VehicleColor = "brown"
+	VehicleColorPurple VehicleColor = "purple"
+	VehicleColorOther  VehicleColor = "other"
+)
+
+// ErrInvalidVehicleColor is returned when parsing an invalid vehicle color.
+var ErrInvalidVehicleColor = errors.New("invalid vehicle color")
+
+// ParseVehicleColor parses a string into a VehicleColor.
+// The spelling "gray" is accepted for VehicleColorGrey.
+func ParseVehicleColor(s string) (VehicleColor, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "white":
+		return VehicleColorWhite, nil
+	case "black":
+		return VehicleColorBlack, nil
+	case "silver":
+		return VehicleColorSilver, nil
+	case "grey", "gray":
+		return VehicleColorGrey, nil
+	case "red":
+		return VehicleColorRed, nil
+	case "blue":
+		return VehicleColorBlue, nil
+	case "green":
+		return VehicleColorGreen, nil
+	case "yellow":
+		return VehicleColorYellow, nil
+	case "orange":
+		return VehicleColorOrange, nil
+	case "brown":
+		return VehicleColorBrown, nil
+	case "purple":
+		return VehicleColorPurple, nil
+	case "other":
+		return VehicleColorOther, nil
+	default:
+		return "", parseError(ErrInvalidVehicleColor, s)
+	}
+}
+
+// String returns the string representation.
+func (v VehicleColor) String() string {
+	return string(v)
+}
+
+// Valid returns true if the VehicleColor is valid.
+func (v VehicleColor) Valid() bool {
+	switch v {
+	case VehicleColorWhite, VehicleColorBlack, VehicleColorSilver, VehicleColorGrey, VehicleColorRed,
+		VehicleColorBlue, VehicleColorGreen, VehicleColorYellow, VehicleColorOrange, VehicleColorBrown,
+		VehicleColorPurple, VehicleColorOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// VehicleColorValues returns all valid VehicleColor values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func VehicleColorValues() []VehicleColor {
+	return []VehicleColor{
+		VehicleColorWhite,
+		VehicleColorBlack,
+		VehicleColorSilver,
+		VehicleColorGrey,
+		VehicleColorRed,
+		VehicleColorBlue,
+		VehicleColorGreen,
+		VehicleColorYellow,
+		VehicleColorOrange,
+		VehicleColorBrown,
+		VehicleColorPurple,
+		VehicleColorOther,
+	}
+}
+
+// HexCode returns an approximate hex color (e.g. "#FFFFFF") for display.
+// Returns an empty string for VehicleColorOther and invalid values.
+func (v VehicleColor) HexCode() string {
+	switch v {
+	case VehicleColorWhite:
+		return "#FFFFFF"
+	case VehicleColorBlack:
+		return "#000000"
+	case VehicleColorSilver:
+		return "#C0C0C0"
+	case VehicleColorGrey:
+		return "#808080"
+	case VehicleColorRed:
+		return "#FF0000"
+	case VehicleColorBlue:
+		return "#0000FF"
+	case VehicleColorGreen:
+		return "#008000"
+	case VehicleColorYellow:
+		return "#FFFF00"
+	case VehicleColorOrange:
+		return "#FFA500"
+	case VehicleColorBrown:
+		return "#8B4513"
+	case VehicleColorPurple:
+		return "#800080"
+	default:
+		return ""
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v VehicleColor) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *VehicleColor) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, v, ParseVehicleColor)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v VehicleColor) MarshalText() ([]byte, error) {
+	return marshalEnumText(v)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *VehicleColor) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, v, ParseVehicleColor)
+}
+
+// Scan implements sql.Scanner.
+func (v *VehicleColor) Scan(src interface{}) error {
+	return scanEnum(src, v, ParseVehicleColor)
+}
+
+// Value implements driver.Valuer.
+func (v VehicleColor) Value() (driver.Value, error) {
+	return enumValue(v)
+}
+
+// ExpiryReason represents why a document expired.
+type ExpiryReason string
+
+const (
+	ExpiryReasonTimeElapsed ExpiryReason = "time_elapsed"
+	ExpiryReasonRevoked     ExpiryReason = "revoked"
+	ExpiryReasonSuperseded  ExpiryReason = "superseded"
+	ExpiryReasonVoluntary   ExpiryReason = "voluntary"
+)
+
+// ErrInvalidExpiryReason is returned when parsing an invalid expiry reason.
+var ErrInvalidExpiryReason = errors.New("invalid expiry reason")
+
+// ParseExpiryReason parses a string into a ExpiryReason.
+func ParseExpiryReason(s string) (ExpiryReason, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "time_elapsed":
+		return ExpiryReasonTimeElapsed, nil
+	case "revoked":
+		return ExpiryReasonRevoked, nil
+	case "superseded":
+		return ExpiryReasonSuperseded, nil
+	case "voluntary":
+		return ExpiryReasonVoluntary, nil
+	default:
+		return "", parseError(ErrInvalidExpiryReason, s)
+	}
+}
+
+// String returns the string representation.
+func (e ExpiryReason) String() string {
+	return string(e)
+}
+
+// Valid returns true if the ExpiryReason is valid.
+func (e ExpiryReason) Valid() bool {
+	switch e {
+	case ExpiryReasonTimeElapsed, ExpiryReasonRevoked, ExpiryReasonSuperseded, ExpiryReasonVoluntary:
+		return true
+	default:
+		return false
+	}
+}
+
+// ExpiryReasonValues returns all valid ExpiryReason values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func ExpiryReasonValues() []ExpiryReason {
+	return []ExpiryReason{
+		ExpiryReasonTimeElapsed,
+		ExpiryReasonRevoked,
+		ExpiryReasonSuperseded,
+		ExpiryReasonVoluntary,
+	}
+}
+
+// IsAdministrative returns true if the document was expired by an
+// administrative action (revoked or superseded) rather than by time or
+// at the driver's request.
+func (e ExpiryReason) IsAdministrative() bool {
+	switch e {
+	case ExpiryReasonRevoked, ExpiryReasonSuperseded:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e ExpiryReason) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *ExpiryReason) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, e, ParseExpiryReason)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (e ExpiryReason) MarshalText() ([]byte, error) {
+	return marshalEnumText(e)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *ExpiryReason) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, e, ParseExpiryReason)
+}
+
+// Scan implements sql.Scanner.
+func (e *ExpiryReason) Scan(src interface{}) error {
+	return scanEnum(src, e, ParseExpiryReason)
+}
+
+// Value implements driver.Valuer.
+func (e ExpiryReason) Value() (driver.Value, error) {
+	return enumValue(e)
+}
+
+// FuelType represents the fuel or energy source of a vehicle.
+type FuelType string
+
+const (
+	FuelTypePetrol   FuelType = "petrol"
+	FuelTypeDiesel   FuelType = "diesel"
+	FuelTypeElectric FuelType = "electric"
+	FuelTypeHybrid   FuelType = "hybrid"
+	FuelTypeLPG      FuelType = "lpg"
+)
+
+// ErrInvalidFuelType is returned when parsing an invalid fuel type.
+var ErrInvalidFuelType = errors.New("invalid fuel type")
+
+// ParseFuelType parses a string into a FuelType.
+func ParseFuelType(s string) (FuelType, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "petrol", "gasoline":
+		return FuelTypePetrol, nil
+	case "diesel":
+		return FuelTypeDiesel, nil
+	case "electric":
+		return FuelTypeElectric, nil
+	case "hybrid":
+		return FuelTypeHybrid, nil
+	case "lpg":
+		return FuelTypeLPG, nil
+	default:
+		return "", parseError(ErrInvalidFuelType, s)
+	}
+}
+
+// String returns the string representation.
+func (f FuelType) String() string {
+	return string(f)
+}
+
+// Valid returns true if the FuelType is valid.
+func (f FuelType) Valid() bool {
+	switch f {
+	case FuelTypePetrol, FuelTypeDiesel, FuelTypeElectric, FuelTypeHybrid, FuelTypeLPG:
+		return true
+	default:
+		return false
+	}
+}
+
+// FuelTypeValues returns all valid FuelType values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func FuelTypeValues() []FuelType {
+	return []FuelType{
+		FuelTypePetrol,
+		FuelTypeDiesel,
+		FuelTypeElectric,
+		FuelTypeHybrid,
+		FuelTypeLPG,
+	}
+}
+
+// IsElectric returns true if the vehicle has an electric drivetrain
+// (electric or hybrid).
+func (f FuelType) IsElectric() bool {
+	return f == FuelTypeElectric || f == FuelTypeHybrid
+}
+
+// IsLowEmission returns true if the vehicle qualifies as low emission
+// (electric, hybrid, or lpg).
+func (f FuelType) IsLowEmission() bool {
+	switch f {
+	case FuelTypeElectric, FuelTypeHybrid, FuelTypeLPG:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f FuelType) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(f)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *FuelType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, f, ParseFuelType)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (f FuelType) MarshalText() ([]byte, error) {
+	return marshalEnumText(f)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (f *FuelType) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, f, ParseFuelType)
+}
+
+// Scan implements sql.Scanner.
+func (f *FuelType) Scan(src interface{}) error {
+	return scanEnum(src, f, ParseFuelType)
+}
+
+// Value implements driver.Valuer.
+func (f FuelType) Value() (driver.Value, error) {
+	return enumValue(f)
+}
+
+// DriverVerificationStep represents a stage of driver onboarding.
+type DriverVerificationStep string
+
+const (
+	DriverVerificationStepProfile         DriverVerificationStep = "profile"
+	DriverVerificationStepDocuments       DriverVerificationStep = "documents"
+	DriverVerificationStepVehicle         DriverVerificationStep = "vehicle"
+	DriverVerificationStepBackgroundCheck DriverVerificationStep = "background_check"
+	DriverVerificationStepTraining        DriverVerificationStep = "training"
+	DriverVerificationStepActivation      DriverVerificationStep = "activation"
+)
+
+// ErrInvalidDriverVerificationStep is returned when parsing an invalid driver verification step.
+var ErrInvalidDriverVerificationStep = errors.New("invalid driver verification step")
+
+// ParseDriverVerificationStep parses a string into a DriverVerificationStep.
+func ParseDriverVerificationStep(s string) (DriverVerificationStep, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "profile":
+		return DriverVerificationStepProfile, nil
+	case "documents":
+		return DriverVerificationStepDocuments, nil
+	case "vehicle":
+		return DriverVerificationStepVehicle, nil
+	case "background_check":
+		return DriverVerificationStepBackgroundCheck, nil
+	case "training":
+		return DriverVerificationStepTraining, nil
+	case "activation":
+		return DriverVerificationStepActivation, nil
+	default:
+		return "", parseError(ErrInvalidDriverVerificationStep, s)
+	}
+}
+
+// String returns the string representation.
+func (d DriverVerificationStep) String() string {
+	return string(d)
+}
+
+// Valid returns true if the DriverVerificationStep is valid.
+func (d DriverVerificationStep) Valid() bool {
+	switch d {
+	case DriverVerificationStepProfile, DriverVerificationStepDocuments,
+		DriverVerificationStepVehicle, DriverVerificationStepBackgroundCheck,
+		DriverVerificationStepTraining, DriverVerificationStepActivation:
+		return true
+	default:
+		return false
+	}
+}
+
+// DriverVerificationStepValues returns all valid DriverVerificationStep values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func DriverVerificationStepValues() []DriverVerificationStep {
+	return []DriverVerificationStep{
+		DriverVerificationStepProfile,
+		DriverVerificationStepDocuments,
+		DriverVerificationStepVehicle,
+		DriverVerificationStepBackgroundCheck,
+		DriverVerificationStepTraining,
+		DriverVerificationStepActivation,
+	}
+}
+
+// Order returns the 1-based position of the step in the onboarding
+// checklist. Returns 0 for invalid steps.
+func (d DriverVerificationStep) Order() int {
+	return slices.Index(DriverVerificationStepValues(), d) + 1
+}
+
+// Next returns the step that follows d in the full onboarding checklist.
+// It is service-agnostic and always includes the vehicle step; use NextFor
+// to follow the checklist of a specific service type.
+// Returns false for the final step and for invalid steps.
+func (d DriverVerificationStep) Next() (DriverVerificationStep, bool) {
+	return nextStep(DriverVerificationStepValues(), d)
+}
+
+// NextFor returns the step that follows d in StepsForServiceType(s), so the
+// vehicle step is skipped when the service does not require an inspection
+// certificate (see DocumentType.RequiredFor). Returns false for the final
+// step, for steps not in the service's checklist and for invalid steps or
+// service types.
+func (d DriverVerificationStep) NextFor(s ServiceType) (DriverVerificationStep, bool) {
+	return nextStep(StepsForServiceType(s), d)
+}
+
+// nextStep returns the step after d in steps.
+func nextStep(steps []DriverVerificationStep, d DriverVerificationStep) (DriverVerificationStep, bool) {
+	i := slices.Index(steps, d)
+	if i < 0 || i == len(steps)-1 {
+		return "", false
+	}
+	return steps[i+1], true
+}
+
+// StepsForServiceType returns the onboarding steps a driver must complete to
+// offer the service type, in Order. The vehicle step covers the vehicle
+// inspection and is skipped for services that do not require an inspection
+// certificate, such as moto. Returns an empty slice for invalid service types.
+func StepsForServiceType(s ServiceType) []DriverVerificationStep {
+	if !s.Valid() {
+		return []DriverVerificationStep{}
+	}
+	steps := DriverVerificationStepValues()
+	if !DocumentTypeInspectionCertificate.RequiredFor(s) {
+		steps = slices.DeleteFunc(steps, func(step DriverVerificationStep) bool {
+			return step == DriverVerificationStepVehicle
+		})
+	}
+	return steps
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d DriverVerificationStep) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(d)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DriverVerificationStep) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, d, ParseDriverVerificationStep)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d DriverVerificationStep) MarshalText() ([]byte, error) {
+	return marshalEnumText(d)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *DriverVerificationStep) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, d, ParseDriverVerificationStep)
+}
+
+// Scan implements sql.Scanner.
+func (d *DriverVerificationStep) Scan(src interface{}) error {
+	return scanEnum(src, d, ParseDriverVerificationStep)
+}
+
+// Value implements driver.Valuer.
+func (d DriverVerificationStep) Value() (driver.Value, error) {
+	return enumValue(d)
+}
+
+// FleetOwnershipType represents who owns the vehicle a driver operates.
+type FleetOwnershipType string
+
+const (
+	FleetOwnershipTypeOwnerOperated FleetOwnershipType = "owner_operated"
+	FleetOwnershipTypeCompanyFleet  FleetOwnershipType = "company_fleet"
+	FleetOwnershipTypeRental        FleetOwnershipType = "rental"
+	FleetOwnershipTypeFranchise     FleetOwnershipType = "franchise"
+)
+
+// ErrInvalidFleetOwnershipType is returned when parsing an invalid fleet ownership type.
+var ErrInvalidFleetOwnershipType = errors.New("invalid fleet ownership type")
+
+// ParseFleetOwnershipType parses a string into a FleetOwnershipType.
+func ParseFleetOwnershipType(s string) (FleetOwnershipType, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "owner_operated":
+		return FleetOwnershipTypeOwnerOperated, nil
+	case "company_fleet":
+		return FleetOwnershipTypeCompanyFleet, nil
+	case "rental":
+		return FleetOwnershipTypeRental, nil
+	case "franchise":
+		return FleetOwnershipTypeFranchise, nil
+	default:
+		return "", parseError(ErrInvalidFleetOwnershipType, s)
+	}
+}
+
+// String returns the string representation.
+func (f FleetOwnershipType) String() string {
+	return string(f)
+}
+
+// Valid returns true if the FleetOwnershipType is valid.
+func (f FleetOwnershipType) Valid() bool {
+	switch f {
+	case FleetOwnershipTypeOwnerOperated, FleetOwnershipTypeCompanyFleet, FleetOwnershipTypeRental,
+		FleetOwnershipTypeFranchise:
+		return true
+	default:
+		return false
+	}
+}
+
+// FleetOwnershipTypeValues returns all valid FleetOwnershipType values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func FleetOwnershipTypeValues() []FleetOwnershipType {
+	return []FleetOwnershipType{
+		FleetOwnershipTypeOwnerOperated,
+		FleetOwnershipTypeCompanyFleet,
+		FleetOwnershipTypeRental,
+		FleetOwnershipTypeFranchise,
+	}
+}
+
+// IsEmployerLiable returns true if a company rather than the driver carries
+// insurance liability for the vehicle (company_fleet and franchise).
+func (f FleetOwnershipType) IsEmployerLiable() bool {
+	return f == FleetOwnershipTypeCompanyFleet || f == FleetOwnershipTypeFranchise
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f FleetOwnershipType) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(f)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *FleetOwnershipType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, f, ParseFleetOwnershipType)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (f FleetOwnershipType) MarshalText() ([]byte, error) {
+	return marshalEnumText(f)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (f *FleetOwnershipType) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, f, ParseFleetOwnershipType)
+}
+
+// Scan implements sql.Scanner.
+func (f *FleetOwnershipType) Scan(src interface{}) error {
+	return scanEnum(src, f, ParseFleetOwnershipType)
+}
+
+// Value implements driver.Valuer.
+func (f FleetOwnershipType) Value() (driver.Value, error) {
+	return enumValue(f)
+}
+
+// BackgroundCheckStatus represents the result of a driver background check run by
+// a third-party provider. Provider-specific results are mapped onto these values.
+type BackgroundCheckStatus string
+
+const (
+	BackgroundCheckStatusNotStarted BackgroundCheckStatus = "not_started"
+	BackgroundCheckStatusSubmitted  BackgroundCheckStatus = "submitted"
+	BackgroundCheckStatusInProgress BackgroundCheckStatus = "in_progress"
+	BackgroundCheckStatusClear      BackgroundCheckStatus = "clear"
+	BackgroundCheckStatusConsider   BackgroundCheckStatus = "consider"
+	BackgroundCheckStatusFailed     BackgroundCheckStatus = "failed"
+	BackgroundCheckStatusExpired    BackgroundCheckStatus = "expired"
+)
+
+// ErrInvalidBackgroundCheckStatus is returned when parsing an invalid background check status.
+var ErrInvalidBackgroundCheckStatus = errors.New("invalid background check status")
+
+// ParseBackgroundCheckStatus parses a string into a BackgroundCheckStatus.
+func ParseBackgroundCheckStatus(s string) (BackgroundCheckStatus, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "not_started":
+		return BackgroundCheckStatusNotStarted, nil
+	case "submitted":
+		return BackgroundCheckStatusSubmitted, nil
+	case "in_progress":
+		return BackgroundCheckStatusInProgress, nil
+	case "clear":
+		return BackgroundCheckStatusClear, nil
+	case "consider", "adverse":
+		return BackgroundCheckStatusConsider, nil
+	case "failed":
+		return BackgroundCheckStatusFailed, nil
+	case "expired":
+		return BackgroundCheckStatusExpired, nil
+	default:
+		return "", parseError(ErrInvalidBackgroundCheckStatus, s)
+	}
+}
+
+// String returns the string representation.
+func (b BackgroundCheckStatus) String() string {
+	return string(b)
+}
+
+// Valid returns true if the BackgroundCheckStatus is valid.
+func (b BackgroundCheckStatus) Valid() bool {
+	switch b {
+	case BackgroundCheckStatusNotStarted, BackgroundCheckStatusSubmitted,
+		BackgroundCheckStatusInProgress, BackgroundCheckStatusClear, BackgroundCheckStatusConsider,
+		BackgroundCheckStatusFailed, BackgroundCheckStatusExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+// BackgroundCheckStatusValues returns all valid BackgroundCheckStatus values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func BackgroundCheckStatusValues() []BackgroundCheckStatus {
+	return []BackgroundCheckStatus{
+		BackgroundCheckStatusNotStarted,
+		BackgroundCheckStatusSubmitted,
+		BackgroundCheckStatusInProgress,
+		BackgroundCheckStatusClear,
+		BackgroundCheckStatusConsider,
+		BackgroundCheckStatusFailed,
+		BackgroundCheckStatusExpired,
+	}
+}
+
+// AllowsActivation returns true if the check permits activating the driver
+// (clear only). A consider result needs manual review first.
+func (b BackgroundCheckStatus) AllowsActivation() bool {
+	return b == BackgroundCheckStatusClear
+}
+
+// NeedsRenewal returns true if a new background check should be run.
+// Expired checks always need renewal. Completed checks (clear or consider)
+// need renewal once completedAt is more than olderThan in the past, or if
+// completedAt is unknown. Checks that were never completed, or failed,
+// return false.
+func (b BackgroundCheckStatus) NeedsRenewal(olderThan time.Duration, completedAt time.Time) bool {
+	switch b {
+	case BackgroundCheckStatusExpired:
+		return true
+	case BackgroundCheckStatusClear, BackgroundCheckStatusConsider:
+		return completedAt.IsZero() || time.Since(completedAt) > olderThan
+	default:
+		return false
 	}
-	return string(v), nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b BackgroundCheckStatus) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(b)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BackgroundCheckStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, b, ParseBackgroundCheckStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (b BackgroundCheckStatus) MarshalText() ([]byte, error) {
+	return marshalEnumText(b)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (b *BackgroundCheckStatus) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, b, ParseBackgroundCheckStatus)
+}
+
+// Scan implements sql.Scanner.
+func (b *BackgroundCheckStatus) Scan(src interface{}) error {
+	return scanEnum(src, b, ParseBackgroundCheckStatus)
+}
+
+// Value implements driver.Valuer.
+func (b BackgroundCheckStatus) Value() (driver.Value, error) {
+	return enumValue(b)
+}
+
+// OnboardingStep tracks how far a driver has progressed through onboarding.
+// Steps are declared in workflow order.
+type OnboardingStep string
+
+const (
+	OnboardingStepProfile         OnboardingStep = "profile"
+	OnboardingStepPhoto           OnboardingStep = "photo"
+	OnboardingStepDocuments       OnboardingStep = "documents"
+	OnboardingStepVehicle         OnboardingStep = "vehicle"
+	OnboardingStepBackgroundCheck OnboardingStep = "background_check"
+	OnboardingStepTraining        OnboardingStep = "training"
+	OnboardingStepApproved        OnboardingStep = "approved"
+)
+
+// ErrInvalidOnboardingStep is returned when parsing an invalid onboarding step.
+var ErrInvalidOnboardingStep = errors.New("invalid onboarding step")
+
+// ParseOnboardingStep parses a string into a OnboardingStep.
+func ParseOnboardingStep(s string) (OnboardingStep, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "profile":
+		return OnboardingStepProfile, nil
+	case "photo":
+		return OnboardingStepPhoto, nil
+	case "documents":
+		return OnboardingStepDocuments, nil
+	case "vehicle":
+		return OnboardingStepVehicle, nil
+	case "background_check":
+		return OnboardingStepBackgroundCheck, nil
+	case "training":
+		return OnboardingStepTraining, nil
+	case "approved":
+		return OnboardingStepApproved, nil
+	default:
+		return "", parseError(ErrInvalidOnboardingStep, s)
+	}
+}
+
+// String returns the string representation.
+func (o OnboardingStep) String() string {
+	return string(o)
+}
+
+// Valid returns true if the OnboardingStep is valid.
+func (o OnboardingStep) Valid() bool {
+	switch o {
+	case OnboardingStepProfile, OnboardingStepPhoto, OnboardingStepDocuments, OnboardingStepVehicle,
+		OnboardingStepBackgroundCheck, OnboardingStepTraining, OnboardingStepApproved:
+		return true
+	default:
+		return false
+	}
+}
+
+// OnboardingStepValues returns all valid OnboardingStep values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func OnboardingStepValues() []OnboardingStep {
+	return []OnboardingStep{
+		OnboardingStepProfile,
+		OnboardingStepPhoto,
+		OnboardingStepDocuments,
+		OnboardingStepVehicle,
+		OnboardingStepBackgroundCheck,
+		OnboardingStepTraining,
+		OnboardingStepApproved,
+	}
+}
+
+// Index returns the 0-based position of the step in the onboarding
+// workflow. Returns -1 for invalid steps.
+func (o OnboardingStep) Index() int {
+	return slices.Index(OnboardingStepValues(), o)
+}
+
+// Next returns the step that follows o in the onboarding workflow.
+// Returns false for the final step (approved) and for invalid steps.
+func (o OnboardingStep) Next() (OnboardingStep, bool) {
+	steps := OnboardingStepValues()
+	i := slices.Index(steps, o)
+	if i < 0 || i == len(steps)-1 {
+		return "", false
+	}
+	return steps[i+1], true
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o OnboardingStep) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(o)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OnboardingStep) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, o, ParseOnboardingStep)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (o OnboardingStep) MarshalText() ([]byte, error) {
+	return marshalEnumText(o)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OnboardingStep) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, o, ParseOnboardingStep)
+}
+
+// Scan implements sql.Scanner.
+func (o *OnboardingStep) Scan(src interface{}) error {
+	return scanEnum(src, o, ParseOnboardingStep)
+}
+
+// Value implements driver.Valuer.
+func (o OnboardingStep) Value() (driver.Value, error) {
+	return enumValue(o)
 }
@@ -0,0 +1,115 @@
+package enums
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"unicode/utf8"
+)
+
+// ErrInvalidEnumValue is returned when marshaling an enum value that is not
+// Valid. The zero value is not considered invalid and encodes as an empty
+// string, so unset fields still marshal and work with omitempty.
+var ErrInvalidEnumValue = errors.New("invalid enum value")
+
+// validEnum is implemented by every enum type in this package.
+type validEnum interface {
+	~string
+	Valid() bool
+}
+
+// The helpers below implement the serialization boilerplate shared by every
+// enum in this package. Each enum keeps its own exported methods, which
+// delegate here with the enum's Parse function.
+
+// marshalEnumJSON encodes v as a JSON string, rejecting invalid values.
+func marshalEnumJSON[T validEnum](v T) ([]byte, error) {
+	if err := checkEnumMarshal(v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(v))
+}
+
+// marshalEnumText encodes v as text, rejecting invalid values.
+func marshalEnumText[T validEnum](v T) ([]byte, error) {
+	if err := checkEnumMarshal(v); err != nil {
+		return nil, err
+	}
+	return []byte(v), nil
+}
+
+// checkEnumMarshal returns an error wrapping ErrInvalidEnumValue if v is
+// neither the zero value nor Valid.
+func checkEnumMarshal[T validEnum](v T) error {
+	if v == "" || v.Valid() {
+		return nil
+	}
+	return fmt.Errorf("%w: %q is not a valid %s", ErrInvalidEnumValue, string(v), reflect.TypeFor[T]().Name())
+}
+
+// maxErrorInputLen caps how many bytes of an invalid input are echoed in a
+// parse error, so oversized payloads do not flood logs and API responses.
+const maxErrorInputLen = 64
+
+// parseError wraps sentinel with the offending input, quoted as received and
+// truncated to maxErrorInputLen bytes. errors.Is still matches sentinel.
+func parseError(sentinel error, s string) error {
+	if len(s) > maxErrorInputLen {
+		cut := maxErrorInputLen
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		s = s[:cut] + "..."
+	}
+	return fmt.Errorf("%w: %q", sentinel, s)
+}
+
+// unmarshalEnumJSON decodes a JSON string into dst using parse.
+func unmarshalEnumJSON[T ~string](data []byte, dst *T, parse func(string) (T, error)) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return setEnum(s, dst, parse)
+}
+
+// unmarshalEnumText decodes text into dst using parse.
+func unmarshalEnumText[T ~string](data []byte, dst *T, parse func(string) (T, error)) error {
+	return setEnum(string(data), dst, parse)
+}
+
+// scanEnum implements sql.Scanner for an enum. A NULL source yields the zero
+// value; strings and byte slices are parsed with parse.
+func scanEnum[T ~string](src interface{}, dst *T, parse func(string) (T, error)) error {
+	switch val := src.(type) {
+	case string:
+		return setEnum(val, dst, parse)
+	case []byte:
+		return setEnum(string(val), dst, parse)
+	case nil:
+		*dst = ""
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into %s", src, reflect.TypeFor[T]().Name())
+	}
+}
+
+// enumValue implements driver.Valuer for an enum, storing the zero value as NULL.
+func enumValue[T ~string](v T) (driver.Value, error) {
+	if v == "" {
+		return nil, nil
+	}
+	return string(v), nil
+}
+
+// setEnum parses s and stores the result in dst, leaving dst unchanged on error.
+func setEnum[T ~string](s string, dst *T, parse func(string) (T, error)) error {
+	parsed, err := parse(s)
+	if err != nil {
+		return err
+	}
+	*dst = parsed
+	return nil
+}
@@ -2,7 +2,20 @@ package enums
 
 import (
 	"encoding/json"
+	"errors"
+	"flag"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 // Generic enum test helper
@@ -13,6 +26,17 @@ type enumTestCase[T comparable] struct {
 	wantErr bool
 }
 
+// assertParseError checks that err wraps sentinel and quotes the input.
+func assertParseError(t *testing.T, err, sentinel error, input string) {
+	t.Helper()
+	if !errors.Is(err, sentinel) {
+		t.Errorf("error = %v, want %v", err, sentinel)
+	}
+	if quoted := strconv.Quote(input); !strings.Contains(err.Error(), quoted) {
+		t.Errorf("error = %q, want it to contain %s", err.Error(), quoted)
+	}
+}
+
 // TestUserType tests UserType enum
 func TestUserType(t *testing.T) {
 	t.Run("Parse", func(t *testing.T) {
@@ -35,6 +59,10 @@ func TestUserType(t *testing.T) {
 					t.Errorf("ParseUserType(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 					return
 				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidUserType, tt.input)
+					return
+				}
 				if got != tt.want {
 					t.Errorf("ParseUserType(%q) = %v, want %v", tt.input, got, tt.want)
 				}
@@ -76,6 +104,55 @@ func TestUserType(t *testing.T) {
 			},
 			func(u UserType) (interface{}, error) { return u.Value() })
 	})
+
+	t.Run("Capabilities", func(t *testing.T) {
+		tests := []struct {
+			userType                   UserType
+			canRide, canDrive, isAdmin bool
+		}{
+			{UserTypeRider, true, false, false},
+			{UserTypeDriver, false, true, false},
+			{UserTypeBoth, true, true, false},
+			{UserTypeAdmin, false, false, true},
+			{UserType("invalid"), false, false, false},
+		}
+		for _, tt := range tests {
+			if got := tt.userType.CanRide(); got != tt.canRide {
+				t.Errorf("%q.CanRide() = %v, want %v", tt.userType, got, tt.canRide)
+			}
+			if got := tt.userType.CanDrive(); got != tt.canDrive {
+				t.Errorf("%q.CanDrive() = %v, want %v", tt.userType, got, tt.canDrive)
+			}
+			if got := tt.userType.IsAdmin(); got != tt.isAdmin {
+				t.Errorf("%q.IsAdmin() = %v, want %v", tt.userType, got, tt.isAdmin)
+			}
+		}
+	})
+
+	t.Run("UserTypeFromCapabilities", func(t *testing.T) {
+		tests := []struct {
+			canRide, canDrive bool
+			want              UserType
+			wantErr           error
+		}{
+			{true, false, UserTypeRider, nil},
+			{false, true, UserTypeDriver, nil},
+			{true, true, UserTypeBoth, nil},
+			{false, false, "", ErrNoUserCapabilities},
+		}
+		for _, tt := range tests {
+			got, err := UserTypeFromCapabilities(tt.canRide, tt.canDrive)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UserTypeFromCapabilities(%v, %v) error = %v, want %v", tt.canRide, tt.canDrive, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("UserTypeFromCapabilities(%v, %v) = %q, want %q", tt.canRide, tt.canDrive, got, tt.want)
+			}
+			if err == nil && (got.CanRide() != tt.canRide || got.CanDrive() != tt.canDrive) {
+				t.Errorf("UserTypeFromCapabilities(%v, %v) = %q does not round-trip", tt.canRide, tt.canDrive, got)
+			}
+		}
+	})
 }
 
 // TestUserStatus tests UserStatus enum
@@ -97,6 +174,10 @@ func TestUserStatus(t *testing.T) {
 					t.Errorf("ParseUserStatus(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 					return
 				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidUserStatus, tt.input)
+					return
+				}
 				if got != tt.want {
 					t.Errorf("ParseUserStatus(%q) = %v, want %v", tt.input, got, tt.want)
 				}
@@ -161,6 +242,10 @@ func TestDriverStatus(t *testing.T) {
 					t.Errorf("ParseDriverStatus(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 					return
 				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidDriverStatus, tt.input)
+					return
+				}
 				if got != tt.want {
 					t.Errorf("ParseDriverStatus(%q) = %v, want %v", tt.input, got, tt.want)
 				}
@@ -202,6 +287,51 @@ func TestDriverStatus(t *testing.T) {
 			},
 			func(d DriverStatus) (interface{}, error) { return d.Value() })
 	})
+
+	t.Run("CanTransitionTo", func(t *testing.T) {
+		allowed := map[DriverStatus][]DriverStatus{
+			DriverStatusPending:            {DriverStatusDocumentsSubmitted},
+			DriverStatusDocumentsSubmitted: {DriverStatusUnderReview},
+			DriverStatusUnderReview:        {DriverStatusApproved, DriverStatusRejected},
+			DriverStatusApproved:           {DriverStatusSuspended},
+			DriverStatusRejected:           {DriverStatusDocumentsSubmitted},
+			DriverStatusSuspended:          {DriverStatusApproved},
+		}
+
+		all := append(DriverStatusValues(), DriverStatus("invalid"))
+		for _, from := range all {
+			for _, to := range all {
+				want := slices.Contains(allowed[from], to)
+				if got := from.CanTransitionTo(to); got != want {
+					t.Errorf("%q.CanTransitionTo(%q) = %v, want %v", from, to, got, want)
+				}
+			}
+		}
+	})
+
+	t.Run("IsTerminalRejection and RequiresDocuments", func(t *testing.T) {
+		tests := []struct {
+			status           DriverStatus
+			wantRejection    bool
+			wantRequiresDocs bool
+		}{
+			{DriverStatusPending, false, true},
+			{DriverStatusDocumentsSubmitted, false, false},
+			{DriverStatusUnderReview, false, false},
+			{DriverStatusApproved, false, false},
+			{DriverStatusRejected, true, true},
+			{DriverStatusSuspended, false, false},
+			{DriverStatus("invalid"), false, false},
+		}
+		for _, tt := range tests {
+			if got := tt.status.IsTerminalRejection(); got != tt.wantRejection {
+				t.Errorf("%q.IsTerminalRejection() = %v, want %v", tt.status, got, tt.wantRejection)
+			}
+			if got := tt.status.RequiresDocuments(); got != tt.wantRequiresDocs {
+				t.Errorf("%q.RequiresDocuments() = %v, want %v", tt.status, got, tt.wantRequiresDocs)
+			}
+		}
+	})
 }
 
 // TestAvailabilityStatus tests AvailabilityStatus enum
@@ -222,6 +352,10 @@ func TestAvailabilityStatus(t *testing.T) {
 					t.Errorf("ParseAvailabilityStatus(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 					return
 				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidAvailabilityStatus, tt.input)
+					return
+				}
 				if got != tt.want {
 					t.Errorf("ParseAvailabilityStatus(%q) = %v, want %v", tt.input, got, tt.want)
 				}
@@ -263,6 +397,52 @@ func TestAvailabilityStatus(t *testing.T) {
 			},
 			func(a AvailabilityStatus) (interface{}, error) { return a.Value() })
 	})
+
+	t.Run("CanTransitionTo", func(t *testing.T) {
+		allowed := map[AvailabilityStatus][]AvailabilityStatus{
+			AvailabilityStatusOffline: {AvailabilityStatusOnline},
+			AvailabilityStatusOnline:  {AvailabilityStatusOffline, AvailabilityStatusOnTrip},
+			AvailabilityStatusOnTrip:  {AvailabilityStatusOnline},
+		}
+
+		all := append(AvailabilityStatusValues(), AvailabilityStatus("invalid"))
+		for _, from := range all {
+			for _, to := range all {
+				want := slices.Contains(allowed[from], to)
+				if got := from.CanTransitionTo(to); got != want {
+					t.Errorf("%q.CanTransitionTo(%q) = %v, want %v", from, to, got, want)
+				}
+				err := ValidateAvailabilityTransition(from, to)
+				if want && err != nil {
+					t.Errorf("ValidateAvailabilityTransition(%q, %q) error = %v, want nil", from, to, err)
+				}
+				if !want && !errors.Is(err, ErrInvalidAvailabilityTransition) {
+					t.Errorf("ValidateAvailabilityTransition(%q, %q) error = %v, want ErrInvalidAvailabilityTransition", from, to, err)
+				}
+			}
+		}
+	})
+
+	t.Run("CanAcceptRide and IsWorking", func(t *testing.T) {
+		tests := []struct {
+			status     AvailabilityStatus
+			acceptRide bool
+			working    bool
+		}{
+			{AvailabilityStatusOffline, false, false},
+			{AvailabilityStatusOnline, true, true},
+			{AvailabilityStatusOnTrip, false, true},
+			{AvailabilityStatus("invalid"), false, false},
+		}
+		for _, tt := range tests {
+			if got := tt.status.CanAcceptRide(); got != tt.acceptRide {
+				t.Errorf("%q.CanAcceptRide() = %v, want %v", tt.status, got, tt.acceptRide)
+			}
+			if got := tt.status.IsWorking(); got != tt.working {
+				t.Errorf("%q.IsWorking() = %v, want %v", tt.status, got, tt.working)
+			}
+		}
+	})
 }
 
 // TestDocumentType tests DocumentType enum
@@ -285,6 +465,10 @@ func TestDocumentType(t *testing.T) {
 					t.Errorf("ParseDocumentType(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 					return
 				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidDocumentType, tt.input)
+					return
+				}
 				if got != tt.want {
 					t.Errorf("ParseDocumentType(%q) = %v, want %v", tt.input, got, tt.want)
 				}
@@ -326,6 +510,64 @@ func TestDocumentType(t *testing.T) {
 			},
 			func(d DocumentType) (interface{}, error) { return d.Value() })
 	})
+
+	t.Run("RequiredFor", func(t *testing.T) {
+		moto := []DocumentType{DocumentTypeDriversLicense, DocumentTypeInsurance, DocumentTypeIDCard}
+		car := DocumentTypeValues()
+		want := map[ServiceType][]DocumentType{
+			ServiceTypeMoto:     moto,
+			ServiceTypeStandard: car,
+			ServiceTypeComfort:  car,
+			ServiceTypePremium:  car,
+		}
+
+		for _, svc := range ServiceTypeValues() {
+			got := RequiredDocuments(svc)
+			if !slices.Equal(got, want[svc]) {
+				t.Errorf("RequiredDocuments(%q) = %v, want %v", svc, got, want[svc])
+			}
+			if !slices.Contains(got, DocumentTypeIDCard) {
+				t.Errorf("RequiredDocuments(%q) does not require id_card", svc)
+			}
+			for _, doc := range DocumentTypeValues() {
+				if got, want := doc.RequiredFor(svc), slices.Contains(want[svc], doc); got != want {
+					t.Errorf("%q.RequiredFor(%q) = %v, want %v", doc, svc, got, want)
+				}
+			}
+		}
+
+		if got := RequiredDocuments(ServiceType("invalid")); len(got) != 0 {
+			t.Errorf("RequiredDocuments(invalid) = %v, want empty", got)
+		}
+		if DocumentTypeIDCard.RequiredFor(ServiceType("invalid")) {
+			t.Error("RequiredFor(invalid) = true, want false")
+		}
+
+		docs := RequiredDocuments(ServiceTypeMoto)
+		docs[0] = DocumentTypeVehicleRegistration
+		if RequiredDocuments(ServiceTypeMoto)[0] != DocumentTypeDriversLicense {
+			t.Error("RequiredDocuments() returned shared backing array")
+		}
+	})
+
+	t.Run("HasExpiry", func(t *testing.T) {
+		tests := []struct {
+			doc  DocumentType
+			want bool
+		}{
+			{DocumentTypeDriversLicense, true},
+			{DocumentTypeVehicleRegistration, false},
+			{DocumentTypeInsurance, true},
+			{DocumentTypeInspectionCertificate, true},
+			{DocumentTypeIDCard, true},
+			{DocumentType("invalid"), false},
+		}
+		for _, tt := range tests {
+			if got := tt.doc.HasExpiry(); got != tt.want {
+				t.Errorf("%q.HasExpiry() = %v, want %v", tt.doc, got, tt.want)
+			}
+		}
+	})
 }
 
 // TestDocumentStatus tests DocumentStatus enum
@@ -347,6 +589,10 @@ func TestDocumentStatus(t *testing.T) {
 					t.Errorf("ParseDocumentStatus(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 					return
 				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidDocumentStatus, tt.input)
+					return
+				}
 				if got != tt.want {
 					t.Errorf("ParseDocumentStatus(%q) = %v, want %v", tt.input, got, tt.want)
 				}
@@ -409,6 +655,10 @@ func TestVehicleStatus(t *testing.T) {
 					t.Errorf("ParseVehicleStatus(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 					return
 				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidVehicleStatus, tt.input)
+					return
+				}
 				if got != tt.want {
 					t.Errorf("ParseVehicleStatus(%q) = %v, want %v", tt.input, got, tt.want)
 				}
@@ -471,6 +721,10 @@ func TestServiceType(t *testing.T) {
 					t.Errorf("ParseServiceType(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 					return
 				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidServiceType, tt.input)
+					return
+				}
 				if got != tt.want {
 					t.Errorf("ParseServiceType(%q) = %v, want %v", tt.input, got, tt.want)
 				}
@@ -512,6 +766,62 @@ func TestServiceType(t *testing.T) {
 			},
 			func(s ServiceType) (interface{}, error) { return s.Value() })
 	})
+
+	t.Run("Metadata", func(t *testing.T) {
+		tests := []struct {
+			service      ServiceType
+			wantCapacity int
+			wantVehicles []VehicleType
+			wantRank     int
+		}{
+			{ServiceTypeMoto, 1, []VehicleType{VehicleTypeMotorcycle}, 1},
+			{ServiceTypeStandard, 4, []VehicleType{VehicleTypeSedan, VehicleTypeHatchback, VehicleTypeSUV, VehicleTypeMinivan}, 2},
+			{ServiceTypeComfort, 4, []VehicleType{VehicleTypeSedan, VehicleTypeSUV, VehicleTypeMinivan}, 3},
+			{ServiceTypePremium, 4, []VehicleType{VehicleTypeSedan, VehicleTypeSUV}, 4},
+			{ServiceType("invalid"), 0, []VehicleType{}, 0},
+		}
+		for _, tt := range tests {
+			if got := tt.service.PassengerCapacity(); got != tt.wantCapacity {
+				t.Errorf("%q.PassengerCapacity() = %v, want %v", tt.service, got, tt.wantCapacity)
+			}
+			if got := tt.service.EligibleVehicleTypes(); !slices.Equal(got, tt.wantVehicles) {
+				t.Errorf("%q.EligibleVehicleTypes() = %v, want %v", tt.service, got, tt.wantVehicles)
+			}
+			if got := tt.service.DisplayRank(); got != tt.wantRank {
+				t.Errorf("%q.DisplayRank() = %v, want %v", tt.service, got, tt.wantRank)
+			}
+		}
+	})
+
+	t.Run("every service type has metadata", func(t *testing.T) {
+		ranks := make(map[int]ServiceType)
+		for _, s := range ServiceTypeValues() {
+			if s.PassengerCapacity() <= 0 {
+				t.Errorf("%q.PassengerCapacity() = %d, want > 0", s, s.PassengerCapacity())
+			}
+			vehicles := s.EligibleVehicleTypes()
+			if len(vehicles) == 0 {
+				t.Errorf("%q.EligibleVehicleTypes() is empty", s)
+			}
+			for _, v := range vehicles {
+				if !v.Valid() {
+					t.Errorf("%q.EligibleVehicleTypes() contains invalid %q", s, v)
+				}
+			}
+			if other, dup := ranks[s.DisplayRank()]; dup {
+				t.Errorf("%q and %q share DisplayRank %d", s, other, s.DisplayRank())
+			}
+			ranks[s.DisplayRank()] = s
+		}
+	})
+
+	t.Run("EligibleVehicleTypes returns a copy", func(t *testing.T) {
+		vehicles := ServiceTypePremium.EligibleVehicleTypes()
+		vehicles[0] = VehicleTypeTukTuk
+		if slices.Contains(ServiceTypePremium.EligibleVehicleTypes(), VehicleTypeTukTuk) {
+			t.Error("EligibleVehicleTypes() returned shared backing array")
+		}
+	})
 }
 
 // TestRideStatus tests RideStatus enum
@@ -537,6 +847,10 @@ func TestRideStatus(t *testing.T) {
 					t.Errorf("ParseRideStatus(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 					return
 				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidRideStatus, tt.input)
+					return
+				}
 				if got != tt.want {
 					t.Errorf("ParseRideStatus(%q) = %v, want %v", tt.input, got, tt.want)
 				}
@@ -578,6 +892,79 @@ func TestRideStatus(t *testing.T) {
 			},
 			func(r RideStatus) (interface{}, error) { return r.Value() })
 	})
+
+	t.Run("CanTransitionTo", func(t *testing.T) {
+		allowed := map[RideStatus][]RideStatus{
+			RideStatusRequested:       {RideStatusSearching, RideStatusCancelled},
+			RideStatusSearching:       {RideStatusDriverAssigned, RideStatusCancelled},
+			RideStatusDriverAssigned:  {RideStatusDriverArriving, RideStatusCancelled},
+			RideStatusDriverArriving:  {RideStatusWaitingForRider, RideStatusCancelled},
+			RideStatusWaitingForRider: {RideStatusInProgress, RideStatusCancelled},
+			RideStatusInProgress:      {RideStatusCompleted, RideStatusCancelled},
+			RideStatusCompleted:       nil,
+			RideStatusCancelled:       nil,
+		}
+
+		all := append(RideStatusValues(), RideStatus("invalid"))
+		for _, from := range all {
+			for _, to := range all {
+				want := slices.Contains(allowed[from], to)
+				if got := from.CanTransitionTo(to); got != want {
+					t.Errorf("%q.CanTransitionTo(%q) = %v, want %v", from, to, got, want)
+				}
+			}
+		}
+	})
+
+	t.Run("ValidNextStatuses", func(t *testing.T) {
+		tests := []struct {
+			status RideStatus
+			want   []RideStatus
+		}{
+			{RideStatusRequested, []RideStatus{RideStatusSearching, RideStatusCancelled}},
+			{RideStatusInProgress, []RideStatus{RideStatusCompleted, RideStatusCancelled}},
+			{RideStatusCompleted, []RideStatus{}},
+			{RideStatusCancelled, []RideStatus{}},
+			{RideStatus("invalid"), []RideStatus{}},
+		}
+		for _, tt := range tests {
+			if got := tt.status.ValidNextStatuses(); !slices.Equal(got, tt.want) {
+				t.Errorf("%q.ValidNextStatuses() = %v, want %v", tt.status, got, tt.want)
+			}
+		}
+
+		next := RideStatusRequested.ValidNextStatuses()
+		next[0] = RideStatusCompleted
+		if RideStatusRequested.CanTransitionTo(RideStatusCompleted) {
+			t.Error("ValidNextStatuses() returned shared transition table")
+		}
+	})
+
+	t.Run("IsTerminal and IsActive", func(t *testing.T) {
+		tests := []struct {
+			status       RideStatus
+			wantTerminal bool
+			wantActive   bool
+		}{
+			{RideStatusRequested, false, true},
+			{RideStatusSearching, false, true},
+			{RideStatusDriverAssigned, false, true},
+			{RideStatusDriverArriving, false, true},
+			{RideStatusWaitingForRider, false, true},
+			{RideStatusInProgress, false, true},
+			{RideStatusCompleted, true, false},
+			{RideStatusCancelled, true, false},
+			{RideStatus("invalid"), false, false},
+		}
+		for _, tt := range tests {
+			if got := tt.status.IsTerminal(); got != tt.wantTerminal {
+				t.Errorf("%q.IsTerminal() = %v, want %v", tt.status, got, tt.wantTerminal)
+			}
+			if got := tt.status.IsActive(); got != tt.wantActive {
+				t.Errorf("%q.IsActive() = %v, want %v", tt.status, got, tt.wantActive)
+			}
+		}
+	})
 }
 
 // TestCancellationReason tests CancellationReason enum
@@ -602,6 +989,10 @@ func TestCancellationReason(t *testing.T) {
 					t.Errorf("ParseCancellationReason(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 					return
 				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidCancellationReason, tt.input)
+					return
+				}
 				if got != tt.want {
 					t.Errorf("ParseCancellationReason(%q) = %v, want %v", tt.input, got, tt.want)
 				}
@@ -643,6 +1034,43 @@ func TestCancellationReason(t *testing.T) {
 			},
 			func(c CancellationReason) (interface{}, error) { return c.Value() })
 	})
+
+	t.Run("Fault", func(t *testing.T) {
+		tests := []struct {
+			reason         CancellationReason
+			wantFault      Fault
+			wantChargeable bool
+			wantDriver     bool
+		}{
+			{CancellationReasonRiderCancelled, FaultRider, true, false},
+			{CancellationReasonDriverCancelled, FaultDriver, false, true},
+			{CancellationReasonNoDriversAvailable, FaultPlatform, false, false},
+			{CancellationReasonRiderNoShow, FaultRider, true, false},
+			{CancellationReasonDriverNoShow, FaultDriver, false, true},
+			{CancellationReasonSafetyConcern, FaultNone, false, false},
+			{CancellationReasonOther, FaultNone, false, false},
+			{CancellationReason("invalid"), "", false, false},
+		}
+		for _, tt := range tests {
+			if got := tt.reason.Fault(); got != tt.wantFault {
+				t.Errorf("%q.Fault() = %q, want %q", tt.reason, got, tt.wantFault)
+			}
+			if got := tt.reason.IsChargeable(); got != tt.wantChargeable {
+				t.Errorf("%q.IsChargeable() = %v, want %v", tt.reason, got, tt.wantChargeable)
+			}
+			if got := tt.reason.CountsAgainstDriver(); got != tt.wantDriver {
+				t.Errorf("%q.CountsAgainstDriver() = %v, want %v", tt.reason, got, tt.wantDriver)
+			}
+		}
+	})
+
+	t.Run("every reason has a valid fault", func(t *testing.T) {
+		for _, reason := range CancellationReasonValues() {
+			if !reason.Fault().Valid() {
+				t.Errorf("%q.Fault() = %q, want a valid Fault", reason, reason.Fault())
+			}
+		}
+	})
 }
 
 // TestPaymentMethod tests PaymentMethod enum
@@ -654,6 +1082,11 @@ func TestPaymentMethod(t *testing.T) {
 			{"card", "card", PaymentMethodCard, false},
 			{"wallet", "wallet", PaymentMethodWallet, false},
 			{"uppercase", "MPESA", PaymentMethodMPesa, false},
+			{"alias m-pesa", "m-pesa", PaymentMethodMPesa, false},
+			{"alias m_pesa", "m_pesa", PaymentMethodMPesa, false},
+			{"alias M PESA", "M PESA", PaymentMethodMPesa, false},
+			{"alias M-Pesa", " M-Pesa ", PaymentMethodMPesa, false},
+			{"alias credit_card", "credit_card", PaymentMethodCard, false},
 			{"invalid", "unknown", "", true},
 		}
 
@@ -664,6 +1097,10 @@ func TestPaymentMethod(t *testing.T) {
 					t.Errorf("ParsePaymentMethod(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 					return
 				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidPaymentMethod, tt.input)
+					return
+				}
 				if got != tt.want {
 					t.Errorf("ParsePaymentMethod(%q) = %v, want %v", tt.input, got, tt.want)
 				}
@@ -705,6 +1142,39 @@ func TestPaymentMethod(t *testing.T) {
 			},
 			func(p PaymentMethod) (interface{}, error) { return p.Value() })
 	})
+
+	t.Run("alias String is canonical", func(t *testing.T) {
+		for _, alias := range []string{"m-pesa", "m_pesa", "M PESA", "credit_card"} {
+			p, err := ParsePaymentMethod(alias)
+			if err != nil {
+				t.Fatalf("ParsePaymentMethod(%q) error = %v", alias, err)
+			}
+			if !slices.Contains(PaymentMethodValues(), PaymentMethod(p.String())) {
+				t.Errorf("ParsePaymentMethod(%q).String() = %q, want canonical value", alias, p.String())
+			}
+		}
+	})
+
+	t.Run("Settlement", func(t *testing.T) {
+		tests := []struct {
+			method                   PaymentMethod
+			electronic, confirmation bool
+		}{
+			{PaymentMethodCash, false, false},
+			{PaymentMethodMPesa, true, true},
+			{PaymentMethodCard, true, true},
+			{PaymentMethodWallet, true, false},
+			{PaymentMethod("invalid"), false, false},
+		}
+		for _, tt := range tests {
+			if got := tt.method.IsElectronic(); got != tt.electronic {
+				t.Errorf("%q.IsElectronic() = %v, want %v", tt.method, got, tt.electronic)
+			}
+			if got := tt.method.RequiresExternalConfirmation(); got != tt.confirmation {
+				t.Errorf("%q.RequiresExternalConfirmation() = %v, want %v", tt.method, got, tt.confirmation)
+			}
+		}
+	})
 }
 
 // TestPaymentStatus tests PaymentStatus enum
@@ -727,6 +1197,10 @@ func TestPaymentStatus(t *testing.T) {
 					t.Errorf("ParsePaymentStatus(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 					return
 				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidPaymentStatus, tt.input)
+					return
+				}
 				if got != tt.want {
 					t.Errorf("ParsePaymentStatus(%q) = %v, want %v", tt.input, got, tt.want)
 				}
@@ -768,6 +1242,56 @@ func TestPaymentStatus(t *testing.T) {
 			},
 			func(p PaymentStatus) (interface{}, error) { return p.Value() })
 	})
+
+	t.Run("CanTransitionTo", func(t *testing.T) {
+		allowed := map[PaymentStatus][]PaymentStatus{
+			PaymentStatusPending:    {PaymentStatusProcessing},
+			PaymentStatusProcessing: {PaymentStatusCompleted, PaymentStatusFailed},
+			PaymentStatusCompleted:  {PaymentStatusRefunded},
+			PaymentStatusFailed:     nil,
+			PaymentStatusRefunded:   nil,
+		}
+
+		all := append(PaymentStatusValues(), PaymentStatus("invalid"))
+		for _, from := range all {
+			for _, to := range all {
+				want := slices.Contains(allowed[from], to)
+				if got := from.CanTransitionTo(to); got != want {
+					t.Errorf("%q.CanTransitionTo(%q) = %v, want %v", from, to, got, want)
+				}
+				err := ValidatePaymentTransition(from, to)
+				if want && err != nil {
+					t.Errorf("ValidatePaymentTransition(%q, %q) error = %v, want nil", from, to, err)
+				}
+				if !want && !errors.Is(err, ErrInvalidPaymentTransition) {
+					t.Errorf("ValidatePaymentTransition(%q, %q) error = %v, want ErrInvalidPaymentTransition", from, to, err)
+				}
+			}
+		}
+	})
+
+	t.Run("IsTerminal and IsSettled", func(t *testing.T) {
+		tests := []struct {
+			status       PaymentStatus
+			wantTerminal bool
+			wantSettled  bool
+		}{
+			{PaymentStatusPending, false, false},
+			{PaymentStatusProcessing, false, false},
+			{PaymentStatusCompleted, false, true},
+			{PaymentStatusFailed, true, false},
+			{PaymentStatusRefunded, true, true},
+			{PaymentStatus("invalid"), false, false},
+		}
+		for _, tt := range tests {
+			if got := tt.status.IsTerminal(); got != tt.wantTerminal {
+				t.Errorf("%q.IsTerminal() = %v, want %v", tt.status, got, tt.wantTerminal)
+			}
+			if got := tt.status.IsSettled(); got != tt.wantSettled {
+				t.Errorf("%q.IsSettled() = %v, want %v", tt.status, got, tt.wantSettled)
+			}
+		}
+	})
 }
 
 // TestTransactionType tests TransactionType enum
@@ -791,6 +1315,10 @@ func TestTransactionType(t *testing.T) {
 					t.Errorf("ParseTransactionType(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 					return
 				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidTransactionType, tt.input)
+					return
+				}
 				if got != tt.want {
 					t.Errorf("ParseTransactionType(%q) = %v, want %v", tt.input, got, tt.want)
 				}
@@ -832,6 +1360,38 @@ func TestTransactionType(t *testing.T) {
 			},
 			func(tx TransactionType) (interface{}, error) { return tx.Value() })
 	})
+
+	t.Run("Sign", func(t *testing.T) {
+		tests := []struct {
+			txType TransactionType
+			sign   int
+		}{
+			{TransactionTypeRidePayment, -1},
+			{TransactionTypeDriverPayout, +1},
+			{TransactionTypeRefund, +1},
+			{TransactionTypeWalletTopup, +1},
+			{TransactionTypeBonus, +1},
+			{TransactionTypeCommission, -1},
+			{TransactionType("invalid"), 0},
+		}
+		for _, tt := range tests {
+			if got := tt.txType.Sign(); got != tt.sign {
+				t.Errorf("%q.Sign() = %d, want %d", tt.txType, got, tt.sign)
+			}
+			if got := tt.txType.IsCredit(); got != (tt.sign > 0) {
+				t.Errorf("%q.IsCredit() = %v, want %v", tt.txType, got, tt.sign > 0)
+			}
+			if got := tt.txType.IsDebit(); got != (tt.sign < 0) {
+				t.Errorf("%q.IsDebit() = %v, want %v", tt.txType, got, tt.sign < 0)
+			}
+		}
+
+		for _, v := range TransactionTypeValues() {
+			if v.IsCredit() == v.IsDebit() {
+				t.Errorf("%q must be exactly one of credit or debit", v)
+			}
+		}
+	})
 }
 
 // TestIncidentSeverity tests IncidentSeverity enum
@@ -853,6 +1413,10 @@ func TestIncidentSeverity(t *testing.T) {
 					t.Errorf("ParseIncidentSeverity(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 					return
 				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidIncidentSeverity, tt.input)
+					return
+				}
 				if got != tt.want {
 					t.Errorf("ParseIncidentSeverity(%q) = %v, want %v", tt.input, got, tt.want)
 				}
@@ -894,6 +1458,59 @@ func TestIncidentSeverity(t *testing.T) {
 			},
 			func(i IncidentSeverity) (interface{}, error) { return i.Value() })
 	})
+
+	t.Run("Level", func(t *testing.T) {
+		tests := []struct {
+			severity IncidentSeverity
+			want     int
+		}{
+			{IncidentSeverityLow, 1},
+			{IncidentSeverityMedium, 2},
+			{IncidentSeverityHigh, 3},
+			{IncidentSeverityCritical, 4},
+			{IncidentSeverity("invalid"), 0},
+			{IncidentSeverity(""), 0},
+		}
+		for _, tt := range tests {
+			if got := tt.severity.Level(); got != tt.want {
+				t.Errorf("%q.Level() = %v, want %v", tt.severity, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("Less and AtLeast", func(t *testing.T) {
+		invalid := IncidentSeverity("invalid")
+		ordered := append([]IncidentSeverity{invalid}, IncidentSeveritiesAscending()...)
+		for i, a := range ordered {
+			for j, b := range ordered {
+				if got := a.Less(b); got != (i < j) {
+					t.Errorf("%q.Less(%q) = %v, want %v", a, b, got, i < j)
+				}
+				if got := a.AtLeast(b); got != (i >= j) {
+					t.Errorf("%q.AtLeast(%q) = %v, want %v", a, b, got, i >= j)
+				}
+			}
+		}
+
+		// String ordering would put "critical" before "low".
+		if !IncidentSeverityLow.Less(IncidentSeverityCritical) {
+			t.Error("low should be less severe than critical")
+		}
+	})
+
+	t.Run("IncidentSeveritiesAscending", func(t *testing.T) {
+		got := IncidentSeveritiesAscending()
+		if !slices.IsSortedFunc(got, func(a, b IncidentSeverity) int { return a.Level() - b.Level() }) {
+			t.Errorf("IncidentSeveritiesAscending() = %v, not sorted by Level", got)
+		}
+		if !slices.Equal(slices.Sorted(slices.Values(got)), slices.Sorted(slices.Values(IncidentSeverityValues()))) {
+			t.Errorf("IncidentSeveritiesAscending() = %v, want all of %v", got, IncidentSeverityValues())
+		}
+		got[0] = IncidentSeverityCritical
+		if IncidentSeveritiesAscending()[0] != IncidentSeverityLow {
+			t.Error("IncidentSeveritiesAscending() returned shared backing array")
+		}
+	})
 }
 
 // TestIncidentStatus tests IncidentStatus enum
@@ -915,6 +1532,10 @@ func TestIncidentStatus(t *testing.T) {
 					t.Errorf("ParseIncidentStatus(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 					return
 				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidIncidentStatus, tt.input)
+					return
+				}
 				if got != tt.want {
 					t.Errorf("ParseIncidentStatus(%q) = %v, want %v", tt.input, got, tt.want)
 				}
@@ -956,6 +1577,54 @@ func TestIncidentStatus(t *testing.T) {
 			},
 			func(i IncidentStatus) (interface{}, error) { return i.Value() })
 	})
+
+	t.Run("CanTransitionTo", func(t *testing.T) {
+		allowed := map[IncidentStatus][]IncidentStatus{
+			IncidentStatusReported:      {IncidentStatusInvestigating},
+			IncidentStatusInvestigating: {IncidentStatusResolved, IncidentStatusDismissed},
+			IncidentStatusResolved:      nil,
+			IncidentStatusDismissed:     nil,
+		}
+
+		all := append(IncidentStatusValues(), IncidentStatus("invalid"))
+		for _, from := range all {
+			for _, to := range all {
+				want := slices.Contains(allowed[from], to)
+				if got := from.CanTransitionTo(to); got != want {
+					t.Errorf("%q.CanTransitionTo(%q) = %v, want %v", from, to, got, want)
+				}
+				err := ValidateIncidentTransition(from, to)
+				if want && err != nil {
+					t.Errorf("ValidateIncidentTransition(%q, %q) error = %v, want nil", from, to, err)
+				}
+				if !want && !errors.Is(err, ErrInvalidIncidentTransition) {
+					t.Errorf("ValidateIncidentTransition(%q, %q) error = %v, want ErrInvalidIncidentTransition", from, to, err)
+				}
+			}
+		}
+	})
+
+	t.Run("IsOpen and IsClosed", func(t *testing.T) {
+		tests := []struct {
+			status     IncidentStatus
+			wantOpen   bool
+			wantClosed bool
+		}{
+			{IncidentStatusReported, true, false},
+			{IncidentStatusInvestigating, true, false},
+			{IncidentStatusResolved, false, true},
+			{IncidentStatusDismissed, false, true},
+			{IncidentStatus("invalid"), false, false},
+		}
+		for _, tt := range tests {
+			if got := tt.status.IsOpen(); got != tt.wantOpen {
+				t.Errorf("%q.IsOpen() = %v, want %v", tt.status, got, tt.wantOpen)
+			}
+			if got := tt.status.IsClosed(); got != tt.wantClosed {
+				t.Errorf("%q.IsClosed() = %v, want %v", tt.status, got, tt.wantClosed)
+			}
+		}
+	})
 }
 
 // TestEmergencyType tests EmergencyType enum
@@ -978,6 +1647,10 @@ func TestEmergencyType(t *testing.T) {
 					t.Errorf("ParseEmergencyType(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 					return
 				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidEmergencyType, tt.input)
+					return
+				}
 				if got != tt.want {
 					t.Errorf("ParseEmergencyType(%q) = %v, want %v", tt.input, got, tt.want)
 				}
@@ -1019,6 +1692,5067 @@ func TestEmergencyType(t *testing.T) {
 			},
 			func(e EmergencyType) (interface{}, error) { return e.Value() })
 	})
+
+	t.Run("Triage", func(t *testing.T) {
+		tests := map[EmergencyType]struct {
+			severity IncidentSeverity
+			dispatch bool
+		}{
+			EmergencyTypeAccident:   {IncidentSeverityCritical, true},
+			EmergencyTypeHarassment: {IncidentSeverityHigh, true},
+			EmergencyTypeTheft:      {IncidentSeverityHigh, false},
+			EmergencyTypeMedical:    {IncidentSeverityCritical, true},
+			EmergencyTypeOther:      {IncidentSeverityMedium, false},
+		}
+
+		// Iterate Values() so a new emergency type without triage defaults fails here.
+		for _, e := range EmergencyTypeValues() {
+			want, ok := tests[e]
+			if !ok {
+				t.Errorf("no expected triage defaults for %q", e)
+				continue
+			}
+			if got := e.DefaultSeverity(); got != want.severity {
+				t.Errorf("%q.DefaultSeverity() = %q, want %q", e, got, want.severity)
+			}
+			if !e.DefaultSeverity().Valid() {
+				t.Errorf("%q.DefaultSeverity() = %q is not a valid severity", e, e.DefaultSeverity())
+			}
+			if got := e.RequiresImmediateDispatch(); got != want.dispatch {
+				t.Errorf("%q.RequiresImmediateDispatch() = %v, want %v", e, got, want.dispatch)
+			}
+		}
+
+		invalid := EmergencyType("invalid")
+		if invalid.DefaultSeverity() != "" || invalid.RequiresImmediateDispatch() {
+			t.Errorf("invalid EmergencyType triage = %q, %v, want empty, false",
+				invalid.DefaultSeverity(), invalid.RequiresImmediateDispatch())
+		}
+	})
+}
+
+// TestAccidentSeverity tests AccidentSeverity enum
+func TestAccidentSeverity(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[AccidentSeverity]{
+			{"minor", "minor", AccidentSeverityMinor, false},
+			{"moderate", "moderate", AccidentSeverityModerate, false},
+			{"severe", "severe", AccidentSeveritySevere, false},
+			{"fatal", "fatal", AccidentSeverityFatal, false},
+			{"uppercase", "SEVERE", AccidentSeveritySevere, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseAccidentSeverity(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseAccidentSeverity(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidAccidentSeverity, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseAccidentSeverity(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if AccidentSeveritySevere.String() != "severe" {
+			t.Errorf("String() = %v, want severe", AccidentSeveritySevere.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !AccidentSeveritySevere.Valid() {
+			t.Error("AccidentSeveritySevere.Valid() = false, want true")
+		}
+		if AccidentSeverity("invalid").Valid() {
+			t.Error("AccidentSeverity(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, AccidentSeveritySevere, "severe", ParseAccidentSeverity)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, AccidentSeveritySevere, "severe", func(a *AccidentSeverity) error {
+			return a.UnmarshalText([]byte("severe"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, AccidentSeveritySevere, "severe",
+			func(src interface{}) (*AccidentSeverity, error) {
+				var a AccidentSeverity
+				err := a.Scan(src)
+				return &a, err
+			},
+			func(a AccidentSeverity) (interface{}, error) { return a.Value() })
+	})
+
+	t.Run("RequiresHospital", func(t *testing.T) {
+		tests := []struct {
+			severity AccidentSeverity
+			want     bool
+		}{
+			{AccidentSeverityMinor, false},
+			{AccidentSeverityModerate, false},
+			{AccidentSeveritySevere, true},
+			{AccidentSeverityFatal, true},
+			{AccidentSeverity("invalid"), false},
+		}
+		for _, tt := range tests {
+			if got := tt.severity.RequiresHospital(); got != tt.want {
+				t.Errorf("%q.RequiresHospital() = %v, want %v", tt.severity, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("RequiresPoliceReport", func(t *testing.T) {
+		tests := []struct {
+			severity AccidentSeverity
+			want     bool
+		}{
+			{AccidentSeverityMinor, false},
+			{AccidentSeverityModerate, true},
+			{AccidentSeveritySevere, true},
+			{AccidentSeverityFatal, true},
+			{AccidentSeverity("invalid"), false},
+		}
+		for _, tt := range tests {
+			if got := tt.severity.RequiresPoliceReport(); got != tt.want {
+				t.Errorf("%q.RequiresPoliceReport() = %v, want %v", tt.severity, got, tt.want)
+			}
+		}
+	})
+}
+
+// TestCurrency tests Currency enum
+func TestCurrency(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[Currency]{
+			{"MZN", "MZN", CurrencyMZN, false},
+			{"ZAR", "ZAR", CurrencyZAR, false},
+			{"USD", "USD", CurrencyUSD, false},
+			{"lowercase", "mzn", CurrencyMZN, false},
+			{"with spaces", " usd ", CurrencyUSD, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseCurrency(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseCurrency(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidCurrency, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseCurrency(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if CurrencyMZN.String() != "MZN" {
+			t.Errorf("String() = %v, want MZN", CurrencyMZN.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !CurrencyMZN.Valid() {
+			t.Error("CurrencyMZN.Valid() = false, want true")
+		}
+		if Currency("invalid").Valid() {
+			t.Error("Currency(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, CurrencyMZN, "MZN", ParseCurrency)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, CurrencyMZN, "MZN", func(c *Currency) error {
+			return c.UnmarshalText([]byte("MZN"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, CurrencyMZN, "MZN",
+			func(src interface{}) (*Currency, error) {
+				var c Currency
+				err := c.Scan(src)
+				return &c, err
+			},
+			func(c Currency) (interface{}, error) { return c.Value() })
+	})
+
+	t.Run("Symbol", func(t *testing.T) {
+		tests := []struct {
+			currency Currency
+			want     string
+		}{
+			{CurrencyMZN, "MT"},
+			{CurrencyZAR, "R"},
+			{CurrencyUSD, "$"},
+			{Currency("EUR"), ""},
+		}
+		for _, tt := range tests {
+			if got := tt.currency.Symbol(); got != tt.want {
+				t.Errorf("%q.Symbol() = %q, want %q", tt.currency, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("ISOCode", func(t *testing.T) {
+		tests := []struct {
+			currency Currency
+			want     string
+		}{
+			{CurrencyMZN, "MZN"},
+			{CurrencyZAR, "ZAR"},
+			{CurrencyUSD, "USD"},
+			{Currency("EUR"), ""},
+		}
+		for _, tt := range tests {
+			if got := tt.currency.ISOCode(); got != tt.want {
+				t.Errorf("%q.ISOCode() = %q, want %q", tt.currency, got, tt.want)
+			}
+		}
+	})
+}
+
+// TestBiometricType tests BiometricType enum
+func TestBiometricType(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[BiometricType]{
+			{"fingerprint", "fingerprint", BiometricTypeFingerprint, false},
+			{"face_id", "face_id", BiometricTypeFaceID, false},
+			{"iris", "iris", BiometricTypeIris, false},
+			{"none", "none", BiometricTypeNone, false},
+			{"uppercase", "FACE_ID", BiometricTypeFaceID, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseBiometricType(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseBiometricType(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidBiometricType, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseBiometricType(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if BiometricTypeFaceID.String() != "face_id" {
+			t.Errorf("String() = %v, want face_id", BiometricTypeFaceID.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !BiometricTypeFaceID.Valid() {
+			t.Error("BiometricTypeFaceID.Valid() = false, want true")
+		}
+		if BiometricType("invalid").Valid() {
+			t.Error("BiometricType(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, BiometricTypeFaceID, "face_id", ParseBiometricType)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, BiometricTypeFaceID, "face_id", func(b *BiometricType) error {
+			return b.UnmarshalText([]byte("face_id"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, BiometricTypeFaceID, "face_id",
+			func(src interface{}) (*BiometricType, error) {
+				var b BiometricType
+				err := b.Scan(src)
+				return &b, err
+			},
+			func(b BiometricType) (interface{}, error) { return b.Value() })
+	})
+
+	t.Run("IsSupported", func(t *testing.T) {
+		tests := []struct {
+			biometric BiometricType
+			want      bool
+		}{
+			{BiometricTypeFingerprint, true},
+			{BiometricTypeFaceID, true},
+			{BiometricTypeIris, true},
+			{BiometricTypeNone, false},
+			{BiometricType("invalid"), false},
+		}
+		for _, tt := range tests {
+			if got := tt.biometric.IsSupported(); got != tt.want {
+				t.Errorf("%q.IsSupported() = %v, want %v", tt.biometric, got, tt.want)
+			}
+		}
+	})
+}
+
+// TestVehicleType tests VehicleType enum
+func TestVehicleType(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[VehicleType]{
+			{"sedan", "sedan", VehicleTypeSedan, false},
+			{"hatchback", "hatchback", VehicleTypeHatchback, false},
+			{"suv", "suv", VehicleTypeSUV, false},
+			{"minivan", "minivan", VehicleTypeMinivan, false},
+			{"pickup", "pickup", VehicleTypePickup, false},
+			{"motorcycle", "motorcycle", VehicleTypeMotorcycle, false},
+			{"tuk_tuk", "tuk_tuk", VehicleTypeTukTuk, false},
+			{"motorbike alias", "motorbike", VehicleTypeMotorcycle, false},
+			{"mixed case with spaces", "Sedan ", VehicleTypeSedan, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseVehicleType(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseVehicleType(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidVehicleType, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseVehicleType(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if VehicleTypeSedan.String() != "sedan" {
+			t.Errorf("String() = %v, want sedan", VehicleTypeSedan.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !VehicleTypeSedan.Valid() {
+			t.Error("VehicleTypeSedan.Valid() = false, want true")
+		}
+		if VehicleType("invalid").Valid() {
+			t.Error("VehicleType(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, VehicleTypeSedan, "sedan", ParseVehicleType)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, VehicleTypeSedan, "sedan", func(v *VehicleType) error {
+			return v.UnmarshalText([]byte("sedan"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, VehicleTypeSedan, "sedan",
+			func(src interface{}) (*VehicleType, error) {
+				var v VehicleType
+				err := v.Scan(src)
+				return &v, err
+			},
+			func(v VehicleType) (interface{}, error) { return v.Value() })
+	})
+}
+
+// TestNotificationChannel tests NotificationChannel enum
+func TestNotificationChannel(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[NotificationChannel]{
+			{"sms", "sms", NotificationChannelSMS, false},
+			{"push", "push", NotificationChannelPush, false},
+			{"email", "email", NotificationChannelEmail, false},
+			{"whatsapp", "whatsapp", NotificationChannelWhatsApp, false},
+			{"in_app", "in_app", NotificationChannelInApp, false},
+			{"uppercase", "SMS", NotificationChannelSMS, false},
+			{"push hyphen alias", "push-notification", NotificationChannelPush, false},
+			{"push underscore alias", "push_notification", NotificationChannelPush, false},
+			{"in-app alias", "in-app", NotificationChannelInApp, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseNotificationChannel(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseNotificationChannel(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidNotificationChannel, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseNotificationChannel(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if NotificationChannelSMS.String() != "sms" {
+			t.Errorf("String() = %v, want sms", NotificationChannelSMS.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !NotificationChannelSMS.Valid() {
+			t.Error("NotificationChannelSMS.Valid() = false, want true")
+		}
+		if NotificationChannel("invalid").Valid() {
+			t.Error("NotificationChannel(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, NotificationChannelSMS, "sms", ParseNotificationChannel)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, NotificationChannelSMS, "sms", func(n *NotificationChannel) error {
+			return n.UnmarshalText([]byte("sms"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, NotificationChannelSMS, "sms",
+			func(src interface{}) (*NotificationChannel, error) {
+				var n NotificationChannel
+				err := n.Scan(src)
+				return &n, err
+			},
+			func(n NotificationChannel) (interface{}, error) { return n.Value() })
+	})
+
+	t.Run("SupportsRichContent and RequiresPhoneNumber", func(t *testing.T) {
+		tests := []struct {
+			channel   NotificationChannel
+			wantRich  bool
+			wantPhone bool
+		}{
+			{NotificationChannelSMS, false, true},
+			{NotificationChannelPush, true, false},
+			{NotificationChannelEmail, true, false},
+			{NotificationChannelWhatsApp, true, true},
+			{NotificationChannelInApp, true, false},
+			{NotificationChannel("invalid"), false, false},
+		}
+		for _, tt := range tests {
+			if got := tt.channel.SupportsRichContent(); got != tt.wantRich {
+				t.Errorf("%q.SupportsRichContent() = %v, want %v", tt.channel, got, tt.wantRich)
+			}
+			if got := tt.channel.RequiresPhoneNumber(); got != tt.wantPhone {
+				t.Errorf("%q.RequiresPhoneNumber() = %v, want %v", tt.channel, got, tt.wantPhone)
+			}
+		}
+	})
+}
+
+// TestVehicleColor tests VehicleColor enum
+func TestVehicleColor(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[VehicleColor]{
+			{"white", "white", VehicleColorWhite, false},
+			{"black", "black", VehicleColorBlack, false},
+			{"silver", "silver", VehicleColorSilver, false},
+			{"grey", "grey", VehicleColorGrey, false},
+			{"red", "red", VehicleColorRed, false},
+			{"blue", "blue", VehicleColorBlue, false},
+			{"green", "green", VehicleColorGreen, false},
+			{"yellow", "yellow", VehicleColorYellow, false},
+			{"orange", "orange", VehicleColorOrange, false},
+			{"brown", "brown", VehicleColorBrown, false},
+			{"purple", "purple", VehicleColorPurple, false},
+			{"other", "other", VehicleColorOther, false},
+			{"gray spelling", "gray", VehicleColorGrey, false},
+			{"uppercase", "WHITE", VehicleColorWhite, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseVehicleColor(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseVehicleColor(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidVehicleColor, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseVehicleColor(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if VehicleColorWhite.String() != "white" {
+			t.Errorf("String() = %v, want white", VehicleColorWhite.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !VehicleColorWhite.Valid() {
+			t.Error("VehicleColorWhite.Valid() = false, want true")
+		}
+		if VehicleColor("invalid").Valid() {
+			t.Error("VehicleColor(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, VehicleColorWhite, "white", ParseVehicleColor)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, VehicleColorWhite, "white", func(v *VehicleColor) error {
+			return v.UnmarshalText([]byte("white"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, VehicleColorWhite, "white",
+			func(src interface{}) (*VehicleColor, error) {
+				var v VehicleColor
+				err := v.Scan(src)
+				return &v, err
+			},
+			func(v VehicleColor) (interface{}, error) { return v.Value() })
+	})
+
+	t.Run("HexCode", func(t *testing.T) {
+		tests := []struct {
+			color VehicleColor
+			want  string
+		}{
+			{VehicleColorWhite, "#FFFFFF"},
+			{VehicleColorBlack, "#000000"},
+			{VehicleColorSilver, "#C0C0C0"},
+			{VehicleColorGrey, "#808080"},
+			{VehicleColorRed, "#FF0000"},
+			{VehicleColorBlue, "#0000FF"},
+			{VehicleColorGreen, "#008000"},
+			{VehicleColorYellow, "#FFFF00"},
+			{VehicleColorOrange, "#FFA500"},
+			{VehicleColorBrown, "#8B4513"},
+			{VehicleColorPurple, "#800080"},
+			{VehicleColorOther, ""},
+			{VehicleColor("invalid"), ""},
+		}
+		for _, tt := range tests {
+			if got := tt.color.HexCode(); got != tt.want {
+				t.Errorf("%q.HexCode() = %q, want %q", tt.color, got, tt.want)
+			}
+		}
+	})
+}
+
+// TestLanguage tests Language enum
+func TestLanguage(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[Language]{
+			{"pt", "pt", LanguagePortuguese, false},
+			{"en", "en", LanguageEnglish, false},
+			{"ts", "ts", LanguageTsonga, false},
+			{"BCP-47 region", "pt-MZ", LanguagePortuguese, false},
+			{"uppercase region", "EN-US", LanguageEnglish, false},
+			{"underscore separator", "ts_MZ", LanguageTsonga, false},
+			{"with spaces", " pt-BR ", LanguagePortuguese, false},
+			{"empty", "", "", true},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseLanguage(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseLanguage(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidLanguage, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseLanguage(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if LanguagePortuguese.String() != "pt" {
+			t.Errorf("String() = %v, want pt", LanguagePortuguese.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !LanguagePortuguese.Valid() {
+			t.Error("LanguagePortuguese.Valid() = false, want true")
+		}
+		if Language("invalid").Valid() {
+			t.Error("Language(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, LanguagePortuguese, "pt", ParseLanguage)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, LanguagePortuguese, "pt", func(l *Language) error {
+			return l.UnmarshalText([]byte("pt"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, LanguagePortuguese, "pt",
+			func(src interface{}) (*Language, error) {
+				var l Language
+				err := l.Scan(src)
+				return &l, err
+			},
+			func(l Language) (interface{}, error) { return l.Value() })
+	})
+
+	t.Run("Tag", func(t *testing.T) {
+		tests := []struct {
+			language Language
+			want     string
+		}{
+			{LanguagePortuguese, "pt-MZ"},
+			{LanguageEnglish, "en"},
+			{LanguageTsonga, "ts-MZ"},
+			{Language("invalid"), ""},
+		}
+		for _, tt := range tests {
+			if got := tt.language.Tag(); got != tt.want {
+				t.Errorf("%q.Tag() = %q, want %q", tt.language, got, tt.want)
+			}
+			if tt.want == "" {
+				continue
+			}
+			if parsed, err := ParseLanguage(tt.want); err != nil || parsed != tt.language {
+				t.Errorf("ParseLanguage(%q) = %v, %v; want %v", tt.want, parsed, err, tt.language)
+			}
+		}
+	})
+
+	t.Run("DefaultLanguage", func(t *testing.T) {
+		if DefaultLanguage != LanguagePortuguese {
+			t.Errorf("DefaultLanguage = %v, want pt", DefaultLanguage)
+		}
+	})
+}
+
+// TestPayoutMethod tests PayoutMethod enum
+func TestPayoutMethod(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[PayoutMethod]{
+			{"mpesa", "mpesa", PayoutMethodMPesa, false},
+			{"emola", "emola", PayoutMethodEMola, false},
+			{"mkesh", "mkesh", PayoutMethodMKesh, false},
+			{"bank_transfer", "bank_transfer", PayoutMethodBankTransfer, false},
+			{"uppercase", "MPESA", PayoutMethodMPesa, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParsePayoutMethod(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParsePayoutMethod(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidPayoutMethod, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParsePayoutMethod(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if PayoutMethodMPesa.String() != "mpesa" {
+			t.Errorf("String() = %v, want mpesa", PayoutMethodMPesa.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !PayoutMethodMPesa.Valid() {
+			t.Error("PayoutMethodMPesa.Valid() = false, want true")
+		}
+		if PayoutMethod("invalid").Valid() {
+			t.Error("PayoutMethod(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, PayoutMethodMPesa, "mpesa", ParsePayoutMethod)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, PayoutMethodMPesa, "mpesa", func(p *PayoutMethod) error {
+			return p.UnmarshalText([]byte("mpesa"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, PayoutMethodMPesa, "mpesa",
+			func(src interface{}) (*PayoutMethod, error) {
+				var p PayoutMethod
+				err := p.Scan(src)
+				return &p, err
+			},
+			func(p PayoutMethod) (interface{}, error) { return p.Value() })
+	})
+
+	t.Run("IsInstant and RequiresBankDetails", func(t *testing.T) {
+		tests := []struct {
+			method      PayoutMethod
+			wantInstant bool
+			wantBank    bool
+		}{
+			{PayoutMethodMPesa, true, false},
+			{PayoutMethodEMola, true, false},
+			{PayoutMethodMKesh, true, false},
+			{PayoutMethodBankTransfer, false, true},
+			{PayoutMethod("invalid"), false, false},
+		}
+		for _, tt := range tests {
+			if got := tt.method.IsInstant(); got != tt.wantInstant {
+				t.Errorf("%q.IsInstant() = %v, want %v", tt.method, got, tt.wantInstant)
+			}
+			if got := tt.method.RequiresBankDetails(); got != tt.wantBank {
+				t.Errorf("%q.RequiresBankDetails() = %v, want %v", tt.method, got, tt.wantBank)
+			}
+		}
+	})
+
+	t.Run("PayoutMethodFromPaymentMethod", func(t *testing.T) {
+		tests := []struct {
+			payment PaymentMethod
+			want    PayoutMethod
+			wantOK  bool
+		}{
+			{PaymentMethodMPesa, PayoutMethodMPesa, true},
+			{PaymentMethodCash, "", false},
+			{PaymentMethodCard, "", false},
+			{PaymentMethodWallet, "", false},
+			{PaymentMethod("invalid"), "", false},
+		}
+		for _, tt := range tests {
+			got, ok := PayoutMethodFromPaymentMethod(tt.payment)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("PayoutMethodFromPaymentMethod(%q) = %q, %v; want %q, %v", tt.payment, got, ok, tt.want, tt.wantOK)
+			}
+		}
+	})
+}
+
+// TestTicketStatus tests TicketStatus enum
+func TestTicketStatus(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[TicketStatus]{
+			{"open", "open", TicketStatusOpen, false},
+			{"pending_customer", "pending_customer", TicketStatusPendingCustomer, false},
+			{"pending_internal", "pending_internal", TicketStatusPendingInternal, false},
+			{"resolved", "resolved", TicketStatusResolved, false},
+			{"closed", "closed", TicketStatusClosed, false},
+			{"uppercase", "OPEN", TicketStatusOpen, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseTicketStatus(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseTicketStatus(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidTicketStatus, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseTicketStatus(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if TicketStatusOpen.String() != "open" {
+			t.Errorf("String() = %v, want open", TicketStatusOpen.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !TicketStatusOpen.Valid() {
+			t.Error("TicketStatusOpen.Valid() = false, want true")
+		}
+		if TicketStatus("invalid").Valid() {
+			t.Error("TicketStatus(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, TicketStatusOpen, "open", ParseTicketStatus)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, TicketStatusOpen, "open", func(s *TicketStatus) error {
+			return s.UnmarshalText([]byte("open"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, TicketStatusOpen, "open",
+			func(src interface{}) (*TicketStatus, error) {
+				var s TicketStatus
+				err := s.Scan(src)
+				return &s, err
+			},
+			func(s TicketStatus) (interface{}, error) { return s.Value() })
+	})
+
+	t.Run("CanTransitionTo", func(t *testing.T) {
+		allowed := map[TicketStatus][]TicketStatus{
+			TicketStatusOpen:            {TicketStatusPendingCustomer, TicketStatusPendingInternal, TicketStatusResolved, TicketStatusClosed},
+			TicketStatusPendingCustomer: {TicketStatusOpen, TicketStatusPendingInternal, TicketStatusResolved, TicketStatusClosed},
+			TicketStatusPendingInternal: {TicketStatusOpen, TicketStatusPendingCustomer, TicketStatusResolved, TicketStatusClosed},
+			TicketStatusResolved:        {TicketStatusOpen, TicketStatusClosed},
+			TicketStatusClosed:          nil,
+		}
+
+		all := append(TicketStatusValues(), TicketStatus("invalid"))
+		for _, from := range all {
+			for _, to := range all {
+				want := slices.Contains(allowed[from], to)
+				if got := from.CanTransitionTo(to); got != want {
+					t.Errorf("%q.CanTransitionTo(%q) = %v, want %v", from, to, got, want)
+				}
+				err := ValidateTicketTransition(from, to)
+				if want && err != nil {
+					t.Errorf("ValidateTicketTransition(%q, %q) error = %v, want nil", from, to, err)
+				}
+				if !want && !errors.Is(err, ErrInvalidTicketTransition) {
+					t.Errorf("ValidateTicketTransition(%q, %q) error = %v, want ErrInvalidTicketTransition", from, to, err)
+				}
+			}
+		}
+	})
+}
+
+// TestTicketPriority tests TicketPriority enum
+func TestTicketPriority(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[TicketPriority]{
+			{"low", "low", TicketPriorityLow, false},
+			{"normal", "normal", TicketPriorityNormal, false},
+			{"high", "high", TicketPriorityHigh, false},
+			{"urgent", "urgent", TicketPriorityUrgent, false},
+			{"uppercase", "URGENT", TicketPriorityUrgent, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseTicketPriority(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseTicketPriority(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidTicketPriority, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseTicketPriority(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if TicketPriorityHigh.String() != "high" {
+			t.Errorf("String() = %v, want high", TicketPriorityHigh.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !TicketPriorityHigh.Valid() {
+			t.Error("TicketPriorityHigh.Valid() = false, want true")
+		}
+		if TicketPriority("invalid").Valid() {
+			t.Error("TicketPriority(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, TicketPriorityHigh, "high", ParseTicketPriority)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, TicketPriorityHigh, "high", func(p *TicketPriority) error {
+			return p.UnmarshalText([]byte("high"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, TicketPriorityHigh, "high",
+			func(src interface{}) (*TicketPriority, error) {
+				var p TicketPriority
+				err := p.Scan(src)
+				return &p, err
+			},
+			func(p TicketPriority) (interface{}, error) { return p.Value() })
+	})
+
+	t.Run("Level", func(t *testing.T) {
+		for i, p := range TicketPriorityValues() {
+			if got := p.Level(); got != i+1 {
+				t.Errorf("%q.Level() = %d, want %d", p, got, i+1)
+			}
+		}
+		if got := TicketPriority("invalid").Level(); got != 0 {
+			t.Errorf("invalid.Level() = %d, want 0", got)
+		}
+	})
+
+	t.Run("Less and AtLeast", func(t *testing.T) {
+		// An invalid priority has Level 0 and orders below every valid one.
+		ordered := append([]TicketPriority{TicketPriority("invalid")}, TicketPriorityValues()...)
+		for i, a := range ordered {
+			for j, b := range ordered {
+				if got := a.Less(b); got != (i < j) {
+					t.Errorf("%q.Less(%q) = %v, want %v", a, b, got, i < j)
+				}
+				if got := a.AtLeast(b); got != (i >= j) {
+					t.Errorf("%q.AtLeast(%q) = %v, want %v", a, b, got, i >= j)
+				}
+			}
+		}
+	})
+}
+
+// TestAuthProvider tests AuthProvider enum
+func TestAuthProvider(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[AuthProvider]{
+			{"email", "email", AuthProviderEmail, false},
+			{"google", "google", AuthProviderGoogle, false},
+			{"facebook", "facebook", AuthProviderFacebook, false},
+			{"apple", "apple", AuthProviderApple, false},
+			{"phone", "phone", AuthProviderPhone, false},
+			{"uppercase", "GOOGLE", AuthProviderGoogle, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseAuthProvider(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseAuthProvider(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidAuthProvider, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseAuthProvider(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if AuthProviderGoogle.String() != "google" {
+			t.Errorf("String() = %v, want google", AuthProviderGoogle.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !AuthProviderGoogle.Valid() {
+			t.Error("AuthProviderGoogle.Valid() = false, want true")
+		}
+		if AuthProvider("invalid").Valid() {
+			t.Error("AuthProvider(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, AuthProviderGoogle, "google", ParseAuthProvider)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, AuthProviderGoogle, "google", func(a *AuthProvider) error {
+			return a.UnmarshalText([]byte("google"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, AuthProviderGoogle, "google",
+			func(src interface{}) (*AuthProvider, error) {
+				var a AuthProvider
+				err := a.Scan(src)
+				return &a, err
+			},
+			func(a AuthProvider) (interface{}, error) { return a.Value() })
+	})
+
+	t.Run("IsOAuth", func(t *testing.T) {
+		tests := []struct {
+			provider AuthProvider
+			want     bool
+		}{
+			{AuthProviderEmail, false},
+			{AuthProviderGoogle, true},
+			{AuthProviderFacebook, true},
+			{AuthProviderApple, true},
+			{AuthProviderPhone, false},
+			{AuthProvider("invalid"), false},
+		}
+		for _, tt := range tests {
+			if got := tt.provider.IsOAuth(); got != tt.want {
+				t.Errorf("%q.IsOAuth() = %v, want %v", tt.provider, got, tt.want)
+			}
+		}
+	})
+}
+
+// TestPromotionType tests PromotionType enum
+func TestPromotionType(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[PromotionType]{
+			{"percent_off", "percent_off", PromotionTypePercentOff, false},
+			{"amount_off", "amount_off", PromotionTypeAmountOff, false},
+			{"free_ride", "free_ride", PromotionTypeFreeRide, false},
+			{"referral_bonus", "referral_bonus", PromotionTypeReferralBonus, false},
+			{"uppercase", "FREE_RIDE", PromotionTypeFreeRide, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParsePromotionType(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParsePromotionType(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidPromotionType, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParsePromotionType(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if PromotionTypePercentOff.String() != "percent_off" {
+			t.Errorf("String() = %v, want percent_off", PromotionTypePercentOff.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !PromotionTypePercentOff.Valid() {
+			t.Error("PromotionTypePercentOff.Valid() = false, want true")
+		}
+		if PromotionType("invalid").Valid() {
+			t.Error("PromotionType(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, PromotionTypePercentOff, "percent_off", ParsePromotionType)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, PromotionTypePercentOff, "percent_off", func(p *PromotionType) error {
+			return p.UnmarshalText([]byte("percent_off"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, PromotionTypePercentOff, "percent_off",
+			func(src interface{}) (*PromotionType, error) {
+				var p PromotionType
+				err := p.Scan(src)
+				return &p, err
+			},
+			func(p PromotionType) (interface{}, error) { return p.Value() })
+	})
+
+	t.Run("RequiresAmount and RequiresPercentage", func(t *testing.T) {
+		tests := []struct {
+			promotion      PromotionType
+			wantAmount     bool
+			wantPercentage bool
+		}{
+			{PromotionTypePercentOff, false, true},
+			{PromotionTypeAmountOff, true, false},
+			{PromotionTypeFreeRide, false, false},
+			{PromotionTypeReferralBonus, true, false},
+			{PromotionType("invalid"), false, false},
+		}
+		for _, tt := range tests {
+			if got := tt.promotion.RequiresAmount(); got != tt.wantAmount {
+				t.Errorf("%q.RequiresAmount() = %v, want %v", tt.promotion, got, tt.wantAmount)
+			}
+			if got := tt.promotion.RequiresPercentage(); got != tt.wantPercentage {
+				t.Errorf("%q.RequiresPercentage() = %v, want %v", tt.promotion, got, tt.wantPercentage)
+			}
+		}
+	})
+}
+
+// TestPromotionStatus tests PromotionStatus enum
+func TestPromotionStatus(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[PromotionStatus]{
+			{"draft", "draft", PromotionStatusDraft, false},
+			{"active", "active", PromotionStatusActive, false},
+			{"paused", "paused", PromotionStatusPaused, false},
+			{"expired", "expired", PromotionStatusExpired, false},
+			{"exhausted", "exhausted", PromotionStatusExhausted, false},
+			{"uppercase", "ACTIVE", PromotionStatusActive, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParsePromotionStatus(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParsePromotionStatus(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidPromotionStatus, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParsePromotionStatus(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if PromotionStatusActive.String() != "active" {
+			t.Errorf("String() = %v, want active", PromotionStatusActive.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !PromotionStatusActive.Valid() {
+			t.Error("PromotionStatusActive.Valid() = false, want true")
+		}
+		if PromotionStatus("invalid").Valid() {
+			t.Error("PromotionStatus(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, PromotionStatusActive, "active", ParsePromotionStatus)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, PromotionStatusActive, "active", func(p *PromotionStatus) error {
+			return p.UnmarshalText([]byte("active"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, PromotionStatusActive, "active",
+			func(src interface{}) (*PromotionStatus, error) {
+				var p PromotionStatus
+				err := p.Scan(src)
+				return &p, err
+			},
+			func(p PromotionStatus) (interface{}, error) { return p.Value() })
+	})
+
+	t.Run("CanTransitionTo", func(t *testing.T) {
+		allowed := map[PromotionStatus][]PromotionStatus{
+			PromotionStatusDraft:     {PromotionStatusActive},
+			PromotionStatusActive:    {PromotionStatusPaused, PromotionStatusExpired, PromotionStatusExhausted},
+			PromotionStatusPaused:    {PromotionStatusActive, PromotionStatusExpired},
+			PromotionStatusExpired:   nil,
+			PromotionStatusExhausted: nil,
+		}
+
+		all := append(PromotionStatusValues(), PromotionStatus("invalid"))
+		for _, from := range all {
+			for _, to := range all {
+				want := slices.Contains(allowed[from], to)
+				if got := from.CanTransitionTo(to); got != want {
+					t.Errorf("%q.CanTransitionTo(%q) = %v, want %v", from, to, got, want)
+				}
+			}
+		}
+	})
+
+	t.Run("IsTerminal", func(t *testing.T) {
+		tests := []struct {
+			status PromotionStatus
+			want   bool
+		}{
+			{PromotionStatusDraft, false},
+			{PromotionStatusActive, false},
+			{PromotionStatusPaused, false},
+			{PromotionStatusExpired, true},
+			{PromotionStatusExhausted, true},
+			{PromotionStatus("invalid"), false},
+		}
+		for _, tt := range tests {
+			if got := tt.status.IsTerminal(); got != tt.want {
+				t.Errorf("%q.IsTerminal() = %v, want %v", tt.status, got, tt.want)
+			}
+		}
+	})
+}
+
+// TestDevicePlatform tests DevicePlatform enum
+func TestDevicePlatform(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[DevicePlatform]{
+			{"ios", "ios", DevicePlatformIOS, false},
+			{"android", "android", DevicePlatformAndroid, false},
+			{"web", "web", DevicePlatformWeb, false},
+			{"mixed case", "iOS", DevicePlatformIOS, false},
+			{"iphone alias", "iPhone", DevicePlatformIOS, false},
+			{"gms alias", "gms", DevicePlatformAndroid, false},
+			{"fcm alias", "FCM", DevicePlatformAndroid, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseDevicePlatform(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseDevicePlatform(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidDevicePlatform, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseDevicePlatform(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if DevicePlatformIOS.String() != "ios" {
+			t.Errorf("String() = %v, want ios", DevicePlatformIOS.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !DevicePlatformIOS.Valid() {
+			t.Error("DevicePlatformIOS.Valid() = false, want true")
+		}
+		if DevicePlatform("invalid").Valid() {
+			t.Error("DevicePlatform(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, DevicePlatformIOS, "ios", ParseDevicePlatform)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, DevicePlatformIOS, "ios", func(d *DevicePlatform) error {
+			return d.UnmarshalText([]byte("ios"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, DevicePlatformIOS, "ios",
+			func(src interface{}) (*DevicePlatform, error) {
+				var d DevicePlatform
+				err := d.Scan(src)
+				return &d, err
+			},
+			func(d DevicePlatform) (interface{}, error) { return d.Value() })
+	})
+
+	t.Run("SupportsPush", func(t *testing.T) {
+		tests := []struct {
+			platform DevicePlatform
+			want     bool
+		}{
+			{DevicePlatformIOS, true},
+			{DevicePlatformAndroid, true},
+			{DevicePlatformWeb, false},
+			{DevicePlatform("invalid"), false},
+		}
+		for _, tt := range tests {
+			if got := tt.platform.SupportsPush(); got != tt.want {
+				t.Errorf("%q.SupportsPush() = %v, want %v", tt.platform, got, tt.want)
+			}
+		}
+	})
+}
+
+// TestFault tests Fault enum
+func TestFault(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[Fault]{
+			{"rider", "rider", FaultRider, false},
+			{"driver", "driver", FaultDriver, false},
+			{"platform", "platform", FaultPlatform, false},
+			{"none", "none", FaultNone, false},
+			{"uppercase", "DRIVER", FaultDriver, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseFault(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseFault(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidFault, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseFault(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if FaultRider.String() != "rider" {
+			t.Errorf("String() = %v, want rider", FaultRider.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !FaultRider.Valid() {
+			t.Error("FaultRider.Valid() = false, want true")
+		}
+		if Fault("invalid").Valid() {
+			t.Error("Fault(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, FaultRider, "rider", ParseFault)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, FaultRider, "rider", func(f *Fault) error {
+			return f.UnmarshalText([]byte("rider"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, FaultRider, "rider",
+			func(src interface{}) (*Fault, error) {
+				var f Fault
+				err := f.Scan(src)
+				return &f, err
+			},
+			func(f Fault) (interface{}, error) { return f.Value() })
+	})
+}
+
+// TestNotificationType tests NotificationType enum
+func TestNotificationType(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[NotificationType]{
+			{"ride_request", "ride_request", NotificationTypeRideRequest, false},
+			{"ride_accepted", "ride_accepted", NotificationTypeRideAccepted, false},
+			{"driver_arriving", "driver_arriving", NotificationTypeDriverArriving, false},
+			{"driver_arrived", "driver_arrived", NotificationTypeDriverArrived, false},
+			{"ride_started", "ride_started", NotificationTypeRideStarted, false},
+			{"ride_completed", "ride_completed", NotificationTypeRideCompleted, false},
+			{"ride_cancelled", "ride_cancelled", NotificationTypeRideCancelled, false},
+			{"payment_received", "payment_received", NotificationTypePaymentReceived, false},
+			{"payment_failed", "payment_failed", NotificationTypePaymentFailed, false},
+			{"refund_issued", "refund_issued", NotificationTypeRefundIssued, false},
+			{"rating_reminder", "rating_reminder", NotificationTypeRatingReminder, false},
+			{"promotion", "promotion", NotificationTypePromotion, false},
+			{"safety_alert", "safety_alert", NotificationTypeSafetyAlert, false},
+			{"uppercase", "RIDE_REQUEST", NotificationTypeRideRequest, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseNotificationType(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseNotificationType(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidNotificationType, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseNotificationType(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if NotificationTypeRideRequest.String() != "ride_request" {
+			t.Errorf("String() = %v, want ride_request", NotificationTypeRideRequest.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !NotificationTypeRideRequest.Valid() {
+			t.Error("NotificationTypeRideRequest.Valid() = false, want true")
+		}
+		if NotificationType("invalid").Valid() {
+			t.Error("NotificationType(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, NotificationTypeRideRequest, "ride_request", ParseNotificationType)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, NotificationTypeRideRequest, "ride_request", func(n *NotificationType) error {
+			return n.UnmarshalText([]byte("ride_request"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, NotificationTypeRideRequest, "ride_request",
+			func(src interface{}) (*NotificationType, error) {
+				var n NotificationType
+				err := n.Scan(src)
+				return &n, err
+			},
+			func(n NotificationType) (interface{}, error) { return n.Value() })
+	})
+
+	t.Run("Priority", func(t *testing.T) {
+		tests := []struct {
+			notification NotificationType
+			want         int
+		}{
+			{NotificationTypeRideRequest, 3},
+			{NotificationTypeRideAccepted, 2},
+			{NotificationTypeDriverArriving, 2},
+			{NotificationTypeDriverArrived, 3},
+			{NotificationTypeRideStarted, 2},
+			{NotificationTypeRideCompleted, 2},
+			{NotificationTypeRideCancelled, 3},
+			{NotificationTypePaymentReceived, 2},
+			{NotificationTypePaymentFailed, 3},
+			{NotificationTypeRefundIssued, 2},
+			{NotificationTypeRatingReminder, 1},
+			{NotificationTypePromotion, 1},
+			{NotificationTypeSafetyAlert, 3},
+			{NotificationType("invalid"), 0},
+		}
+		for _, tt := range tests {
+			if got := tt.notification.Priority(); got != tt.want {
+				t.Errorf("%q.Priority() = %v, want %v", tt.notification, got, tt.want)
+			}
+		}
+	})
+}
+
+func TestDisplayName(t *testing.T) {
+	t.Parallel()
+
+	check := func(t *testing.T, value string, name func(Language) string) {
+		t.Helper()
+		pt, en := name(LanguagePortuguese), name(LanguageEnglish)
+		if pt == "" {
+			t.Errorf("%s: missing Portuguese display name", value)
+		}
+		if en == "" {
+			t.Errorf("%s: missing English display name", value)
+		}
+		if got := name(LanguageTsonga); got != en {
+			t.Errorf("%s: Tsonga display name = %q, want English fallback %q", value, got, en)
+		}
+	}
+
+	t.Run("RideStatus", func(t *testing.T) {
+		t.Parallel()
+		for _, v := range RideStatusValues() {
+			check(t, v.String(), v.DisplayName)
+		}
+	})
+
+	t.Run("PaymentMethod", func(t *testing.T) {
+		t.Parallel()
+		for _, v := range PaymentMethodValues() {
+			check(t, v.String(), v.DisplayName)
+		}
+	})
+
+	t.Run("ServiceType", func(t *testing.T) {
+		t.Parallel()
+		for _, v := range ServiceTypeValues() {
+			check(t, v.String(), v.DisplayName)
+		}
+	})
+
+	t.Run("CancellationReason", func(t *testing.T) {
+		t.Parallel()
+		for _, v := range CancellationReasonValues() {
+			check(t, v.String(), v.DisplayName)
+		}
+	})
+
+	t.Run("translations", func(t *testing.T) {
+		t.Parallel()
+		if got := RideStatusInProgress.DisplayName(LanguagePortuguese); got != "Em curso" {
+			t.Errorf("RideStatusInProgress pt = %q, want %q", got, "Em curso")
+		}
+		if got := PaymentMethodCash.DisplayName(LanguageEnglish); got != "Cash" {
+			t.Errorf("PaymentMethodCash en = %q, want %q", got, "Cash")
+		}
+		if got := PaymentMethodCash.DisplayName(Language("xx")); got != "Cash" {
+			t.Errorf("PaymentMethodCash unknown language = %q, want %q", got, "Cash")
+		}
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		t.Parallel()
+		if got := ServiceType("hover").DisplayName(LanguageEnglish); got != "hover" {
+			t.Errorf("invalid ServiceType = %q, want raw value %q", got, "hover")
+		}
+	})
+}
+
+func TestEnumScanErrorNamesType(t *testing.T) {
+	for typeName, fn := range enumValuesFuncs {
+		t.Run(typeName, func(t *testing.T) {
+			elem := reflect.TypeOf(fn).Out(0).Elem()
+			scanner := reflect.New(elem).Interface().(interface{ Scan(interface{}) error })
+
+			err := scanner.Scan(42)
+			if err == nil {
+				t.Fatal("Scan(int) error = nil, want error")
+			}
+			if want := "cannot scan int into " + typeName; err.Error() != want {
+				t.Errorf("Scan(int) error = %q, want %q", err.Error(), want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Run("preserves input case", func(t *testing.T) {
+		_, err := ParseRideStatus("  Teleported ")
+		assertParseError(t, err, ErrInvalidRideStatus, "  Teleported ")
+		if want := `invalid ride status: "  Teleported "`; err.Error() != want {
+			t.Errorf("error = %q, want %q", err.Error(), want)
+		}
+	})
+
+	t.Run("truncates long input", func(t *testing.T) {
+		long := strings.Repeat("x", 10*maxErrorInputLen)
+		_, err := ParsePaymentMethod(long)
+		if !errors.Is(err, ErrInvalidPaymentMethod) {
+			t.Fatalf("error = %v, want ErrInvalidPaymentMethod", err)
+		}
+		want := strconv.Quote(strings.Repeat("x", maxErrorInputLen) + "...")
+		if !strings.HasSuffix(err.Error(), want) {
+			t.Errorf("error = %q, want suffix %s", err.Error(), want)
+		}
+	})
+
+	t.Run("truncates on rune boundary", func(t *testing.T) {
+		long := "x" + strings.Repeat("é", maxErrorInputLen)
+		_, err := ParseLanguage(long)
+		if !errors.Is(err, ErrInvalidLanguage) {
+			t.Fatalf("error = %v, want ErrInvalidLanguage", err)
+		}
+		if !strings.Contains(err.Error(), "...") || strings.Contains(err.Error(), `\x`) {
+			t.Errorf("error = %q, want valid UTF-8 truncated with ...", err.Error())
+		}
+	})
+
+	t.Run("decoders wrap too", func(t *testing.T) {
+		var status RideStatus
+		err := json.Unmarshal([]byte(`"Bogus"`), &status)
+		assertParseError(t, err, ErrInvalidRideStatus, "Bogus")
+
+		err = status.Scan([]byte("Bogus"))
+		assertParseError(t, err, ErrInvalidRideStatus, "Bogus")
+
+		var set ServiceTypeSet
+		err = set.Add(ServiceType("hover"))
+		assertParseError(t, err, ErrInvalidServiceType, "hover")
+	})
+}
+
+func TestEnumMarshalRejectsInvalid(t *testing.T) {
+	type marshaler interface {
+		MarshalJSON() ([]byte, error)
+		MarshalText() ([]byte, error)
+	}
+
+	for typeName, fn := range enumValuesFuncs {
+		t.Run(typeName, func(t *testing.T) {
+			elem := reflect.TypeOf(fn).Out(0).Elem()
+
+			invalid := reflect.New(elem).Elem()
+			invalid.SetString("bogus")
+			m := invalid.Interface().(marshaler)
+
+			if _, err := m.MarshalJSON(); !errors.Is(err, ErrInvalidEnumValue) {
+				t.Errorf("MarshalJSON() error = %v, want ErrInvalidEnumValue", err)
+			} else if !strings.Contains(err.Error(), typeName) {
+				t.Errorf("MarshalJSON() error = %q, want it to name %s", err.Error(), typeName)
+			}
+			if _, err := m.MarshalText(); !errors.Is(err, ErrInvalidEnumValue) {
+				t.Errorf("MarshalText() error = %v, want ErrInvalidEnumValue", err)
+			}
+			if _, err := json.Marshal(m); !errors.Is(err, ErrInvalidEnumValue) {
+				t.Errorf("json.Marshal() error = %v, want ErrInvalidEnumValue", err)
+			}
+
+			empty := reflect.New(elem).Elem().Interface().(marshaler)
+			data, err := empty.MarshalJSON()
+			if err != nil || string(data) != `""` {
+				t.Errorf("zero MarshalJSON() = %s, %v, want \"\", nil", data, err)
+			}
+			data, err = empty.MarshalText()
+			if err != nil || len(data) != 0 {
+				t.Errorf("zero MarshalText() = %q, %v, want empty, nil", data, err)
+			}
+		})
+	}
+
+	t.Run("Null", func(t *testing.T) {
+		if _, err := json.Marshal(NewNull(RideStatus("bogus"))); !errors.Is(err, ErrInvalidEnumValue) {
+			t.Errorf("json.Marshal(Null) error = %v, want ErrInvalidEnumValue", err)
+		}
+	})
+
+	t.Run("Lenient", func(t *testing.T) {
+		if _, err := json.Marshal(Lenient[RideStatus]{V: RideStatus("bogus")}); !errors.Is(err, ErrInvalidEnumValue) {
+			t.Errorf("json.Marshal(Lenient) error = %v, want ErrInvalidEnumValue", err)
+		}
+		data, err := json.Marshal(Lenient[RideStatus]{V: RideStatusUnknown, Raw: "teleporting"})
+		if err != nil || string(data) != `"teleporting"` {
+			t.Errorf("json.Marshal(Lenient unknown) = %s, %v, want \"teleporting\", nil", data, err)
+		}
+	})
+}
+
+func TestNull(t *testing.T) {
+	t.Run("NewNull", func(t *testing.T) {
+		n := NewNull(RideStatusCompleted)
+		if !n.Valid || n.V != RideStatusCompleted {
+			t.Errorf("NewNull() = %+v, want valid %q", n, RideStatusCompleted)
+		}
+	})
+
+	t.Run("SQL NULL round trip", func(t *testing.T) {
+		n := NewNull(RideStatusCompleted)
+		if err := n.Scan(nil); err != nil {
+			t.Fatalf("Scan(nil) error = %v", err)
+		}
+		if n.Valid || n.V != "" {
+			t.Errorf("Scan(nil) = %+v, want NULL", n)
+		}
+		v, err := n.Value()
+		if err != nil {
+			t.Fatalf("Value() error = %v", err)
+		}
+		if v != nil {
+			t.Errorf("Value() = %v, want nil", v)
+		}
+	})
+
+	t.Run("SQL value round trip", func(t *testing.T) {
+		for _, src := range []interface{}{"mpesa", []byte("mpesa")} {
+			var n NullPaymentMethod
+			if err := n.Scan(src); err != nil {
+				t.Fatalf("Scan(%v) error = %v", src, err)
+			}
+			if !n.Valid || n.V != PaymentMethodMPesa {
+				t.Errorf("Scan(%v) = %+v, want valid %q", src, n, PaymentMethodMPesa)
+			}
+			v, err := n.Value()
+			if err != nil {
+				t.Fatalf("Value() error = %v", err)
+			}
+			if v != "mpesa" {
+				t.Errorf("Value() = %v, want %q", v, "mpesa")
+			}
+		}
+	})
+
+	t.Run("SQL invalid", func(t *testing.T) {
+		n := NewNull(PaymentMethodCash)
+		if err := n.Scan("bitcoin"); !errors.Is(err, ErrInvalidPaymentMethod) {
+			t.Errorf("Scan(invalid) error = %v, want %v", err, ErrInvalidPaymentMethod)
+		}
+		if n != NewNull(PaymentMethodCash) {
+			t.Errorf("Scan(invalid) modified value to %+v", n)
+		}
+		if err := n.Scan(42); err == nil {
+			t.Error("Scan(int) error = nil, want error")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		type payload struct {
+			Status NullRideStatus `json:"status"`
+		}
+
+		tests := []struct {
+			name string
+			in   payload
+			json string
+		}{
+			{"null", payload{}, `{"status":null}`},
+			{"set", payload{Status: NewNull(RideStatusInProgress)}, `{"status":"in_progress"}`},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				data, err := json.Marshal(tt.in)
+				if err != nil {
+					t.Fatalf("Marshal() error = %v", err)
+				}
+				if string(data) != tt.json {
+					t.Errorf("Marshal() = %s, want %s", data, tt.json)
+				}
+				var out payload
+				out.Status = NewNull(RideStatusCancelled)
+				if err := json.Unmarshal(data, &out); err != nil {
+					t.Fatalf("Unmarshal() error = %v", err)
+				}
+				if out != tt.in {
+					t.Errorf("Unmarshal() = %+v, want %+v", out, tt.in)
+				}
+			})
+		}
+
+		var n NullRideStatus
+		if err := json.Unmarshal([]byte(`"flying"`), &n); !errors.Is(err, ErrInvalidRideStatus) {
+			t.Errorf("Unmarshal(invalid) error = %v, want %v", err, ErrInvalidRideStatus)
+		}
+	})
+}
+
+// TestExpiryReason tests ExpiryReason enum
+func TestExpiryReason(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[ExpiryReason]{
+			{"time_elapsed", "time_elapsed", ExpiryReasonTimeElapsed, false},
+			{"revoked", "revoked", ExpiryReasonRevoked, false},
+			{"superseded", "superseded", ExpiryReasonSuperseded, false},
+			{"voluntary", "voluntary", ExpiryReasonVoluntary, false},
+			{"uppercase", "REVOKED", ExpiryReasonRevoked, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseExpiryReason(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseExpiryReason(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidExpiryReason, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseExpiryReason(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if ExpiryReasonTimeElapsed.String() != "time_elapsed" {
+			t.Errorf("String() = %v, want time_elapsed", ExpiryReasonTimeElapsed.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !ExpiryReasonTimeElapsed.Valid() {
+			t.Error("ExpiryReasonTimeElapsed.Valid() = false, want true")
+		}
+		if ExpiryReason("invalid").Valid() {
+			t.Error("ExpiryReason(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, ExpiryReasonTimeElapsed, "time_elapsed", ParseExpiryReason)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, ExpiryReasonTimeElapsed, "time_elapsed", func(r *ExpiryReason) error {
+			return r.UnmarshalText([]byte("time_elapsed"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, ExpiryReasonTimeElapsed, "time_elapsed",
+			func(src interface{}) (*ExpiryReason, error) {
+				var r ExpiryReason
+				err := r.Scan(src)
+				return &r, err
+			},
+			func(r ExpiryReason) (interface{}, error) { return r.Value() })
+	})
+
+	t.Run("IsAdministrative", func(t *testing.T) {
+		tests := []struct {
+			reason ExpiryReason
+			want   bool
+		}{
+			{ExpiryReasonTimeElapsed, false},
+			{ExpiryReasonRevoked, true},
+			{ExpiryReasonSuperseded, true},
+			{ExpiryReasonVoluntary, false},
+			{ExpiryReason("invalid"), false},
+		}
+		for _, tt := range tests {
+			if got := tt.reason.IsAdministrative(); got != tt.want {
+				t.Errorf("%q.IsAdministrative() = %v, want %v", tt.reason, got, tt.want)
+			}
+		}
+	})
+}
+
+func TestLenient(t *testing.T) {
+	t.Run("ParseRideStatusLenient", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			input string
+			want  RideStatus
+		}{
+			{"known", "completed", RideStatusCompleted},
+			{"known uppercase", "IN_PROGRESS", RideStatusInProgress},
+			{"unknown", "teleporting", RideStatusUnknown},
+			{"empty", "", RideStatusUnknown},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got := ParseRideStatusLenient(tt.input)
+				if got != tt.want {
+					t.Errorf("ParseRideStatusLenient(%q) = %q, want %q", tt.input, got, tt.want)
+				}
+				if got.IsUnknown() != (tt.want == RideStatusUnknown) {
+					t.Errorf("IsUnknown() = %v for %q", got.IsUnknown(), got)
+				}
+			})
+		}
+
+		if _, err := ParseRideStatus("teleporting"); !errors.Is(err, ErrInvalidRideStatus) {
+			t.Errorf("ParseRideStatus() strict error = %v, want %v", err, ErrInvalidRideStatus)
+		}
+		if RideStatusUnknown.Valid() {
+			t.Error("RideStatusUnknown.Valid() = true, want false")
+		}
+	})
+
+	t.Run("ParseLenient", func(t *testing.T) {
+		if got := ParseLenient("mpesa", ParsePaymentMethod); got != PaymentMethodMPesa {
+			t.Errorf("ParseLenient(mpesa) = %q, want %q", got, PaymentMethodMPesa)
+		}
+		got := ParseLenient("crypto", ParsePaymentMethod)
+		if !IsUnknown(got) || got.Valid() {
+			t.Errorf("ParseLenient(crypto) = %q, want unknown sentinel", got)
+		}
+		if IsUnknown(PaymentMethodCash) {
+			t.Error("IsUnknown(PaymentMethodCash) = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		type webhook struct {
+			Status Lenient[RideStatus] `json:"status"`
+		}
+
+		tests := []struct {
+			name        string
+			input       string
+			want        RideStatus
+			wantUnknown bool
+			output      string
+		}{
+			{"known", `{"status":"completed"}`, RideStatusCompleted, false, `{"status":"completed"}`},
+			{"unknown", `{"status":"teleporting"}`, RideStatusUnknown, true, `{"status":"teleporting"}`},
+			{"empty", `{"status":""}`, RideStatusUnknown, true, `{"status":""}`},
+			{"null", `{"status":null}`, "", false, `{"status":""}`},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var w webhook
+				if err := json.Unmarshal([]byte(tt.input), &w); err != nil {
+					t.Fatalf("Unmarshal() error = %v", err)
+				}
+				if w.Status.V != tt.want {
+					t.Errorf("Status.V = %q, want %q", w.Status.V, tt.want)
+				}
+				if w.Status.IsUnknown() != tt.wantUnknown {
+					t.Errorf("Status.IsUnknown() = %v, want %v", w.Status.IsUnknown(), tt.wantUnknown)
+				}
+				data, err := json.Marshal(w)
+				if err != nil {
+					t.Fatalf("Marshal() error = %v", err)
+				}
+				if string(data) != tt.output {
+					t.Errorf("Marshal() = %s, want %s", data, tt.output)
+				}
+			})
+		}
+
+		var w webhook
+		if err := json.Unmarshal([]byte(`{"status":42}`), &w); err == nil {
+			t.Error("Unmarshal(non-string) error = nil, want error")
+		}
+
+		var strict struct {
+			Status RideStatus `json:"status"`
+		}
+		if err := json.Unmarshal([]byte(`{"status":"teleporting"}`), &strict); !errors.Is(err, ErrInvalidRideStatus) {
+			t.Errorf("strict Unmarshal() error = %v, want %v", err, ErrInvalidRideStatus)
+		}
+	})
+}
+
+// TestPaymentGateway tests PaymentGateway enum
+func TestPaymentGateway(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[PaymentGateway]{
+			{"mpesa_api", "mpesa_api", PaymentGatewayMPesaAPI, false},
+			{"stripe", "stripe", PaymentGatewayStripe, false},
+			{"payfast", "payfast", PaymentGatewayPayFast, false},
+			{"flutterwave", "flutterwave", PaymentGatewayFlutterwave, false},
+			{"uppercase", "STRIPE", PaymentGatewayStripe, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParsePaymentGateway(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParsePaymentGateway(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidPaymentGateway, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParsePaymentGateway(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if PaymentGatewayMPesaAPI.String() != "mpesa_api" {
+			t.Errorf("String() = %v, want mpesa_api", PaymentGatewayMPesaAPI.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !PaymentGatewayMPesaAPI.Valid() {
+			t.Error("PaymentGatewayMPesaAPI.Valid() = false, want true")
+		}
+		if PaymentGateway("invalid").Valid() {
+			t.Error("PaymentGateway(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, PaymentGatewayMPesaAPI, "mpesa_api", ParsePaymentGateway)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, PaymentGatewayMPesaAPI, "mpesa_api", func(g *PaymentGateway) error {
+			return g.UnmarshalText([]byte("mpesa_api"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, PaymentGatewayMPesaAPI, "mpesa_api",
+			func(src interface{}) (*PaymentGateway, error) {
+				var g PaymentGateway
+				err := g.Scan(src)
+				return &g, err
+			},
+			func(g PaymentGateway) (interface{}, error) { return g.Value() })
+	})
+
+	t.Run("SupportsRefund", func(t *testing.T) {
+		tests := []struct {
+			gateway PaymentGateway
+			want    bool
+		}{
+			{PaymentGatewayMPesaAPI, true},
+			{PaymentGatewayStripe, true},
+			{PaymentGatewayPayFast, false},
+			{PaymentGatewayFlutterwave, true},
+			{PaymentGateway("invalid"), false},
+		}
+		for _, tt := range tests {
+			if got := tt.gateway.SupportsRefund(); got != tt.want {
+				t.Errorf("%q.SupportsRefund() = %v, want %v", tt.gateway, got, tt.want)
+			}
+		}
+	})
+}
+
+// TestRideCancelledBy tests RideCancelledBy enum
+func TestRideCancelledBy(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[RideCancelledBy]{
+			{"rider", "rider", RideCancelledByRider, false},
+			{"driver", "driver", RideCancelledByDriver, false},
+			{"system", "system", RideCancelledBySystem, false},
+			{"admin", "admin", RideCancelledByAdmin, false},
+			{"uppercase", "SYSTEM", RideCancelledBySystem, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseRideCancelledBy(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseRideCancelledBy(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidRideCancelledBy, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseRideCancelledBy(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if RideCancelledByRider.String() != "rider" {
+			t.Errorf("String() = %v, want rider", RideCancelledByRider.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !RideCancelledByRider.Valid() {
+			t.Error("RideCancelledByRider.Valid() = false, want true")
+		}
+		if RideCancelledBy("invalid").Valid() {
+			t.Error("RideCancelledBy(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, RideCancelledByRider, "rider", ParseRideCancelledBy)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, RideCancelledByRider, "rider", func(c *RideCancelledBy) error {
+			return c.UnmarshalText([]byte("rider"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, RideCancelledByRider, "rider",
+			func(src interface{}) (*RideCancelledBy, error) {
+				var c RideCancelledBy
+				err := c.Scan(src)
+				return &c, err
+			},
+			func(c RideCancelledBy) (interface{}, error) { return c.Value() })
+	})
+
+	t.Run("IsFaultOfDriver", func(t *testing.T) {
+		for _, c := range append(RideCancelledByValues(), RideCancelledBy("invalid")) {
+			if got, want := c.IsFaultOfDriver(), c == RideCancelledByDriver; got != want {
+				t.Errorf("%q.IsFaultOfDriver() = %v, want %v", c, got, want)
+			}
+		}
+	})
+}
+
+func TestServiceTypeSet(t *testing.T) {
+	t.Run("Add Remove Contains Len", func(t *testing.T) {
+		var set ServiceTypeSet
+		if set.Len() != 0 || set.Contains(ServiceTypeMoto) {
+			t.Fatalf("zero set = %v, want empty", set)
+		}
+
+		for range 2 {
+			if err := set.Add(ServiceTypeMoto); err != nil {
+				t.Fatalf("Add() error = %v", err)
+			}
+		}
+		if set.Len() != 1 {
+			t.Errorf("Len() after duplicate Add = %d, want 1", set.Len())
+		}
+		if err := set.Add(ServiceTypeComfort); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+		if !set.Contains(ServiceTypeMoto) || !set.Contains(ServiceTypeComfort) || set.Contains(ServiceTypePremium) {
+			t.Errorf("Contains() mismatch for %v", set)
+		}
+		if err := set.Add(ServiceType("hover")); !errors.Is(err, ErrInvalidServiceType) {
+			t.Errorf("Add(invalid) error = %v, want %v", err, ErrInvalidServiceType)
+		}
+		if set.Contains(ServiceType("hover")) {
+			t.Error("Contains(invalid) = true, want false")
+		}
+
+		set.Remove(ServiceTypeMoto)
+		set.Remove(ServiceTypeMoto)
+		set.Remove(ServiceType("hover"))
+		if set.Len() != 1 || set.Contains(ServiceTypeMoto) {
+			t.Errorf("after Remove = %v, want [comfort]", set)
+		}
+	})
+
+	t.Run("NewServiceTypeSet", func(t *testing.T) {
+		set, err := NewServiceTypeSet([]ServiceType{ServiceTypeStandard, ServiceTypeMoto, ServiceTypeStandard})
+		if err != nil {
+			t.Fatalf("NewServiceTypeSet() error = %v", err)
+		}
+		want := []ServiceType{ServiceTypeMoto, ServiceTypeStandard}
+		if got := set.Types(); !slices.Equal(got, want) {
+			t.Errorf("Types() = %v, want %v", got, want)
+		}
+
+		all, err := NewServiceTypeSet(ServiceTypeValues())
+		if err != nil {
+			t.Fatalf("NewServiceTypeSet(all) error = %v", err)
+		}
+		if all.Len() != len(ServiceTypeValues()) {
+			t.Errorf("Len() = %d, want %d", all.Len(), len(ServiceTypeValues()))
+		}
+
+		if _, err := NewServiceTypeSet([]ServiceType{ServiceTypeMoto, "hover"}); !errors.Is(err, ErrInvalidServiceType) {
+			t.Errorf("NewServiceTypeSet(invalid) error = %v, want %v", err, ErrInvalidServiceType)
+		}
+	})
+
+	t.Run("Intersects", func(t *testing.T) {
+		car, _ := NewServiceTypeSet([]ServiceType{ServiceTypeStandard, ServiceTypeComfort})
+		moto, _ := NewServiceTypeSet([]ServiceType{ServiceTypeMoto})
+		standard, _ := NewServiceTypeSet([]ServiceType{ServiceTypeStandard})
+
+		if !car.Intersects(standard) || !standard.Intersects(car) {
+			t.Error("car.Intersects(standard) = false, want true")
+		}
+		if car.Intersects(moto) {
+			t.Error("car.Intersects(moto) = true, want false")
+		}
+		if car.Intersects(ServiceTypeSet(0)) {
+			t.Error("Intersects(empty) = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		set, _ := NewServiceTypeSet([]ServiceType{ServiceTypeStandard, ServiceTypeMoto, ServiceTypeComfort})
+		data, err := json.Marshal(set)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		if want := `["comfort","moto","standard"]`; string(data) != want {
+			t.Errorf("Marshal() = %s, want %s", data, want)
+		}
+		var decoded ServiceTypeSet
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if decoded != set {
+			t.Errorf("Unmarshal() = %v, want %v", decoded, set)
+		}
+
+		var empty ServiceTypeSet
+		data, err = json.Marshal(empty)
+		if err != nil {
+			t.Fatalf("Marshal(empty) error = %v", err)
+		}
+		if string(data) != "[]" {
+			t.Errorf("Marshal(empty) = %s, want []", data)
+		}
+		decoded = set
+		if err := json.Unmarshal(data, &decoded); err != nil || decoded != 0 {
+			t.Errorf("Unmarshal([]) = %v, %v, want empty set", decoded, err)
+		}
+
+		if err := json.Unmarshal([]byte(`["moto","hover"]`), &decoded); !errors.Is(err, ErrInvalidServiceType) {
+			t.Errorf("Unmarshal(invalid) error = %v, want %v", err, ErrInvalidServiceType)
+		}
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		set, _ := NewServiceTypeSet([]ServiceType{ServiceTypePremium, ServiceTypeMoto})
+		v, err := set.Value()
+		if err != nil {
+			t.Fatalf("Value() error = %v", err)
+		}
+		if v != "moto,premium" {
+			t.Errorf("Value() = %v, want %q", v, "moto,premium")
+		}
+
+		tests := []struct {
+			name    string
+			src     interface{}
+			want    ServiceTypeSet
+			wantErr bool
+		}{
+			{"canonical", "moto,premium", set, false},
+			{"bytes", []byte("moto,premium"), set, false},
+			{"unordered with spaces and duplicates", " PREMIUM, moto ,moto", set, false},
+			{"empty string", "", 0, false},
+			{"nil", nil, 0, false},
+			{"unknown value", "moto,hover", 0, true},
+			{"empty entry", "moto,,premium", 0, true},
+			{"invalid type", 42, 0, true},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var got ServiceTypeSet
+				err := got.Scan(tt.src)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("Scan(%v) error = %v, wantErr %v", tt.src, err, tt.wantErr)
+				}
+				if got != tt.want {
+					t.Errorf("Scan(%v) = %v, want %v", tt.src, got, tt.want)
+				}
+			})
+		}
+
+		var empty ServiceTypeSet
+		v, err = empty.Value()
+		if err != nil {
+			t.Fatalf("Value(empty) error = %v", err)
+		}
+		roundTrip := set
+		if err := roundTrip.Scan(v); err != nil || roundTrip != 0 {
+			t.Errorf("empty round trip = %v, %v, want empty set", roundTrip, err)
+		}
+	})
+
+	t.Run("unknown bits", func(t *testing.T) {
+		for _, set := range []ServiceTypeSet{0xF0, 0x11} {
+			if _, err := set.MarshalJSON(); !errors.Is(err, ErrInvalidEnumValue) {
+				t.Errorf("%#x.MarshalJSON() error = %v, want ErrInvalidEnumValue", uint8(set), err)
+			}
+			if _, err := json.Marshal(set); !errors.Is(err, ErrInvalidEnumValue) {
+				t.Errorf("json.Marshal(%#x) error = %v, want ErrInvalidEnumValue", uint8(set), err)
+			}
+			if _, err := set.Value(); !errors.Is(err, ErrInvalidEnumValue) {
+				t.Errorf("%#x.Value() error = %v, want ErrInvalidEnumValue", uint8(set), err)
+			}
+		}
+		all, _ := NewServiceTypeSet(ServiceTypeValues())
+		if _, err := all.Value(); err != nil {
+			t.Errorf("Value(all) error = %v", err)
+		}
+	})
+}
+
+// TestKYCStatus tests KYCStatus enum
+func TestKYCStatus(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[KYCStatus]{
+			{"unverified", "unverified", KYCStatusUnverified, false},
+			{"basic", "basic", KYCStatusBasic, false},
+			{"full", "full", KYCStatusFull, false},
+			{"rejected", "rejected", KYCStatusRejected, false},
+			{"uppercase", "FULL", KYCStatusFull, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseKYCStatus(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseKYCStatus(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidKYCStatus, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseKYCStatus(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if KYCStatusUnverified.String() != "unverified" {
+			t.Errorf("String() = %v, want unverified", KYCStatusUnverified.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !KYCStatusUnverified.Valid() {
+			t.Error("KYCStatusUnverified.Valid() = false, want true")
+		}
+		if KYCStatus("invalid").Valid() {
+			t.Error("KYCStatus(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, KYCStatusUnverified, "unverified", ParseKYCStatus)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, KYCStatusUnverified, "unverified", func(k *KYCStatus) error {
+			return k.UnmarshalText([]byte("unverified"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, KYCStatusUnverified, "unverified",
+			func(src interface{}) (*KYCStatus, error) {
+				var k KYCStatus
+				err := k.Scan(src)
+				return &k, err
+			},
+			func(k KYCStatus) (interface{}, error) { return k.Value() })
+	})
+
+	t.Run("Ordering", func(t *testing.T) {
+		tests := []struct {
+			status KYCStatus
+			level  int
+		}{
+			{KYCStatusRejected, 0},
+			{KYCStatusUnverified, 1},
+			{KYCStatusBasic, 2},
+			{KYCStatusFull, 3},
+			{KYCStatus("invalid"), 0},
+		}
+		for _, tt := range tests {
+			if got := tt.status.Level(); got != tt.level {
+				t.Errorf("%q.Level() = %d, want %d", tt.status, got, tt.level)
+			}
+		}
+
+		for _, a := range tests {
+			for _, b := range tests {
+				if got, want := a.status.Less(b.status), a.level < b.level; got != want {
+					t.Errorf("%q.Less(%q) = %v, want %v", a.status, b.status, got, want)
+				}
+				if got, want := a.status.AtLeast(b.status), a.level >= b.level; got != want {
+					t.Errorf("%q.AtLeast(%q) = %v, want %v", a.status, b.status, got, want)
+				}
+			}
+		}
+
+		if !KYCStatusFull.AtLeast(KYCStatusBasic) || KYCStatusUnverified.AtLeast(KYCStatusBasic) || KYCStatusRejected.AtLeast(KYCStatusBasic) {
+			t.Error("AtLeast(KYCStatusBasic) mismatch")
+		}
+	})
+}
+
+// TestFuelType tests FuelType enum
+func TestFuelType(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[FuelType]{
+			{"petrol", "petrol", FuelTypePetrol, false},
+			{"diesel", "diesel", FuelTypeDiesel, false},
+			{"electric", "electric", FuelTypeElectric, false},
+			{"hybrid", "hybrid", FuelTypeHybrid, false},
+			{"lpg", "lpg", FuelTypeLPG, false},
+			{"uppercase", "LPG", FuelTypeLPG, false},
+			{"alias gasoline", "gasoline", FuelTypePetrol, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseFuelType(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseFuelType(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidFuelType, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseFuelType(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if FuelTypePetrol.String() != "petrol" {
+			t.Errorf("String() = %v, want petrol", FuelTypePetrol.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !FuelTypePetrol.Valid() {
+			t.Error("FuelTypePetrol.Valid() = false, want true")
+		}
+		if FuelType("invalid").Valid() {
+			t.Error("FuelType(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, FuelTypePetrol, "petrol", ParseFuelType)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, FuelTypePetrol, "petrol", func(f *FuelType) error {
+			return f.UnmarshalText([]byte("petrol"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, FuelTypePetrol, "petrol",
+			func(src interface{}) (*FuelType, error) {
+				var f FuelType
+				err := f.Scan(src)
+				return &f, err
+			},
+			func(f FuelType) (interface{}, error) { return f.Value() })
+	})
+
+	t.Run("Emissions", func(t *testing.T) {
+		tests := []struct {
+			fuel                  FuelType
+			electric, lowEmission bool
+		}{
+			{FuelTypePetrol, false, false},
+			{FuelTypeDiesel, false, false},
+			{FuelTypeElectric, true, true},
+			{FuelTypeHybrid, true, true},
+			{FuelTypeLPG, false, true},
+			{FuelType("invalid"), false, false},
+		}
+		for _, tt := range tests {
+			if got := tt.fuel.IsElectric(); got != tt.electric {
+				t.Errorf("%q.IsElectric() = %v, want %v", tt.fuel, got, tt.electric)
+			}
+			if got := tt.fuel.IsLowEmission(); got != tt.lowEmission {
+				t.Errorf("%q.IsLowEmission() = %v, want %v", tt.fuel, got, tt.lowEmission)
+			}
+		}
+	})
+}
+
+// TestRefundReason tests RefundReason enum
+func TestRefundReason(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[RefundReason]{
+			{"duplicate_charge", "duplicate_charge", RefundReasonDuplicateCharge, false},
+			{"ride_not_completed", "ride_not_completed", RefundReasonRideNotCompleted, false},
+			{"overcharge", "overcharge", RefundReasonOvercharge, false},
+			{"fraud", "fraud", RefundReasonFraud, false},
+			{"goodwill", "goodwill", RefundReasonGoodwill, false},
+			{"other", "other", RefundReasonOther, false},
+			{"uppercase", "FRAUD", RefundReasonFraud, false},
+			{"alias dup", "dup", RefundReasonDuplicateCharge, false},
+			{"alias duplicate", "duplicate", RefundReasonDuplicateCharge, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseRefundReason(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseRefundReason(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidRefundReason, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseRefundReason(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if RefundReasonDuplicateCharge.String() != "duplicate_charge" {
+			t.Errorf("String() = %v, want duplicate_charge", RefundReasonDuplicateCharge.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !RefundReasonDuplicateCharge.Valid() {
+			t.Error("RefundReasonDuplicateCharge.Valid() = false, want true")
+		}
+		if RefundReason("invalid").Valid() {
+			t.Error("RefundReason(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, RefundReasonDuplicateCharge, "duplicate_charge", ParseRefundReason)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, RefundReasonDuplicateCharge, "duplicate_charge", func(r *RefundReason) error {
+			return r.UnmarshalText([]byte("duplicate_charge"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, RefundReasonDuplicateCharge, "duplicate_charge",
+			func(src interface{}) (*RefundReason, error) {
+				var r RefundReason
+				err := r.Scan(src)
+				return &r, err
+			},
+			func(r RefundReason) (interface{}, error) { return r.Value() })
+	})
+
+	t.Run("RequiresManualReview", func(t *testing.T) {
+		tests := []struct {
+			reason RefundReason
+			want   bool
+		}{
+			{RefundReasonDuplicateCharge, false},
+			{RefundReasonRideNotCompleted, false},
+			{RefundReasonOvercharge, false},
+			{RefundReasonFraud, true},
+			{RefundReasonGoodwill, true},
+			{RefundReasonOther, true},
+			{RefundReason("invalid"), false},
+		}
+		for _, tt := range tests {
+			if got := tt.reason.RequiresManualReview(); got != tt.want {
+				t.Errorf("%q.RequiresManualReview() = %v, want %v", tt.reason, got, tt.want)
+			}
+		}
+	})
+}
+
+// TestRefundStatus tests RefundStatus enum
+func TestRefundStatus(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[RefundStatus]{
+			{"requested", "requested", RefundStatusRequested, false},
+			{"approved", "approved", RefundStatusApproved, false},
+			{"processing", "processing", RefundStatusProcessing, false},
+			{"completed", "completed", RefundStatusCompleted, false},
+			{"rejected", "rejected", RefundStatusRejected, false},
+			{"uppercase", "APPROVED", RefundStatusApproved, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseRefundStatus(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseRefundStatus(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidRefundStatus, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseRefundStatus(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if RefundStatusRequested.String() != "requested" {
+			t.Errorf("String() = %v, want requested", RefundStatusRequested.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !RefundStatusRequested.Valid() {
+			t.Error("RefundStatusRequested.Valid() = false, want true")
+		}
+		if RefundStatus("invalid").Valid() {
+			t.Error("RefundStatus(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, RefundStatusRequested, "requested", ParseRefundStatus)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, RefundStatusRequested, "requested", func(r *RefundStatus) error {
+			return r.UnmarshalText([]byte("requested"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, RefundStatusRequested, "requested",
+			func(src interface{}) (*RefundStatus, error) {
+				var r RefundStatus
+				err := r.Scan(src)
+				return &r, err
+			},
+			func(r RefundStatus) (interface{}, error) { return r.Value() })
+	})
+
+	t.Run("CanTransitionTo", func(t *testing.T) {
+		allowed := map[RefundStatus][]RefundStatus{
+			RefundStatusRequested:  {RefundStatusApproved, RefundStatusRejected},
+			RefundStatusApproved:   {RefundStatusProcessing},
+			RefundStatusProcessing: {RefundStatusCompleted},
+			RefundStatusCompleted:  nil,
+			RefundStatusRejected:   nil,
+		}
+
+		all := append(RefundStatusValues(), RefundStatus("invalid"))
+		for _, from := range all {
+			for _, to := range all {
+				want := slices.Contains(allowed[from], to)
+				if got := from.CanTransitionTo(to); got != want {
+					t.Errorf("%q.CanTransitionTo(%q) = %v, want %v", from, to, got, want)
+				}
+				err := ValidateRefundTransition(from, to)
+				if want && err != nil {
+					t.Errorf("ValidateRefundTransition(%q, %q) error = %v, want nil", from, to, err)
+				}
+				if !want && !errors.Is(err, ErrInvalidRefundTransition) {
+					t.Errorf("ValidateRefundTransition(%q, %q) error = %v, want ErrInvalidRefundTransition", from, to, err)
+				}
+			}
+			if got, want := from.IsTerminal(), from.Valid() && len(allowed[from]) == 0; got != want {
+				t.Errorf("%q.IsTerminal() = %v, want %v", from, got, want)
+			}
+		}
+	})
+}
+
+// TestAdminRole tests AdminRole enum
+func TestAdminRole(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[AdminRole]{
+			{"support_agent", "support_agent", AdminRoleSupportAgent, false},
+			{"supervisor", "supervisor", AdminRoleSupervisor, false},
+			{"finance", "finance", AdminRoleFinance, false},
+			{"safety", "safety", AdminRoleSafety, false},
+			{"superadmin", "superadmin", AdminRoleSuperadmin, false},
+			{"uppercase", "FINANCE", AdminRoleFinance, false},
+			{"alias support", "Support", AdminRoleSupportAgent, false},
+			{"alias super_admin", "super_admin", AdminRoleSuperadmin, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseAdminRole(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseAdminRole(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidAdminRole, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseAdminRole(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if AdminRoleSupportAgent.String() != "support_agent" {
+			t.Errorf("String() = %v, want support_agent", AdminRoleSupportAgent.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !AdminRoleSupportAgent.Valid() {
+			t.Error("AdminRoleSupportAgent.Valid() = false, want true")
+		}
+		if AdminRole("invalid").Valid() {
+			t.Error("AdminRole(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, AdminRoleSupportAgent, "support_agent", ParseAdminRole)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, AdminRoleSupportAgent, "support_agent", func(r *AdminRole) error {
+			return r.UnmarshalText([]byte("support_agent"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, AdminRoleSupportAgent, "support_agent",
+			func(src interface{}) (*AdminRole, error) {
+				var r AdminRole
+				err := r.Scan(src)
+				return &r, err
+			},
+			func(r AdminRole) (interface{}, error) { return r.Value() })
+	})
+
+	t.Run("grant matrix", func(t *testing.T) {
+		granted := map[AdminRole][]Permission{
+			AdminRoleSupportAgent: {PermissionViewRides},
+			AdminRoleSupervisor:   {PermissionViewRides, PermissionRefundPayment, PermissionSuspendDriver},
+			AdminRoleFinance:      {PermissionViewRides, PermissionRefundPayment},
+			AdminRoleSafety:       {PermissionViewRides, PermissionSuspendDriver, PermissionResolveIncident},
+			AdminRoleSuperadmin:   PermissionValues(),
+		}
+
+		roles := append(AdminRoleValues(), AdminRole("invalid"))
+		perms := append(PermissionValues(), Permission("invalid"))
+		for _, role := range roles {
+			for _, p := range perms {
+				want := slices.Contains(granted[role], p)
+				if got := role.Has(p); got != want {
+					t.Errorf("%q.Has(%q) = %v, want %v", role, p, got, want)
+				}
+			}
+			if got := role.Permissions(); !slices.Equal(got, granted[role]) {
+				t.Errorf("%q.Permissions() = %v, want %v", role, got, granted[role])
+			}
+		}
+	})
+
+	t.Run("superadmin has everything", func(t *testing.T) {
+		for _, p := range PermissionValues() {
+			if !AdminRoleSuperadmin.Has(p) {
+				t.Errorf("superadmin missing %q", p)
+			}
+		}
+	})
+
+	t.Run("Permissions returns copy", func(t *testing.T) {
+		perms := AdminRoleFinance.Permissions()
+		perms[0] = PermissionManagePromotions
+		if AdminRoleFinance.Has(PermissionManagePromotions) {
+			t.Error("modifying Permissions() result changed the grant table")
+		}
+	})
+}
+
+// TestPermission tests Permission enum
+func TestPermission(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[Permission]{
+			{"view_rides", "view_rides", PermissionViewRides, false},
+			{"refund_payment", "refund_payment", PermissionRefundPayment, false},
+			{"suspend_driver", "suspend_driver", PermissionSuspendDriver, false},
+			{"resolve_incident", "resolve_incident", PermissionResolveIncident, false},
+			{"manage_promotions", "manage_promotions", PermissionManagePromotions, false},
+			{"uppercase", "VIEW_RIDES", PermissionViewRides, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParsePermission(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParsePermission(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidPermission, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParsePermission(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if PermissionViewRides.String() != "view_rides" {
+			t.Errorf("String() = %v, want view_rides", PermissionViewRides.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !PermissionViewRides.Valid() {
+			t.Error("PermissionViewRides.Valid() = false, want true")
+		}
+		if Permission("invalid").Valid() {
+			t.Error("Permission(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, PermissionViewRides, "view_rides", ParsePermission)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, PermissionViewRides, "view_rides", func(p *Permission) error {
+			return p.UnmarshalText([]byte("view_rides"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, PermissionViewRides, "view_rides",
+			func(src interface{}) (*Permission, error) {
+				var p Permission
+				err := p.Scan(src)
+				return &p, err
+			},
+			func(p Permission) (interface{}, error) { return p.Value() })
+	})
+}
+
+// TestPhoneVerificationStatus tests PhoneVerificationStatus enum
+func TestPhoneVerificationStatus(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[PhoneVerificationStatus]{
+			{"unverified", "unverified", PhoneVerificationStatusUnverified, false},
+			{"pending", "pending", PhoneVerificationStatusPending, false},
+			{"verified", "verified", PhoneVerificationStatusVerified, false},
+			{"failed", "failed", PhoneVerificationStatusFailed, false},
+			{"blocked", "blocked", PhoneVerificationStatusBlocked, false},
+			{"uppercase", "VERIFIED", PhoneVerificationStatusVerified, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParsePhoneVerificationStatus(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParsePhoneVerificationStatus(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidPhoneVerificationStatus, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParsePhoneVerificationStatus(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if PhoneVerificationStatusUnverified.String() != "unverified" {
+			t.Errorf("String() = %v, want unverified", PhoneVerificationStatusUnverified.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !PhoneVerificationStatusUnverified.Valid() {
+			t.Error("PhoneVerificationStatusUnverified.Valid() = false, want true")
+		}
+		if PhoneVerificationStatus("invalid").Valid() {
+			t.Error("PhoneVerificationStatus(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, PhoneVerificationStatusUnverified, "unverified", ParsePhoneVerificationStatus)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, PhoneVerificationStatusUnverified, "unverified", func(p *PhoneVerificationStatus) error {
+			return p.UnmarshalText([]byte("unverified"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, PhoneVerificationStatusUnverified, "unverified",
+			func(src interface{}) (*PhoneVerificationStatus, error) {
+				var p PhoneVerificationStatus
+				err := p.Scan(src)
+				return &p, err
+			},
+			func(p PhoneVerificationStatus) (interface{}, error) { return p.Value() })
+	})
+
+	t.Run("IsUsable and CanRetry", func(t *testing.T) {
+		tests := []struct {
+			status       PhoneVerificationStatus
+			wantUsable   bool
+			wantCanRetry bool
+		}{
+			{PhoneVerificationStatusUnverified, false, true},
+			{PhoneVerificationStatusPending, false, false},
+			{PhoneVerificationStatusVerified, true, false},
+			{PhoneVerificationStatusFailed, false, true},
+			{PhoneVerificationStatusBlocked, false, false},
+			{PhoneVerificationStatus("invalid"), false, false},
+		}
+		for _, tt := range tests {
+			if got := tt.status.IsUsable(); got != tt.wantUsable {
+				t.Errorf("%q.IsUsable() = %v, want %v", tt.status, got, tt.wantUsable)
+			}
+			if got := tt.status.CanRetry(); got != tt.wantCanRetry {
+				t.Errorf("%q.CanRetry() = %v, want %v", tt.status, got, tt.wantCanRetry)
+			}
+		}
+	})
+}
+
+// TestDriverVerificationStep tests DriverVerificationStep enum
+func TestDriverVerificationStep(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[DriverVerificationStep]{
+			{"profile", "profile", DriverVerificationStepProfile, false},
+			{"documents", "documents", DriverVerificationStepDocuments, false},
+			{"vehicle", "vehicle", DriverVerificationStepVehicle, false},
+			{"background_check", "background_check", DriverVerificationStepBackgroundCheck, false},
+			{"training", "training", DriverVerificationStepTraining, false},
+			{"activation", "activation", DriverVerificationStepActivation, false},
+			{"uppercase", "BACKGROUND_CHECK", DriverVerificationStepBackgroundCheck, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseDriverVerificationStep(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseDriverVerificationStep(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidDriverVerificationStep, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseDriverVerificationStep(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if DriverVerificationStepProfile.String() != "profile" {
+			t.Errorf("String() = %v, want profile", DriverVerificationStepProfile.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !DriverVerificationStepProfile.Valid() {
+			t.Error("DriverVerificationStepProfile.Valid() = false, want true")
+		}
+		if DriverVerificationStep("invalid").Valid() {
+			t.Error("DriverVerificationStep(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, DriverVerificationStepProfile, "profile", ParseDriverVerificationStep)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, DriverVerificationStepProfile, "profile", func(d *DriverVerificationStep) error {
+			return d.UnmarshalText([]byte("profile"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, DriverVerificationStepProfile, "profile",
+			func(src interface{}) (*DriverVerificationStep, error) {
+				var d DriverVerificationStep
+				err := d.Scan(src)
+				return &d, err
+			},
+			func(d DriverVerificationStep) (interface{}, error) { return d.Value() })
+	})
+
+	t.Run("Order and Next", func(t *testing.T) {
+		steps := DriverVerificationStepValues()
+		for i, step := range steps {
+			if got := step.Order(); got != i+1 {
+				t.Errorf("%q.Order() = %d, want %d", step, got, i+1)
+			}
+			next, ok := step.Next()
+			if i == len(steps)-1 {
+				if ok || next != "" {
+					t.Errorf("%q.Next() = %q, %v, want \"\", false", step, next, ok)
+				}
+				continue
+			}
+			if !ok || next != steps[i+1] {
+				t.Errorf("%q.Next() = %q, %v, want %q, true", step, next, ok, steps[i+1])
+			}
+		}
+
+		invalid := DriverVerificationStep("invalid")
+		if got := invalid.Order(); got != 0 {
+			t.Errorf("invalid.Order() = %d, want 0", got)
+		}
+		if next, ok := invalid.Next(); ok || next != "" {
+			t.Errorf("invalid.Next() = %q, %v, want \"\", false", next, ok)
+		}
+	})
+
+	t.Run("StepsForServiceType", func(t *testing.T) {
+		all := []DriverVerificationStep{
+			DriverVerificationStepProfile,
+			DriverVerificationStepDocuments,
+			DriverVerificationStepVehicle,
+			DriverVerificationStepBackgroundCheck,
+			DriverVerificationStepTraining,
+			DriverVerificationStepActivation,
+		}
+		withoutVehicle := []DriverVerificationStep{
+			DriverVerificationStepProfile,
+			DriverVerificationStepDocuments,
+			DriverVerificationStepBackgroundCheck,
+			DriverVerificationStepTraining,
+			DriverVerificationStepActivation,
+		}
+		tests := []struct {
+			service ServiceType
+			want    []DriverVerificationStep
+		}{
+			{ServiceTypeStandard, all},
+			{ServiceTypeComfort, all},
+			{ServiceTypePremium, all},
+			{ServiceTypeMoto, withoutVehicle},
+			{ServiceType("invalid"), []DriverVerificationStep{}},
+		}
+		for _, tt := range tests {
+			got := StepsForServiceType(tt.service)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("StepsForServiceType(%q) = %v, want %v", tt.service, got, tt.want)
+			}
+			for i := 1; i < len(got); i++ {
+				if got[i-1].Order() >= got[i].Order() {
+					t.Errorf("StepsForServiceType(%q) not in Order: %v", tt.service, got)
+				}
+			}
+		}
+	})
+
+	t.Run("NextFor agrees with StepsForServiceType", func(t *testing.T) {
+		for _, service := range ServiceTypeValues() {
+			steps := StepsForServiceType(service)
+			for i, step := range steps {
+				next, ok := step.NextFor(service)
+				if i == len(steps)-1 {
+					if ok || next != "" {
+						t.Errorf("%q.NextFor(%q) = %q, %v, want \"\", false", step, service, next, ok)
+					}
+					continue
+				}
+				if !ok || next != steps[i+1] {
+					t.Errorf("%q.NextFor(%q) = %q, %v, want %q, true", step, service, next, ok, steps[i+1])
+				}
+			}
+			// Next walks the full checklist, so it agrees only where the
+			// service requires every step.
+			if len(steps) == len(DriverVerificationStepValues()) {
+				for _, step := range steps {
+					next, ok := step.Next()
+					wantNext, wantOK := step.NextFor(service)
+					if next != wantNext || ok != wantOK {
+						t.Errorf("%q.Next() = %q, %v, want NextFor(%q) = %q, %v", step, next, ok, service, wantNext, wantOK)
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("NextFor skips steps outside the checklist", func(t *testing.T) {
+		if next, ok := DriverVerificationStepDocuments.NextFor(ServiceTypeMoto); !ok || next != DriverVerificationStepBackgroundCheck {
+			t.Errorf("documents.NextFor(moto) = %q, %v, want background_check, true", next, ok)
+		}
+		if next, ok := DriverVerificationStepVehicle.NextFor(ServiceTypeMoto); ok || next != "" {
+			t.Errorf("vehicle.NextFor(moto) = %q, %v, want \"\", false", next, ok)
+		}
+		if next, ok := DriverVerificationStepProfile.NextFor(ServiceType("invalid")); ok || next != "" {
+			t.Errorf("profile.NextFor(invalid) = %q, %v, want \"\", false", next, ok)
+		}
+	})
+}
+
+// TestWeekDay tests WeekDay enum
+func TestWeekDay(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[WeekDay]{
+			{"monday", "monday", WeekDayMonday, false},
+			{"tuesday", "tuesday", WeekDayTuesday, false},
+			{"wednesday", "wednesday", WeekDayWednesday, false},
+			{"thursday", "thursday", WeekDayThursday, false},
+			{"friday", "friday", WeekDayFriday, false},
+			{"saturday", "saturday", WeekDaySaturday, false},
+			{"sunday", "sunday", WeekDaySunday, false},
+			{"uppercase", "MONDAY", WeekDayMonday, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseWeekDay(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseWeekDay(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidWeekDay, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseWeekDay(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if WeekDayMonday.String() != "monday" {
+			t.Errorf("String() = %v, want monday", WeekDayMonday.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !WeekDayMonday.Valid() {
+			t.Error("WeekDayMonday.Valid() = false, want true")
+		}
+		if WeekDay("invalid").Valid() {
+			t.Error("WeekDay(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, WeekDayMonday, "monday", ParseWeekDay)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, WeekDayMonday, "monday", func(d *WeekDay) error {
+			return d.UnmarshalText([]byte("monday"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, WeekDayMonday, "monday",
+			func(src interface{}) (*WeekDay, error) {
+				var d WeekDay
+				err := d.Scan(src)
+				return &d, err
+			},
+			func(d WeekDay) (interface{}, error) { return d.Value() })
+	})
+
+	t.Run("Portuguese and abbreviations", func(t *testing.T) {
+		tests := []struct {
+			input string
+			want  WeekDay
+		}{
+			{"seg", WeekDayMonday},
+			{"Segunda-feira", WeekDayMonday},
+			{"ter", WeekDayTuesday},
+			{"terça", WeekDayTuesday},
+			{"terca-feira", WeekDayTuesday},
+			{"qua", WeekDayWednesday},
+			{"quinta", WeekDayThursday},
+			{"sex", WeekDayFriday},
+			{"Sábado", WeekDaySaturday},
+			{"SAB", WeekDaySaturday},
+			{"domingo", WeekDaySunday},
+			{"Mon", WeekDayMonday},
+			{"thu", WeekDayThursday},
+			{"SUN", WeekDaySunday},
+		}
+		for _, tt := range tests {
+			got, err := ParseWeekDay(tt.input)
+			if err != nil || got != tt.want {
+				t.Errorf("ParseWeekDay(%q) = %q, %v, want %q", tt.input, got, err, tt.want)
+			}
+		}
+	})
+
+	t.Run("ISO numbering", func(t *testing.T) {
+		for i, d := range WeekDayValues() {
+			if got := d.ISO(); got != i+1 {
+				t.Errorf("%q.ISO() = %d, want %d", d, got, i+1)
+			}
+			if got := WeekDayOf(d.Weekday()); got != d {
+				t.Errorf("WeekDayOf(%q.Weekday()) = %q, want %q", d, got, d)
+			}
+		}
+		if got := WeekDaySunday.Weekday(); got != time.Sunday {
+			t.Errorf("Sunday.Weekday() = %v, want time.Sunday", got)
+		}
+		if got := WeekDayOf(time.Monday); got != WeekDayMonday {
+			t.Errorf("WeekDayOf(time.Monday) = %q, want monday", got)
+		}
+		if got := WeekDay("invalid").ISO(); got != 0 {
+			t.Errorf("invalid.ISO() = %d, want 0", got)
+		}
+		if !WeekDaySaturday.IsWeekend() || !WeekDaySunday.IsWeekend() || WeekDayFriday.IsWeekend() {
+			t.Error("IsWeekend() should be true only for saturday and sunday")
+		}
+	})
+}
+
+func TestTimeWindow(t *testing.T) {
+	maputo := time.FixedZone("CAT", 2*60*60)
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 3, 15, hour, minute, 0, 0, maputo)
+	}
+
+	t.Run("Parse", func(t *testing.T) {
+		w, err := ParseTimeWindow(" 17:00-19:30 ")
+		if err != nil {
+			t.Fatalf("ParseTimeWindow() error = %v", err)
+		}
+		if w.Start != 17*60 || w.End != 19*60+30 {
+			t.Errorf("ParseTimeWindow() = %+v, want 1020-1170", w)
+		}
+		if w.String() != "17:00-19:30" {
+			t.Errorf("String() = %q, want 17:00-19:30", w.String())
+		}
+
+		for _, input := range []string{"", "17:00", "17:00-17:00", "25:00-26:00", "5pm-7pm", "17:00-24:00", "17:60-18:00"} {
+			if _, err := ParseTimeWindow(input); !errors.Is(err, ErrInvalidTimeWindow) {
+				t.Errorf("ParseTimeWindow(%q) error = %v, want ErrInvalidTimeWindow", input, err)
+			}
+		}
+	})
+
+	t.Run("NewTimeWindow", func(t *testing.T) {
+		for _, tt := range []struct{ start, end int }{{-1, 10}, {0, MinutesPerDay}, {60, 60}} {
+			if _, err := NewTimeWindow(tt.start, tt.end); !errors.Is(err, ErrInvalidTimeWindow) {
+				t.Errorf("NewTimeWindow(%d, %d) error = %v, want ErrInvalidTimeWindow", tt.start, tt.end, err)
+			}
+		}
+	})
+
+	t.Run("Contains", func(t *testing.T) {
+		evening, _ := ParseTimeWindow("17:00-19:00")
+		tests := []struct {
+			time time.Time
+			want bool
+		}{
+			{at(16, 59), false},
+			{at(17, 0), true},
+			{at(18, 30), true},
+			{at(18, 59), true},
+			{at(19, 0), false},
+		}
+		for _, tt := range tests {
+			if got := evening.Contains(tt.time, nil); got != tt.want {
+				t.Errorf("Contains(%s) = %v, want %v", tt.time.Format("15:04"), got, tt.want)
+			}
+		}
+	})
+
+	t.Run("Contains overnight", func(t *testing.T) {
+		night, _ := ParseTimeWindow("22:00-05:00")
+		if !night.IsOvernight() {
+			t.Fatal("IsOvernight() = false, want true")
+		}
+		tests := []struct {
+			time time.Time
+			want bool
+		}{
+			{at(21, 59), false},
+			{at(22, 0), true},
+			{at(23, 59), true},
+			{at(0, 0), true},
+			{at(4, 59), true},
+			{at(5, 0), false},
+			{at(12, 0), false},
+		}
+		for _, tt := range tests {
+			if got := night.Contains(tt.time, nil); got != tt.want {
+				t.Errorf("Contains(%s) = %v, want %v", tt.time.Format("15:04"), got, tt.want)
+			}
+		}
+	})
+
+	t.Run("Contains converts location", func(t *testing.T) {
+		evening, _ := ParseTimeWindow("17:00-19:00")
+		utc := time.Date(2024, 3, 15, 15, 30, 0, 0, time.UTC) // 17:30 in Maputo
+		if evening.Contains(utc, time.UTC) {
+			t.Error("Contains(15:30 UTC, UTC) = true, want false")
+		}
+		if !evening.Contains(utc, maputo) {
+			t.Error("Contains(15:30 UTC, CAT) = false, want true")
+		}
+	})
+
+	t.Run("zero value", func(t *testing.T) {
+		var w TimeWindow
+		if !w.IsZero() || w.Contains(at(0, 0), nil) {
+			t.Error("zero window should be unset and contain nothing")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		night, _ := ParseTimeWindow("22:00-05:00")
+		data, err := json.Marshal(night)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		if string(data) != `"22:00-05:00"` {
+			t.Errorf("Marshal() = %s, want \"22:00-05:00\"", data)
+		}
+
+		var decoded TimeWindow
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if decoded != night {
+			t.Errorf("Unmarshal() = %+v, want %+v", decoded, night)
+		}
+
+		data, err = json.Marshal(TimeWindow{})
+		if err != nil || string(data) != "null" {
+			t.Errorf("Marshal(zero) = %s, %v, want null", data, err)
+		}
+		if err := json.Unmarshal([]byte("null"), &decoded); err != nil || !decoded.IsZero() {
+			t.Errorf("Unmarshal(null) = %+v, %v, want zero", decoded, err)
+		}
+
+		if _, err := json.Marshal(TimeWindow{Start: 10, End: 10}); !errors.Is(err, ErrInvalidTimeWindow) {
+			t.Errorf("Marshal(invalid) error = %v, want ErrInvalidTimeWindow", err)
+		}
+		if err := json.Unmarshal([]byte(`"evening"`), &decoded); !errors.Is(err, ErrInvalidTimeWindow) {
+			t.Errorf("Unmarshal(invalid) error = %v, want ErrInvalidTimeWindow", err)
+		}
+	})
+}
+
+// TestAccountType tests AccountType enum
+func TestAccountType(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[AccountType]{
+			{"individual", "individual", AccountTypeIndividual, false},
+			{"business", "business", AccountTypeBusiness, false},
+			{"fleet_operator", "fleet_operator", AccountTypeFleetOperator, false},
+			{"uppercase", "BUSINESS", AccountTypeBusiness, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseAccountType(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseAccountType(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidAccountType, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseAccountType(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if AccountTypeIndividual.String() != "individual" {
+			t.Errorf("String() = %v, want individual", AccountTypeIndividual.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !AccountTypeIndividual.Valid() {
+			t.Error("AccountTypeIndividual.Valid() = false, want true")
+		}
+		if AccountType("invalid").Valid() {
+			t.Error("AccountType(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, AccountTypeIndividual, "individual", ParseAccountType)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, AccountTypeIndividual, "individual", func(a *AccountType) error {
+			return a.UnmarshalText([]byte("individual"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, AccountTypeIndividual, "individual",
+			func(src interface{}) (*AccountType, error) {
+				var a AccountType
+				err := a.Scan(src)
+				return &a, err
+			},
+			func(a AccountType) (interface{}, error) { return a.Value() })
+	})
+
+	t.Run("IsCorporate and RequiresTaxID", func(t *testing.T) {
+		tests := []struct {
+			account       AccountType
+			wantCorporate bool
+			wantTaxID     bool
+		}{
+			{AccountTypeIndividual, false, false},
+			{AccountTypeBusiness, true, true},
+			{AccountTypeFleetOperator, true, false},
+			{AccountType("invalid"), false, false},
+		}
+		for _, tt := range tests {
+			if got := tt.account.IsCorporate(); got != tt.wantCorporate {
+				t.Errorf("%q.IsCorporate() = %v, want %v", tt.account, got, tt.wantCorporate)
+			}
+			if got := tt.account.RequiresTaxID(); got != tt.wantTaxID {
+				t.Errorf("%q.RequiresTaxID() = %v, want %v", tt.account, got, tt.wantTaxID)
+			}
+		}
+	})
+}
+
+// TestFareAdjustmentReason tests FareAdjustmentReason enum
+func TestFareAdjustmentReason(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[FareAdjustmentReason]{
+			{"route_deviation", "route_deviation", FareAdjustmentReasonRouteDeviation, false},
+			{"waiting_time", "waiting_time", FareAdjustmentReasonWaitingTime, false},
+			{"toll", "toll", FareAdjustmentReasonToll, false},
+			{"cleaning_fee", "cleaning_fee", FareAdjustmentReasonCleaningFee, false},
+			{"service_failure", "service_failure", FareAdjustmentReasonServiceFailure, false},
+			{"price_error", "price_error", FareAdjustmentReasonPriceError, false},
+			{"goodwill", "goodwill", FareAdjustmentReasonGoodwill, false},
+			{"uppercase", "TOLL", FareAdjustmentReasonToll, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseFareAdjustmentReason(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseFareAdjustmentReason(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidFareAdjustmentReason, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseFareAdjustmentReason(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if FareAdjustmentReasonRouteDeviation.String() != "route_deviation" {
+			t.Errorf("String() = %v, want route_deviation", FareAdjustmentReasonRouteDeviation.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !FareAdjustmentReasonRouteDeviation.Valid() {
+			t.Error("FareAdjustmentReasonRouteDeviation.Valid() = false, want true")
+		}
+		if FareAdjustmentReason("invalid").Valid() {
+			t.Error("FareAdjustmentReason(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, FareAdjustmentReasonRouteDeviation, "route_deviation", ParseFareAdjustmentReason)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, FareAdjustmentReasonRouteDeviation, "route_deviation", func(f *FareAdjustmentReason) error {
+			return f.UnmarshalText([]byte("route_deviation"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, FareAdjustmentReasonRouteDeviation, "route_deviation",
+			func(src interface{}) (*FareAdjustmentReason, error) {
+				var f FareAdjustmentReason
+				err := f.Scan(src)
+				return &f, err
+			},
+			func(f FareAdjustmentReason) (interface{}, error) { return f.Value() })
+	})
+
+	t.Run("rules", func(t *testing.T) {
+		tests := []struct {
+			reason       FareAdjustmentReason
+			wantSign     int
+			wantEvidence bool
+		}{
+			{FareAdjustmentReasonRouteDeviation, 0, false},
+			{FareAdjustmentReasonWaitingTime, +1, false},
+			{FareAdjustmentReasonToll, +1, true},
+			{FareAdjustmentReasonCleaningFee, +1, true},
+			{FareAdjustmentReasonServiceFailure, -1, false},
+			{FareAdjustmentReasonPriceError, 0, false},
+			{FareAdjustmentReasonGoodwill, -1, false},
+			{FareAdjustmentReason("invalid"), 0, false},
+		}
+		if len(tests)-1 != len(FareAdjustmentReasonValues()) {
+			t.Fatalf("sign table covers %d reasons, want %d", len(tests)-1, len(FareAdjustmentReasonValues()))
+		}
+		for _, tt := range tests {
+			if got := tt.reason.AllowedSign(); got != tt.wantSign {
+				t.Errorf("%q.AllowedSign() = %d, want %d", tt.reason, got, tt.wantSign)
+			}
+			if got := tt.reason.RequiresEvidence(); got != tt.wantEvidence {
+				t.Errorf("%q.RequiresEvidence() = %v, want %v", tt.reason, got, tt.wantEvidence)
+			}
+		}
+	})
+}
+
+// TestDisputeStatus tests DisputeStatus enum
+func TestDisputeStatus(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[DisputeStatus]{
+			{"opened", "opened", DisputeStatusOpened, false},
+			{"awaiting_customer", "awaiting_customer", DisputeStatusAwaitingCustomer, false},
+			{"awaiting_partner", "awaiting_partner", DisputeStatusAwaitingPartner, false},
+			{"resolved_in_favor_rider", "resolved_in_favor_rider", DisputeStatusResolvedInFavorRider, false},
+			{"resolved_in_favor_driver", "resolved_in_favor_driver", DisputeStatusResolvedInFavorDriver, false},
+			{"withdrawn", "withdrawn", DisputeStatusWithdrawn, false},
+			{"uppercase", "WITHDRAWN", DisputeStatusWithdrawn, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseDisputeStatus(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseDisputeStatus(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidDisputeStatus, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseDisputeStatus(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if DisputeStatusOpened.String() != "opened" {
+			t.Errorf("String() = %v, want opened", DisputeStatusOpened.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !DisputeStatusOpened.Valid() {
+			t.Error("DisputeStatusOpened.Valid() = false, want true")
+		}
+		if DisputeStatus("invalid").Valid() {
+			t.Error("DisputeStatus(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, DisputeStatusOpened, "opened", ParseDisputeStatus)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, DisputeStatusOpened, "opened", func(d *DisputeStatus) error {
+			return d.UnmarshalText([]byte("opened"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, DisputeStatusOpened, "opened",
+			func(src interface{}) (*DisputeStatus, error) {
+				var d DisputeStatus
+				err := d.Scan(src)
+				return &d, err
+			},
+			func(d DisputeStatus) (interface{}, error) { return d.Value() })
+	})
+
+	t.Run("CanTransitionTo", func(t *testing.T) {
+		closed := []DisputeStatus{
+			DisputeStatusResolvedInFavorRider, DisputeStatusResolvedInFavorDriver, DisputeStatusWithdrawn,
+		}
+		allowed := map[DisputeStatus][]DisputeStatus{
+			DisputeStatusOpened:           append([]DisputeStatus{DisputeStatusAwaitingCustomer, DisputeStatusAwaitingPartner}, closed...),
+			DisputeStatusAwaitingCustomer: append([]DisputeStatus{DisputeStatusOpened, DisputeStatusAwaitingPartner}, closed...),
+			DisputeStatusAwaitingPartner:  append([]DisputeStatus{DisputeStatusOpened, DisputeStatusAwaitingCustomer}, closed...),
+		}
+
+		all := append(DisputeStatusValues(), DisputeStatus("invalid"))
+		for _, from := range all {
+			for _, to := range all {
+				want := slices.Contains(allowed[from], to)
+				if got := from.CanTransitionTo(to); got != want {
+					t.Errorf("%q.CanTransitionTo(%q) = %v, want %v", from, to, got, want)
+				}
+				err := ValidateDisputeTransition(from, to)
+				if want && err != nil {
+					t.Errorf("ValidateDisputeTransition(%q, %q) error = %v, want nil", from, to, err)
+				}
+				if !want && !errors.Is(err, ErrInvalidDisputeTransition) {
+					t.Errorf("ValidateDisputeTransition(%q, %q) error = %v, want ErrInvalidDisputeTransition", from, to, err)
+				}
+			}
+			if got, want := from.IsClosed(), slices.Contains(closed, from); got != want {
+				t.Errorf("%q.IsClosed() = %v, want %v", from, got, want)
+			}
+		}
+	})
+
+	t.Run("IsResolved and SLAHours", func(t *testing.T) {
+		tests := []struct {
+			status       DisputeStatus
+			wantResolved bool
+			wantSLA      int
+		}{
+			{DisputeStatusOpened, false, 24},
+			{DisputeStatusAwaitingCustomer, false, 72},
+			{DisputeStatusAwaitingPartner, false, 48},
+			{DisputeStatusResolvedInFavorRider, true, 0},
+			{DisputeStatusResolvedInFavorDriver, true, 0},
+			{DisputeStatusWithdrawn, false, 0},
+			{DisputeStatus("invalid"), false, 0},
+		}
+		for _, tt := range tests {
+			if got := tt.status.IsResolved(); got != tt.wantResolved {
+				t.Errorf("%q.IsResolved() = %v, want %v", tt.status, got, tt.wantResolved)
+			}
+			if got := tt.status.SLAHours(); got != tt.wantSLA {
+				t.Errorf("%q.SLAHours() = %d, want %d", tt.status, got, tt.wantSLA)
+			}
+		}
+	})
+}
+
+// TestTripPurpose tests TripPurpose enum
+func TestTripPurpose(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[TripPurpose]{
+			{"business", "business", TripPurposeBusiness, false},
+			{"personal", "personal", TripPurposePersonal, false},
+			{"shared", "shared", TripPurposeShared, false},
+			{"delivery", "delivery", TripPurposeDelivery, false},
+			{"uppercase", "DELIVERY", TripPurposeDelivery, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseTripPurpose(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseTripPurpose(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidTripPurpose, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseTripPurpose(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if TripPurposeBusiness.String() != "business" {
+			t.Errorf("String() = %v, want business", TripPurposeBusiness.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !TripPurposeBusiness.Valid() {
+			t.Error("TripPurposeBusiness.Valid() = false, want true")
+		}
+		if TripPurpose("invalid").Valid() {
+			t.Error("TripPurpose(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, TripPurposeBusiness, "business", ParseTripPurpose)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, TripPurposeBusiness, "business", func(p *TripPurpose) error {
+			return p.UnmarshalText([]byte("business"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, TripPurposeBusiness, "business",
+			func(src interface{}) (*TripPurpose, error) {
+				var p TripPurpose
+				err := p.Scan(src)
+				return &p, err
+			},
+			func(p TripPurpose) (interface{}, error) { return p.Value() })
+	})
+
+	t.Run("IsExpenseable", func(t *testing.T) {
+		tests := []struct {
+			purpose TripPurpose
+			want    bool
+		}{
+			{TripPurposeBusiness, true},
+			{TripPurposePersonal, false},
+			{TripPurposeShared, false},
+			{TripPurposeDelivery, true},
+			{TripPurpose("invalid"), false},
+		}
+		for _, tt := range tests {
+			if got := tt.purpose.IsExpenseable(); got != tt.want {
+				t.Errorf("%q.IsExpenseable() = %v, want %v", tt.purpose, got, tt.want)
+			}
+		}
+	})
+}
+
+// TestWalletStatus tests WalletStatus enum
+func TestWalletStatus(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[WalletStatus]{
+			{"active", "active", WalletStatusActive, false},
+			{"frozen", "frozen", WalletStatusFrozen, false},
+			{"closed", "closed", WalletStatusClosed, false},
+			{"pending_closure", "pending_closure", WalletStatusPendingClosure, false},
+			{"uppercase", "FROZEN", WalletStatusFrozen, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseWalletStatus(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseWalletStatus(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidWalletStatus, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseWalletStatus(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if WalletStatusActive.String() != "active" {
+			t.Errorf("String() = %v, want active", WalletStatusActive.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !WalletStatusActive.Valid() {
+			t.Error("WalletStatusActive.Valid() = false, want true")
+		}
+		if WalletStatus("invalid").Valid() {
+			t.Error("WalletStatus(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, WalletStatusActive, "active", ParseWalletStatus)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, WalletStatusActive, "active", func(w *WalletStatus) error {
+			return w.UnmarshalText([]byte("active"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, WalletStatusActive, "active",
+			func(src interface{}) (*WalletStatus, error) {
+				var w WalletStatus
+				err := w.Scan(src)
+				return &w, err
+			},
+			func(w WalletStatus) (interface{}, error) { return w.Value() })
+	})
+
+	t.Run("CanTransitionTo", func(t *testing.T) {
+		allowed := map[WalletStatus][]WalletStatus{
+			WalletStatusActive:         {WalletStatusFrozen, WalletStatusPendingClosure},
+			WalletStatusFrozen:         {WalletStatusActive, WalletStatusClosed},
+			WalletStatusPendingClosure: {WalletStatusClosed},
+		}
+
+		all := append(WalletStatusValues(), WalletStatus("invalid"))
+		for _, from := range all {
+			for _, to := range all {
+				want := slices.Contains(allowed[from], to)
+				if got := from.CanTransitionTo(to); got != want {
+					t.Errorf("%q.CanTransitionTo(%q) = %v, want %v", from, to, got, want)
+				}
+				err := ValidateWalletTransition(from, to)
+				if want && err != nil {
+					t.Errorf("ValidateWalletTransition(%q, %q) error = %v, want nil", from, to, err)
+				}
+				if !want && !errors.Is(err, ErrInvalidWalletTransition) {
+					t.Errorf("ValidateWalletTransition(%q, %q) error = %v, want ErrInvalidWalletTransition", from, to, err)
+				}
+			}
+		}
+	})
+
+	t.Run("CanDebit and CanCredit", func(t *testing.T) {
+		tests := []struct {
+			status     WalletStatus
+			wantDebit  bool
+			wantCredit bool
+		}{
+			{WalletStatusActive, true, true},
+			{WalletStatusFrozen, false, true},
+			{WalletStatusPendingClosure, false, true},
+			{WalletStatusClosed, false, false},
+			{WalletStatus("invalid"), false, false},
+		}
+		for _, tt := range tests {
+			if got := tt.status.CanDebit(); got != tt.wantDebit {
+				t.Errorf("%q.CanDebit() = %v, want %v", tt.status, got, tt.wantDebit)
+			}
+			if got := tt.status.CanCredit(); got != tt.wantCredit {
+				t.Errorf("%q.CanCredit() = %v, want %v", tt.status, got, tt.wantCredit)
+			}
+		}
+	})
+}
+
+// TestScheduledRideStatus tests ScheduledRideStatus enum
+func TestScheduledRideStatus(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[ScheduledRideStatus]{
+			{"scheduled", "scheduled", ScheduledRideStatusScheduled, false},
+			{"reminder_sent", "reminder_sent", ScheduledRideStatusReminderSent, false},
+			{"dispatching", "dispatching", ScheduledRideStatusDispatching, false},
+			{"converted", "converted", ScheduledRideStatusConverted, false},
+			{"expired", "expired", ScheduledRideStatusExpired, false},
+			{"cancelled", "cancelled", ScheduledRideStatusCancelled, false},
+			{"uppercase", "DISPATCHING", ScheduledRideStatusDispatching, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseScheduledRideStatus(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseScheduledRideStatus(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidScheduledRideStatus, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseScheduledRideStatus(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if ScheduledRideStatusScheduled.String() != "scheduled" {
+			t.Errorf("String() = %v, want scheduled", ScheduledRideStatusScheduled.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !ScheduledRideStatusScheduled.Valid() {
+			t.Error("ScheduledRideStatusScheduled.Valid() = false, want true")
+		}
+		if ScheduledRideStatus("invalid").Valid() {
+			t.Error("ScheduledRideStatus(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, ScheduledRideStatusScheduled, "scheduled", ParseScheduledRideStatus)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, ScheduledRideStatusScheduled, "scheduled", func(s *ScheduledRideStatus) error {
+			return s.UnmarshalText([]byte("scheduled"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, ScheduledRideStatusScheduled, "scheduled",
+			func(src interface{}) (*ScheduledRideStatus, error) {
+				var s ScheduledRideStatus
+				err := s.Scan(src)
+				return &s, err
+			},
+			func(s ScheduledRideStatus) (interface{}, error) { return s.Value() })
+	})
+
+	t.Run("CanTransitionTo", func(t *testing.T) {
+		allowed := map[ScheduledRideStatus][]ScheduledRideStatus{
+			ScheduledRideStatusScheduled: {
+				ScheduledRideStatusReminderSent, ScheduledRideStatusDispatching,
+				ScheduledRideStatusExpired, ScheduledRideStatusCancelled,
+			},
+			ScheduledRideStatusReminderSent: {
+				ScheduledRideStatusDispatching, ScheduledRideStatusExpired, ScheduledRideStatusCancelled,
+			},
+			ScheduledRideStatusDispatching: {
+				ScheduledRideStatusConverted, ScheduledRideStatusExpired, ScheduledRideStatusCancelled,
+			},
+		}
+
+		all := append(ScheduledRideStatusValues(), ScheduledRideStatus("invalid"))
+		for _, from := range all {
+			for _, to := range all {
+				want := slices.Contains(allowed[from], to)
+				if got := from.CanTransitionTo(to); got != want {
+					t.Errorf("%q.CanTransitionTo(%q) = %v, want %v", from, to, got, want)
+				}
+				err := ValidateScheduledRideTransition(from, to)
+				if want && err != nil {
+					t.Errorf("ValidateScheduledRideTransition(%q, %q) error = %v, want nil", from, to, err)
+				}
+				if !want && !errors.Is(err, ErrInvalidScheduledRideTransition) {
+					t.Errorf("ValidateScheduledRideTransition(%q, %q) error = %v, want ErrInvalidScheduledRideTransition", from, to, err)
+				}
+			}
+		}
+	})
+
+	t.Run("IsPreDispatch and ToRideStatus", func(t *testing.T) {
+		tests := []struct {
+			status      ScheduledRideStatus
+			wantPre     bool
+			wantRide    RideStatus
+			wantMapping bool
+		}{
+			{ScheduledRideStatusScheduled, true, "", false},
+			{ScheduledRideStatusReminderSent, true, "", false},
+			{ScheduledRideStatusDispatching, false, "", false},
+			{ScheduledRideStatusConverted, false, RideStatusRequested, true},
+			{ScheduledRideStatusExpired, false, "", false},
+			{ScheduledRideStatusCancelled, false, "", false},
+			{ScheduledRideStatus("invalid"), false, "", false},
+		}
+		for _, tt := range tests {
+			if got := tt.status.IsPreDispatch(); got != tt.wantPre {
+				t.Errorf("%q.IsPreDispatch() = %v, want %v", tt.status, got, tt.wantPre)
+			}
+			ride, ok := tt.status.ToRideStatus()
+			if ride != tt.wantRide || ok != tt.wantMapping {
+				t.Errorf("%q.ToRideStatus() = %q, %v, want %q, %v", tt.status, ride, ok, tt.wantRide, tt.wantMapping)
+			}
+		}
+	})
+}
+
+// TestTipStatus tests TipStatus enum
+func TestTipStatus(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[TipStatus]{
+			{"offered", "offered", TipStatusOffered, false},
+			{"pending_capture", "pending_capture", TipStatusPendingCapture, false},
+			{"captured", "captured", TipStatusCaptured, false},
+			{"failed", "failed", TipStatusFailed, false},
+			{"reversed", "reversed", TipStatusReversed, false},
+			{"uppercase", "CAPTURED", TipStatusCaptured, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseTipStatus(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseTipStatus(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidTipStatus, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseTipStatus(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if TipStatusOffered.String() != "offered" {
+			t.Errorf("String() = %v, want offered", TipStatusOffered.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !TipStatusOffered.Valid() {
+			t.Error("TipStatusOffered.Valid() = false, want true")
+		}
+		if TipStatus("invalid").Valid() {
+			t.Error("TipStatus(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, TipStatusOffered, "offered", ParseTipStatus)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, TipStatusOffered, "offered", func(ts *TipStatus) error {
+			return ts.UnmarshalText([]byte("offered"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, TipStatusOffered, "offered",
+			func(src interface{}) (*TipStatus, error) {
+				var ts TipStatus
+				err := ts.Scan(src)
+				return &ts, err
+			},
+			func(ts TipStatus) (interface{}, error) { return ts.Value() })
+	})
+
+	t.Run("CanTransitionTo", func(t *testing.T) {
+		allowed := map[TipStatus][]TipStatus{
+			TipStatusOffered:        {TipStatusPendingCapture},
+			TipStatusPendingCapture: {TipStatusCaptured, TipStatusFailed},
+			TipStatusCaptured:       {TipStatusReversed},
+			TipStatusFailed:         {TipStatusPendingCapture},
+		}
+
+		all := append(TipStatusValues(), TipStatus("invalid"))
+		for _, from := range all {
+			for _, to := range all {
+				want := slices.Contains(allowed[from], to)
+				if got := from.CanTransitionTo(to); got != want {
+					t.Errorf("%q.CanTransitionTo(%q) = %v, want %v", from, to, got, want)
+				}
+				err := ValidateTipTransition(from, to)
+				if want && err != nil {
+					t.Errorf("ValidateTipTransition(%q, %q) error = %v, want nil", from, to, err)
+				}
+				if !want && !errors.Is(err, ErrInvalidTipTransition) {
+					t.Errorf("ValidateTipTransition(%q, %q) error = %v, want ErrInvalidTipTransition", from, to, err)
+				}
+			}
+		}
+	})
+}
+
+// TestFleetOwnershipType tests FleetOwnershipType enum
+func TestFleetOwnershipType(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[FleetOwnershipType]{
+			{"owner_operated", "owner_operated", FleetOwnershipTypeOwnerOperated, false},
+			{"company_fleet", "company_fleet", FleetOwnershipTypeCompanyFleet, false},
+			{"rental", "rental", FleetOwnershipTypeRental, false},
+			{"franchise", "franchise", FleetOwnershipTypeFranchise, false},
+			{"uppercase", "RENTAL", FleetOwnershipTypeRental, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseFleetOwnershipType(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseFleetOwnershipType(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidFleetOwnershipType, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseFleetOwnershipType(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if FleetOwnershipTypeOwnerOperated.String() != "owner_operated" {
+			t.Errorf("String() = %v, want owner_operated", FleetOwnershipTypeOwnerOperated.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !FleetOwnershipTypeOwnerOperated.Valid() {
+			t.Error("FleetOwnershipTypeOwnerOperated.Valid() = false, want true")
+		}
+		if FleetOwnershipType("invalid").Valid() {
+			t.Error("FleetOwnershipType(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, FleetOwnershipTypeOwnerOperated, "owner_operated", ParseFleetOwnershipType)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, FleetOwnershipTypeOwnerOperated, "owner_operated", func(f *FleetOwnershipType) error {
+			return f.UnmarshalText([]byte("owner_operated"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, FleetOwnershipTypeOwnerOperated, "owner_operated",
+			func(src interface{}) (*FleetOwnershipType, error) {
+				var f FleetOwnershipType
+				err := f.Scan(src)
+				return &f, err
+			},
+			func(f FleetOwnershipType) (interface{}, error) { return f.Value() })
+	})
+
+	t.Run("IsEmployerLiable", func(t *testing.T) {
+		tests := []struct {
+			ownership FleetOwnershipType
+			want      bool
+		}{
+			{FleetOwnershipTypeOwnerOperated, false},
+			{FleetOwnershipTypeCompanyFleet, true},
+			{FleetOwnershipTypeRental, false},
+			{FleetOwnershipTypeFranchise, true},
+			{FleetOwnershipType("invalid"), false},
+		}
+		for _, tt := range tests {
+			if got := tt.ownership.IsEmployerLiable(); got != tt.want {
+				t.Errorf("%q.IsEmployerLiable() = %v, want %v", tt.ownership, got, tt.want)
+			}
+		}
+	})
+}
+
+// TestBackgroundCheckStatus tests BackgroundCheckStatus enum
+func TestBackgroundCheckStatus(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[BackgroundCheckStatus]{
+			{"not_started", "not_started", BackgroundCheckStatusNotStarted, false},
+			{"submitted", "submitted", BackgroundCheckStatusSubmitted, false},
+			{"in_progress", "in_progress", BackgroundCheckStatusInProgress, false},
+			{"clear", "clear", BackgroundCheckStatusClear, false},
+			{"consider", "consider", BackgroundCheckStatusConsider, false},
+			{"failed", "failed", BackgroundCheckStatusFailed, false},
+			{"expired", "expired", BackgroundCheckStatusExpired, false},
+			{"provider uppercase", "CONSIDER", BackgroundCheckStatusConsider, false},
+			{"provider adverse", "adverse", BackgroundCheckStatusConsider, false},
+			{"provider adverse uppercase", "ADVERSE", BackgroundCheckStatusConsider, false},
+			{"uppercase", "CLEAR", BackgroundCheckStatusClear, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseBackgroundCheckStatus(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseBackgroundCheckStatus(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidBackgroundCheckStatus, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseBackgroundCheckStatus(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if BackgroundCheckStatusNotStarted.String() != "not_started" {
+			t.Errorf("String() = %v, want not_started", BackgroundCheckStatusNotStarted.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !BackgroundCheckStatusNotStarted.Valid() {
+			t.Error("BackgroundCheckStatusNotStarted.Valid() = false, want true")
+		}
+		if BackgroundCheckStatus("invalid").Valid() {
+			t.Error("BackgroundCheckStatus(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, BackgroundCheckStatusNotStarted, "not_started", ParseBackgroundCheckStatus)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, BackgroundCheckStatusNotStarted, "not_started", func(b *BackgroundCheckStatus) error {
+			return b.UnmarshalText([]byte("not_started"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, BackgroundCheckStatusNotStarted, "not_started",
+			func(src interface{}) (*BackgroundCheckStatus, error) {
+				var b BackgroundCheckStatus
+				err := b.Scan(src)
+				return &b, err
+			},
+			func(b BackgroundCheckStatus) (interface{}, error) { return b.Value() })
+	})
+
+	t.Run("AllowsActivation", func(t *testing.T) {
+		for _, b := range BackgroundCheckStatusValues() {
+			if got, want := b.AllowsActivation(), b == BackgroundCheckStatusClear; got != want {
+				t.Errorf("%q.AllowsActivation() = %v, want %v", b, got, want)
+			}
+		}
+		if BackgroundCheckStatus("invalid").AllowsActivation() {
+			t.Error("invalid.AllowsActivation() = true, want false")
+		}
+	})
+
+	t.Run("NeedsRenewal", func(t *testing.T) {
+		const year = 365 * 24 * time.Hour
+		recent := time.Now().Add(-30 * 24 * time.Hour)
+		old := time.Now().Add(-2 * year)
+
+		tests := []struct {
+			status      BackgroundCheckStatus
+			completedAt time.Time
+			want        bool
+		}{
+			{BackgroundCheckStatusClear, recent, false},
+			{BackgroundCheckStatusClear, old, true},
+			{BackgroundCheckStatusClear, time.Time{}, true},
+			{BackgroundCheckStatusConsider, recent, false},
+			{BackgroundCheckStatusConsider, old, true},
+			{BackgroundCheckStatusExpired, recent, true},
+			{BackgroundCheckStatusExpired, time.Time{}, true},
+			{BackgroundCheckStatusNotStarted, old, false},
+			{BackgroundCheckStatusSubmitted, old, false},
+			{BackgroundCheckStatusInProgress, old, false},
+			{BackgroundCheckStatusFailed, old, false},
+			{BackgroundCheckStatus("invalid"), old, false},
+		}
+		for _, tt := range tests {
+			if got := tt.status.NeedsRenewal(year, tt.completedAt); got != tt.want {
+				t.Errorf("%q.NeedsRenewal(1y, %v) = %v, want %v", tt.status, tt.completedAt, got, tt.want)
+			}
+		}
+	})
+}
+
+// TestNotificationPriority tests NotificationPriority enum
+func TestNotificationPriority(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[NotificationPriority]{
+			{"low", "low", NotificationPriorityLow, false},
+			{"normal", "normal", NotificationPriorityNormal, false},
+			{"high", "high", NotificationPriorityHigh, false},
+			{"critical", "critical", NotificationPriorityCritical, false},
+			{"uppercase", "CRITICAL", NotificationPriorityCritical, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseNotificationPriority(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseNotificationPriority(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidNotificationPriority, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseNotificationPriority(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if NotificationPriorityLow.String() != "low" {
+			t.Errorf("String() = %v, want low", NotificationPriorityLow.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !NotificationPriorityLow.Valid() {
+			t.Error("NotificationPriorityLow.Valid() = false, want true")
+		}
+		if NotificationPriority("invalid").Valid() {
+			t.Error("NotificationPriority(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, NotificationPriorityLow, "low", ParseNotificationPriority)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, NotificationPriorityLow, "low", func(n *NotificationPriority) error {
+			return n.UnmarshalText([]byte("low"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, NotificationPriorityLow, "low",
+			func(src interface{}) (*NotificationPriority, error) {
+				var n NotificationPriority
+				err := n.Scan(src)
+				return &n, err
+			},
+			func(n NotificationPriority) (interface{}, error) { return n.Value() })
+	})
+
+	t.Run("ordering", func(t *testing.T) {
+		values := NotificationPriorityValues()
+		for i, p := range values {
+			if p.Level() != i+1 {
+				t.Errorf("%q.Level() = %d, want %d", p, p.Level(), i+1)
+			}
+			for j, other := range values {
+				if got := p.Less(other); got != (i < j) {
+					t.Errorf("%q.Less(%q) = %v, want %v", p, other, got, i < j)
+				}
+				if got := p.AtLeast(other); got != (i >= j) {
+					t.Errorf("%q.AtLeast(%q) = %v, want %v", p, other, got, i >= j)
+				}
+			}
+		}
+		invalid := NotificationPriority("invalid")
+		if invalid.Level() != 0 {
+			t.Errorf("invalid.Level() = %d, want 0", invalid.Level())
+		}
+		if !invalid.Less(NotificationPriorityLow) {
+			t.Error("invalid.Less(low) = false, want true")
+		}
+	})
+
+	t.Run("PriorityForEmergency", func(t *testing.T) {
+		for _, sev := range IncidentSeverityValues() {
+			p, ok := severityPriorities[sev]
+			if !ok {
+				t.Errorf("IncidentSeverity %q has no priority", sev)
+				continue
+			}
+			if !p.AtLeast(NotificationPriorityHigh) {
+				t.Errorf("severityPriorities[%q] = %q, want at least high", sev, p)
+			}
+		}
+		if len(severityPriorities) != len(IncidentSeverityValues()) {
+			t.Errorf("severityPriorities has %d entries, want %d", len(severityPriorities), len(IncidentSeverityValues()))
+		}
+		for _, e := range EmergencyTypeValues() {
+			want := NotificationPriorityHigh
+			if e.DefaultSeverity() == IncidentSeverityCritical {
+				want = NotificationPriorityCritical
+			}
+			if got := PriorityForEmergency(e); got != want {
+				t.Errorf("PriorityForEmergency(%q) = %q, want %q for severity %q", e, got, want, e.DefaultSeverity())
+			}
+		}
+
+		tests := []struct {
+			emergency EmergencyType
+			want      NotificationPriority
+		}{
+			{EmergencyTypeMedical, NotificationPriorityCritical},
+			{EmergencyTypeAccident, NotificationPriorityCritical},
+			{EmergencyTypeHarassment, NotificationPriorityHigh},
+			{EmergencyTypeOther, NotificationPriorityHigh},
+			{EmergencyType("invalid"), NotificationPriorityCritical},
+		}
+		for _, tt := range tests {
+			if got := PriorityForEmergency(tt.emergency); got != tt.want {
+				t.Errorf("PriorityForEmergency(%q) = %q, want %q", tt.emergency, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("PriorityForRideStatus", func(t *testing.T) {
+		for _, s := range RideStatusValues() {
+			p, ok := rideStatusPriorities[s]
+			if !ok {
+				t.Errorf("RideStatus %q has no priority", s)
+				continue
+			}
+			if !p.Valid() {
+				t.Errorf("rideStatusPriorities[%q] = %q, not a valid priority", s, p)
+			}
+			if got := PriorityForRideStatus(s); got != p {
+				t.Errorf("PriorityForRideStatus(%q) = %q, want %q", s, got, p)
+			}
+		}
+		if len(rideStatusPriorities) != len(RideStatusValues()) {
+			t.Errorf("rideStatusPriorities has %d entries, want %d", len(rideStatusPriorities), len(RideStatusValues()))
+		}
+
+		tests := []struct {
+			status RideStatus
+			want   NotificationPriority
+		}{
+			{RideStatusSearching, NotificationPriorityLow},
+			{RideStatusDriverArriving, NotificationPriorityHigh},
+			{RideStatusCancelled, NotificationPriorityHigh},
+			{RideStatusCompleted, NotificationPriorityNormal},
+			{RideStatusUnknown, NotificationPriorityNormal},
+			{RideStatus("invalid"), NotificationPriorityNormal},
+		}
+		for _, tt := range tests {
+			if got := PriorityForRideStatus(tt.status); got != tt.want {
+				t.Errorf("PriorityForRideStatus(%q) = %q, want %q", tt.status, got, tt.want)
+			}
+		}
+	})
+}
+
+// TestOnboardingStep tests OnboardingStep enum
+func TestOnboardingStep(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[OnboardingStep]{
+			{"profile", "profile", OnboardingStepProfile, false},
+			{"photo", "photo", OnboardingStepPhoto, false},
+			{"documents", "documents", OnboardingStepDocuments, false},
+			{"vehicle", "vehicle", OnboardingStepVehicle, false},
+			{"background_check", "background_check", OnboardingStepBackgroundCheck, false},
+			{"training", "training", OnboardingStepTraining, false},
+			{"approved", "approved", OnboardingStepApproved, false},
+			{"uppercase", "BACKGROUND_CHECK", OnboardingStepBackgroundCheck, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseOnboardingStep(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseOnboardingStep(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidOnboardingStep, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseOnboardingStep(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if OnboardingStepProfile.String() != "profile" {
+			t.Errorf("String() = %v, want profile", OnboardingStepProfile.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !OnboardingStepProfile.Valid() {
+			t.Error("OnboardingStepProfile.Valid() = false, want true")
+		}
+		if OnboardingStep("invalid").Valid() {
+			t.Error("OnboardingStep(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, OnboardingStepProfile, "profile", ParseOnboardingStep)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, OnboardingStepProfile, "profile", func(o *OnboardingStep) error {
+			return o.UnmarshalText([]byte("profile"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, OnboardingStepProfile, "profile",
+			func(src interface{}) (*OnboardingStep, error) {
+				var o OnboardingStep
+				err := o.Scan(src)
+				return &o, err
+			},
+			func(o OnboardingStep) (interface{}, error) { return o.Value() })
+	})
+
+	t.Run("sequence", func(t *testing.T) {
+		want := []OnboardingStep{
+			OnboardingStepProfile,
+			OnboardingStepPhoto,
+			OnboardingStepDocuments,
+			OnboardingStepVehicle,
+			OnboardingStepBackgroundCheck,
+			OnboardingStepTraining,
+			OnboardingStepApproved,
+		}
+
+		var got []OnboardingStep
+		step, ok := OnboardingStepProfile, true
+		for ok {
+			got = append(got, step)
+			step, ok = step.Next()
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("walking Next() = %v, want %v", got, want)
+		}
+
+		for i, s := range want {
+			if s.Index() != i {
+				t.Errorf("%q.Index() = %d, want %d", s, s.Index(), i)
+			}
+		}
+	})
+
+	t.Run("end and invalid", func(t *testing.T) {
+		if next, ok := OnboardingStepApproved.Next(); ok || next != "" {
+			t.Errorf("approved.Next() = %q, %v, want \"\", false", next, ok)
+		}
+		invalid := OnboardingStep("invalid")
+		if invalid.Index() != -1 {
+			t.Errorf("invalid.Index() = %d, want -1", invalid.Index())
+		}
+		if next, ok := invalid.Next(); ok || next != "" {
+			t.Errorf("invalid.Next() = %q, %v, want \"\", false", next, ok)
+		}
+	})
+}
+
+// TestMessageChannel tests MessageChannel enum
+func TestMessageChannel(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []enumTestCase[MessageChannel]{
+			{"chat", "chat", MessageChannelChat, false},
+			{"sms", "sms", MessageChannelSMS, false},
+			{"push", "push", MessageChannelPush, false},
+			{"email", "email", MessageChannelEmail, false},
+			{"whatsapp", "whatsapp", MessageChannelWhatsApp, false},
+			{"uppercase", "WHATSAPP", MessageChannelWhatsApp, false},
+			{"invalid", "unknown", "", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := ParseMessageChannel(tt.input)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ParseMessageChannel(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+					return
+				}
+				if tt.wantErr {
+					assertParseError(t, err, ErrInvalidMessageChannel, tt.input)
+					return
+				}
+				if got != tt.want {
+					t.Errorf("ParseMessageChannel(%q) = %v, want %v", tt.input, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if MessageChannelChat.String() != "chat" {
+			t.Errorf("String() = %v, want chat", MessageChannelChat.String())
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		if !MessageChannelChat.Valid() {
+			t.Error("MessageChannelChat.Valid() = false, want true")
+		}
+		if MessageChannel("invalid").Valid() {
+			t.Error("MessageChannel(\"invalid\").Valid() = true, want false")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testEnumJSON(t, MessageChannelChat, "chat", ParseMessageChannel)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testEnumText(t, MessageChannelChat, "chat", func(ch *MessageChannel) error {
+			return ch.UnmarshalText([]byte("chat"))
+		})
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		testEnumSQL(t, MessageChannelChat, "chat",
+			func(src interface{}) (*MessageChannel, error) {
+				var ch MessageChannel
+				err := ch.Scan(src)
+				return &ch, err
+			},
+			func(ch MessageChannel) (interface{}, error) { return ch.Value() })
+	})
+
+	t.Run("RequiresInternet and SupportsRichMedia", func(t *testing.T) {
+		tests := []struct {
+			channel       MessageChannel
+			wantInternet  bool
+			wantRichMedia bool
+		}{
+			{MessageChannelChat, true, true},
+			{MessageChannelSMS, false, false},
+			{MessageChannelPush, true, false},
+			{MessageChannelEmail, true, false},
+			{MessageChannelWhatsApp, true, true},
+			{MessageChannel("invalid"), false, false},
+		}
+		for _, tt := range tests {
+			if got := tt.channel.RequiresInternet(); got != tt.wantInternet {
+				t.Errorf("%q.RequiresInternet() = %v, want %v", tt.channel, got, tt.wantInternet)
+			}
+			if got := tt.channel.SupportsRichMedia(); got != tt.wantRichMedia {
+				t.Errorf("%q.SupportsRichMedia() = %v, want %v", tt.channel, got, tt.wantRichMedia)
+			}
+		}
+	})
+}
+
+// enumValuesFuncs maps every enum type declared in this package to its
+// Values function. TestEnumValues fails if an enum type is missing here.
+var enumValuesFuncs = map[string]any{
+	"UserType":                UserTypeValues,
+	"UserStatus":              UserStatusValues,
+	"DriverStatus":            DriverStatusValues,
+	"AvailabilityStatus":      AvailabilityStatusValues,
+	"DocumentType":            DocumentTypeValues,
+	"DocumentStatus":          DocumentStatusValues,
+	"VehicleStatus":           VehicleStatusValues,
+	"ServiceType":             ServiceTypeValues,
+	"RideStatus":              RideStatusValues,
+	"CancellationReason":      CancellationReasonValues,
+	"PaymentMethod":           PaymentMethodValues,
+	"PaymentStatus":           PaymentStatusValues,
+	"TransactionType":         TransactionTypeValues,
+	"IncidentSeverity":        IncidentSeverityValues,
+	"IncidentStatus":          IncidentStatusValues,
+	"EmergencyType":           EmergencyTypeValues,
+	"AccidentSeverity":        AccidentSeverityValues,
+	"Currency":                CurrencyValues,
+	"BiometricType":           BiometricTypeValues,
+	"VehicleType":             VehicleTypeValues,
+	"NotificationChannel":     NotificationChannelValues,
+	"VehicleColor":            VehicleColorValues,
+	"Language":                LanguageValues,
+	"PayoutMethod":            PayoutMethodValues,
+	"TicketStatus":            TicketStatusValues,
+	"TicketPriority":          TicketPriorityValues,
+	"AuthProvider":            AuthProviderValues,
+	"PromotionType":           PromotionTypeValues,
+	"PromotionStatus":         PromotionStatusValues,
+	"DevicePlatform":          DevicePlatformValues,
+	"Fault":                   FaultValues,
+	"NotificationType":        NotificationTypeValues,
+	"ExpiryReason":            ExpiryReasonValues,
+	"PaymentGateway":          PaymentGatewayValues,
+	"RideCancelledBy":         RideCancelledByValues,
+	"KYCStatus":               KYCStatusValues,
+	"FuelType":                FuelTypeValues,
+	"RefundReason":            RefundReasonValues,
+	"RefundStatus":            RefundStatusValues,
+	"AdminRole":               AdminRoleValues,
+	"Permission":              PermissionValues,
+	"PhoneVerificationStatus": PhoneVerificationStatusValues,
+	"DriverVerificationStep":  DriverVerificationStepValues,
+	"WeekDay":                 WeekDayValues,
+	"AccountType":             AccountTypeValues,
+	"FareAdjustmentReason":    FareAdjustmentReasonValues,
+	"DisputeStatus":           DisputeStatusValues,
+	"TripPurpose":             TripPurposeValues,
+	"WalletStatus":            WalletStatusValues,
+	"ScheduledRideStatus":     ScheduledRideStatusValues,
+	"TipStatus":               TipStatusValues,
+	"FleetOwnershipType":      FleetOwnershipTypeValues,
+	"BackgroundCheckStatus":   BackgroundCheckStatusValues,
+	"NotificationPriority":    NotificationPriorityValues,
+	"OnboardingStep":          OnboardingStepValues,
+	"MessageChannel":          MessageChannelValues,
+}
+
+// enumConsistencyChecks runs CheckEnumConsistency for every enum type in
+// enumValuesFuncs. TestEnumConsistency fails if an enum type is missing here.
+var enumConsistencyChecks = map[string]func() error{
+	"UserType":                enumCheck(UserTypeValues, ParseUserType),
+	"UserStatus":              enumCheck(UserStatusValues, ParseUserStatus),
+	"DriverStatus":            enumCheck(DriverStatusValues, ParseDriverStatus),
+	"AvailabilityStatus":      enumCheck(AvailabilityStatusValues, ParseAvailabilityStatus),
+	"DocumentType":            enumCheck(DocumentTypeValues, ParseDocumentType),
+	"DocumentStatus":          enumCheck(DocumentStatusValues, ParseDocumentStatus),
+	"VehicleStatus":           enumCheck(VehicleStatusValues, ParseVehicleStatus),
+	"ServiceType":             enumCheck(ServiceTypeValues, ParseServiceType),
+	"RideStatus":              enumCheck(RideStatusValues, ParseRideStatus),
+	"CancellationReason":      enumCheck(CancellationReasonValues, ParseCancellationReason),
+	"PaymentMethod":           enumCheck(PaymentMethodValues, ParsePaymentMethod),
+	"PaymentStatus":           enumCheck(PaymentStatusValues, ParsePaymentStatus),
+	"TransactionType":         enumCheck(TransactionTypeValues, ParseTransactionType),
+	"IncidentSeverity":        enumCheck(IncidentSeverityValues, ParseIncidentSeverity),
+	"IncidentStatus":          enumCheck(IncidentStatusValues, ParseIncidentStatus),
+	"EmergencyType":           enumCheck(EmergencyTypeValues, ParseEmergencyType),
+	"AccidentSeverity":        enumCheck(AccidentSeverityValues, ParseAccidentSeverity),
+	"Currency":                enumCheck(CurrencyValues, ParseCurrency),
+	"BiometricType":           enumCheck(BiometricTypeValues, ParseBiometricType),
+	"VehicleType":             enumCheck(VehicleTypeValues, ParseVehicleType),
+	"NotificationChannel":     enumCheck(NotificationChannelValues, ParseNotificationChannel),
+	"VehicleColor":            enumCheck(VehicleColorValues, ParseVehicleColor),
+	"Language":                enumCheck(LanguageValues, ParseLanguage),
+	"PayoutMethod":            enumCheck(PayoutMethodValues, ParsePayoutMethod),
+	"TicketStatus":            enumCheck(TicketStatusValues, ParseTicketStatus),
+	"TicketPriority":          enumCheck(TicketPriorityValues, ParseTicketPriority),
+	"AuthProvider":            enumCheck(AuthProviderValues, ParseAuthProvider),
+	"PromotionType":           enumCheck(PromotionTypeValues, ParsePromotionType),
+	"PromotionStatus":         enumCheck(PromotionStatusValues, ParsePromotionStatus),
+	"DevicePlatform":          enumCheck(DevicePlatformValues, ParseDevicePlatform),
+	"Fault":                   enumCheck(FaultValues, ParseFault),
+	"NotificationType":        enumCheck(NotificationTypeValues, ParseNotificationType),
+	"ExpiryReason":            enumCheck(ExpiryReasonValues, ParseExpiryReason),
+	"PaymentGateway":          enumCheck(PaymentGatewayValues, ParsePaymentGateway),
+	"RideCancelledBy":         enumCheck(RideCancelledByValues, ParseRideCancelledBy),
+	"KYCStatus":               enumCheck(KYCStatusValues, ParseKYCStatus),
+	"FuelType":                enumCheck(FuelTypeValues, ParseFuelType),
+	"RefundReason":            enumCheck(RefundReasonValues, ParseRefundReason),
+	"RefundStatus":            enumCheck(RefundStatusValues, ParseRefundStatus),
+	"AdminRole":               enumCheck(AdminRoleValues, ParseAdminRole),
+	"Permission":              enumCheck(PermissionValues, ParsePermission),
+	"PhoneVerificationStatus": enumCheck(PhoneVerificationStatusValues, ParsePhoneVerificationStatus),
+	"DriverVerificationStep":  enumCheck(DriverVerificationStepValues, ParseDriverVerificationStep),
+	"WeekDay":                 enumCheck(WeekDayValues, ParseWeekDay),
+	"AccountType":             enumCheck(AccountTypeValues, ParseAccountType),
+	"FareAdjustmentReason":    enumCheck(FareAdjustmentReasonValues, ParseFareAdjustmentReason),
+	"DisputeStatus":           enumCheck(DisputeStatusValues, ParseDisputeStatus),
+	"TripPurpose":             enumCheck(TripPurposeValues, ParseTripPurpose),
+	"WalletStatus":            enumCheck(WalletStatusValues, ParseWalletStatus),
+	"ScheduledRideStatus":     enumCheck(ScheduledRideStatusValues, ParseScheduledRideStatus),
+	"TipStatus":               enumCheck(TipStatusValues, ParseTipStatus),
+	"FleetOwnershipType":      enumCheck(FleetOwnershipTypeValues, ParseFleetOwnershipType),
+	"BackgroundCheckStatus":   enumCheck(BackgroundCheckStatusValues, ParseBackgroundCheckStatus),
+	"NotificationPriority":    enumCheck(NotificationPriorityValues, ParseNotificationPriority),
+	"OnboardingStep":          enumCheck(OnboardingStepValues, ParseOnboardingStep),
+	"MessageChannel":          enumCheck(MessageChannelValues, ParseMessageChannel),
+}
+
+// enumCheck adapts an enum's Values and Parse functions for enumConsistencyChecks.
+func enumCheck[T ~string](values func() []T, parse func(string) (T, error)) func() error {
+	return func() error {
+		return CheckEnumConsistency(values(), parse)
+	}
+}
+
+func TestEnumConsistency(t *testing.T) {
+	for typeName := range enumValuesFuncs {
+		if _, ok := enumConsistencyChecks[typeName]; !ok {
+			t.Errorf("enum %s has no entry in enumConsistencyChecks", typeName)
+		}
+	}
+
+	for typeName, check := range enumConsistencyChecks {
+		t.Run(typeName, func(t *testing.T) {
+			if err := check(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// bareEnum has none of the enum methods, to exercise CheckEnumConsistency failures.
+type bareEnum string
+
+func TestCheckEnumConsistency(t *testing.T) {
+	t.Run("consistent enum", func(t *testing.T) {
+		if err := CheckEnumConsistency(RideStatusValues(), ParseRideStatus); err != nil {
+			t.Errorf("CheckEnumConsistency() error = %v, want nil", err)
+		}
+	})
+
+	tests := []struct {
+		name  string
+		check func() error
+		want  []string
+	}{
+		{
+			name: "value missing from Parse and Valid",
+			check: func() error {
+				return CheckEnumConsistency([]RideStatus{RideStatusCompleted, "teleported"}, ParseRideStatus)
+			},
+			want: []string{`RideStatus("teleported"): parse failed`, `RideStatus("teleported"): listed but not Valid`},
+		},
+		{
+			name: "parse maps to another value",
+			check: func() error {
+				return CheckEnumConsistency([]RideStatus{RideStatusCompleted}, func(string) (RideStatus, error) {
+					return RideStatusRequested, nil
+				})
+			},
+			want: []string{`RideStatus("completed"): parses to "requested"`, "parse accepted garbage"},
+		},
+		{
+			name: "duplicate and empty values",
+			check: func() error {
+				return CheckEnumConsistency([]RideStatus{RideStatusCompleted, RideStatusCompleted, ""}, ParseRideStatus)
+			},
+			want: []string{"listed more than once", "empty value listed"},
+		},
+		{
+			name: "missing methods",
+			check: func() error {
+				return CheckEnumConsistency([]bareEnum{"a"}, func(s string) (bareEnum, error) {
+					if s != "a" {
+						return "", errors.New("invalid")
+					}
+					return bareEnum(s), nil
+				})
+			},
+			want: []string{"missing Valid method", "missing MarshalText method", "missing Value method"},
+		},
+		{
+			name: "no values",
+			check: func() error {
+				return CheckEnumConsistency([]RideStatus{}, ParseRideStatus)
+			},
+			want: []string{"RideStatus: no values"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.check()
+			if err == nil {
+				t.Fatal("CheckEnumConsistency() error = nil, want error")
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("CheckEnumConsistency() error = %q, want it to contain %q", err.Error(), want)
+				}
+			}
+		})
+	}
+}
+
+// updateGolden rewrites golden files instead of comparing against them:
+//
+//	go test ./enums -run TestEnumStringsGolden -update
+var updateGolden = flag.Bool("update", false, "update golden files in testdata")
+
+// TestEnumStringsGolden guards the serialized form of every enum constant.
+// Values are persisted in databases and exchanged between services, so a
+// renamed string is a breaking change even when the Go code still compiles.
+func TestEnumStringsGolden(t *testing.T) {
+	_, consts := parseEnumDecls(t)
+	got := make(map[string]string, len(consts))
+	for _, c := range consts {
+		got[c.name] = c.value
+	}
+
+	path := filepath.Join("testdata", "enum_strings.golden.json")
+	if *updateGolden {
+		data, err := json.MarshalIndent(got, "", "  ")
+		if err != nil {
+			t.Fatalf("MarshalIndent() error = %v", err)
+		}
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatalf("MkdirAll() error = %v", err)
+		}
+		if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+		return
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	var want map[string]string
+	if err := json.Unmarshal(data, &want); err != nil {
+		t.Fatalf("Unmarshal(%s) error = %v", path, err)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(want)) {
+		g, ok := got[name]
+		switch {
+		case !ok:
+			t.Errorf("%s (%q) was removed or renamed", name, want[name])
+		case g != want[name]:
+			t.Errorf("%s serializes as %q, golden snapshot has %q", name, g, want[name])
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(got)) {
+		if _, ok := want[name]; !ok {
+			t.Errorf("%s (%q) is not in %s; run go test ./enums -run TestEnumStringsGolden -update", name, got[name], path)
+		}
+	}
+}
+
+// enumConstant is a typed string constant declared in the package sources.
+type enumConstant struct {
+	typeName string
+	name     string
+	value    string
+}
+
+// parseEnumDecls parses the package sources and returns the names of all
+// string-based types and every typed string constant, in source order.
+// Constants whose value is not a string literal are skipped.
+func parseEnumDecls(t *testing.T) (types []string, consts []enumConstant) {
+	t.Helper()
+
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatalf("Glob() error = %v", err)
+	}
+
+	fset := token.NewFileSet()
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			t.Fatalf("ParseFile(%s) error = %v", name, err)
+		}
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					if ident, ok := s.Type.(*ast.Ident); ok && ident.Name == "string" {
+						types = append(types, s.Name.Name)
+					}
+				case *ast.ValueSpec:
+					if gen.Tok != token.CONST {
+						continue
+					}
+					ident, ok := s.Type.(*ast.Ident)
+					if !ok {
+						continue
+					}
+					for i, v := range s.Values {
+						lit, ok := v.(*ast.BasicLit)
+						if !ok || lit.Kind != token.STRING {
+							continue
+						}
+						val, err := strconv.Unquote(lit.Value)
+						if err != nil {
+							t.Fatalf("Unquote(%s) error = %v", lit.Value, err)
+						}
+						consts = append(consts, enumConstant{typeName: ident.Name, name: s.Names[i].Name, value: val})
+					}
+				}
+			}
+		}
+	}
+	return types, consts
+}
+
+// declaredEnumConstants parses the package sources and returns the string
+// constants declared for each named string type.
+func declaredEnumConstants(t *testing.T) map[string][]string {
+	t.Helper()
+
+	types, consts := parseEnumDecls(t)
+	declared := make(map[string][]string, len(types))
+	for _, name := range types {
+		declared[name] = nil
+	}
+	for _, c := range consts {
+		declared[c.typeName] = append(declared[c.typeName], c.value)
+	}
+	return declared
+}
+
+// TestEnumValues verifies that every enum exposes a Values function listing
+// exactly its declared constants, all of which are Valid.
+func TestEnumValues(t *testing.T) {
+	declared := declaredEnumConstants(t)
+
+	for typeName := range declared {
+		if _, ok := enumValuesFuncs[typeName]; !ok {
+			t.Errorf("enum %s has no entry in enumValuesFuncs", typeName)
+		}
+	}
+
+	for typeName, fn := range enumValuesFuncs {
+		t.Run(typeName, func(t *testing.T) {
+			values := reflect.ValueOf(fn).Call(nil)[0]
+			if got := values.Type().Elem().Name(); got != typeName {
+				t.Fatalf("Values() element type = %s, want %s", got, typeName)
+			}
+
+			got := make([]string, values.Len())
+			for i := range values.Len() {
+				v := values.Index(i)
+				if !v.MethodByName("Valid").Call(nil)[0].Bool() {
+					t.Errorf("%s(%q).Valid() = false, want true", typeName, v.String())
+				}
+				got[i] = v.String()
+			}
+
+			want := declared[typeName]
+			if !slices.Equal(got, want) {
+				t.Errorf("Values() = %v, want declared constants %v", got, want)
+			}
+
+			// Mutating the returned slice must not affect later calls.
+			if values.Len() > 0 {
+				values.Index(0).SetString("mutated")
+				again := reflect.ValueOf(fn).Call(nil)[0]
+				if again.Index(0).String() == "mutated" {
+					t.Error("Values() returned shared backing array")
+				}
+			}
+		})
+	}
 }
 
 // Helper function for testing JSON marshaling/unmarshaling
@@ -0,0 +1,79 @@
+package enums
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// UnknownValue is the raw value of the Unknown sentinel returned by lenient
+// parsing for values this package does not recognize, such as values added
+// by a partner after this package was released. The sentinel is never Valid.
+const UnknownValue = "unknown"
+
+// ParseLenient parses s with parse, returning the Unknown sentinel instead of
+// an error when s is not recognized. Empty input is also reported as Unknown.
+//
+//	status := enums.ParseLenient(s, enums.ParseRideStatus)
+func ParseLenient[T ~string](s string, parse func(string) (T, error)) T {
+	v, err := parse(s)
+	if err != nil {
+		return T(UnknownValue)
+	}
+	return v
+}
+
+// IsUnknown returns true if v is the Unknown sentinel.
+func IsUnknown[T ~string](v T) bool {
+	return v == T(UnknownValue)
+}
+
+// Lenient wraps an enum field in payloads that must not fail to decode when
+// they contain values newer than this package. Unrecognized values decode to
+// the Unknown sentinel and the original value is kept in Raw.
+// Strict decoding remains the default for the bare enum types.
+type Lenient[T ~string] struct {
+	V   T
+	Raw string
+}
+
+// IsUnknown returns true if the wrapped value is the Unknown sentinel.
+func (l Lenient[T]) IsUnknown() bool {
+	return IsUnknown(l.V)
+}
+
+// MarshalJSON implements json.Marshaler. Unknown values are re-encoded with
+// their original raw value so payloads pass through unchanged; other values
+// are encoded by the enum itself and must therefore be valid.
+func (l Lenient[T]) MarshalJSON() ([]byte, error) {
+	if l.IsUnknown() {
+		return json.Marshal(l.Raw)
+	}
+	return json.Marshal(l.V)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null yields the zero
+// value; a string the enum does not recognize yields the Unknown sentinel.
+// Values that are not JSON strings are still rejected.
+func (l *Lenient[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*l = Lenient[T]{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	var v T
+	u, ok := any(&v).(encoding.TextUnmarshaler)
+	if !ok {
+		return fmt.Errorf("cannot decode %s leniently: not an encoding.TextUnmarshaler", reflect.TypeFor[T]().Name())
+	}
+	if err := u.UnmarshalText([]byte(s)); err != nil {
+		v = T(UnknownValue)
+	}
+	*l = Lenient[T]{V: v, Raw: s}
+	return nil
+}
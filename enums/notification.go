@@ -0,0 +1,606 @@
+package enums
+
+import (
+	"database/sql/driver"
+	"errors"
+	"strings"
+)
+
+// NotificationChannel represents a delivery channel for notifications.
+type NotificationChannel string
+
+const (
+	NotificationChannelSMS      NotificationChannel = "sms"
+	NotificationChannelPush     NotificationChannel = "push"
+	NotificationChannelEmail    NotificationChannel = "email"
+	NotificationChannelWhatsApp NotificationChannel = "whatsapp"
+	NotificationChannelInApp    NotificationChannel = "in_app"
+)
+
+// ErrInvalidNotificationChannel is returned when parsing an invalid notification channel.
+var ErrInvalidNotificationChannel = errors.New("invalid notification channel")
+
+// ParseNotificationChannel parses a string into a NotificationChannel.
+// The aliases "push_notification", "push-notification" and "in-app" are accepted.
+func ParseNotificationChannel(s string) (NotificationChannel, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "sms":
+		return NotificationChannelSMS, nil
+	case "push", "push_notification", "push-notification":
+		return NotificationChannelPush, nil
+	case "email":
+		return NotificationChannelEmail, nil
+	case "whatsapp":
+		return NotificationChannelWhatsApp, nil
+	case "in_app", "in-app":
+		return NotificationChannelInApp, nil
+	default:
+		return "", parseError(ErrInvalidNotificationChannel, s)
+	}
+}
+
+// String returns the string representation.
+func (n NotificationChannel) String() string {
+	return string(n)
+}
+
+// Valid returns true if the NotificationChannel is valid.
+func (n NotificationChannel) Valid() bool {
+	switch n {
+	case NotificationChannelSMS, NotificationChannelPush, NotificationChannelEmail,
+		NotificationChannelWhatsApp, NotificationChannelInApp:
+		return true
+	default:
+		return false
+	}
+}
+
+// NotificationChannelValues returns all valid NotificationChannel values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func NotificationChannelValues() []NotificationChannel {
+	return []NotificationChannel{
+		NotificationChannelSMS,
+		NotificationChannelPush,
+		NotificationChannelEmail,
+		NotificationChannelWhatsApp,
+		NotificationChannelInApp,
+	}
+}
+
+// SupportsRichContent returns true if the channel can deliver formatted
+// content such as images, links or markup. SMS is plain text only.
+func (n NotificationChannel) SupportsRichContent() bool {
+	switch n {
+	case NotificationChannelPush, NotificationChannelEmail, NotificationChannelWhatsApp, NotificationChannelInApp:
+		return true
+	default:
+		return false
+	}
+}
+
+// RequiresPhoneNumber returns true if delivery requires the recipient's
+// phone number (SMS and WhatsApp).
+func (n NotificationChannel) RequiresPhoneNumber() bool {
+	return n == NotificationChannelSMS || n == NotificationChannelWhatsApp
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n NotificationChannel) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(n)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *NotificationChannel) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, n, ParseNotificationChannel)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (n NotificationChannel) MarshalText() ([]byte, error) {
+	return marshalEnumText(n)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (n *NotificationChannel) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, n, ParseNotificationChannel)
+}
+
+// Scan implements sql.Scanner.
+func (n *NotificationChannel) Scan(src interface{}) error {
+	return scanEnum(src, n, ParseNotificationChannel)
+}
+
+// Value implements driver.Valuer.
+func (n NotificationChannel) Value() (driver.Value, error) {
+	return enumValue(n)
+}
+
+// DevicePlatform represents the operating platform of a user device.
+type DevicePlatform string
+
+const (
+	DevicePlatformIOS     DevicePlatform = "ios"
+	DevicePlatformAndroid DevicePlatform = "android"
+	DevicePlatformWeb     DevicePlatform = "web"
+)
+
+// ErrInvalidDevicePlatform is returned when parsing an invalid device platform.
+var ErrInvalidDevicePlatform = errors.New("invalid device platform")
+
+// ParseDevicePlatform parses a string into a DevicePlatform.
+// The aliases "iphone" (ios) and "gms" or "fcm" (android) are accepted.
+func ParseDevicePlatform(s string) (DevicePlatform, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "ios", "iphone":
+		return DevicePlatformIOS, nil
+	case "android", "gms", "fcm":
+		return DevicePlatformAndroid, nil
+	case "web":
+		return DevicePlatformWeb, nil
+	default:
+		return "", parseError(ErrInvalidDevicePlatform, s)
+	}
+}
+
+// String returns the string representation.
+func (d DevicePlatform) String() string {
+	return string(d)
+}
+
+// Valid returns true if the DevicePlatform is valid.
+func (d DevicePlatform) Valid() bool {
+	switch d {
+	case DevicePlatformIOS, DevicePlatformAndroid, DevicePlatformWeb:
+		return true
+	default:
+		return false
+	}
+}
+
+// DevicePlatformValues returns all valid DevicePlatform values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func DevicePlatformValues() []DevicePlatform {
+	return []DevicePlatform{
+		DevicePlatformIOS,
+		DevicePlatformAndroid,
+		DevicePlatformWeb,
+	}
+}
+
+// SupportsPush returns true if push notifications can be delivered to the
+// platform. Web push is not yet supported.
+func (d DevicePlatform) SupportsPush() bool {
+	return d == DevicePlatformIOS || d == DevicePlatformAndroid
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d DevicePlatform) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(d)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DevicePlatform) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, d, ParseDevicePlatform)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d DevicePlatform) MarshalText() ([]byte, error) {
+	return marshalEnumText(d)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *DevicePlatform) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, d, ParseDevicePlatform)
+}
+
+// Scan implements sql.Scanner.
+func (d *DevicePlatform) Scan(src interface{}) error {
+	return scanEnum(src, d, ParseDevicePlatform)
+}
+
+// Value implements driver.Valuer.
+func (d DevicePlatform) Value() (driver.Value, error) {
+	return enumValue(d)
+}
+
+// NotificationType identifies the event a notification is about.
+type NotificationType string
+
+const (
+	NotificationTypeRideRequest     NotificationType = "ride_request"
+	NotificationTypeRideAccepted    NotificationType = "ride_accepted"
+	NotificationTypeDriverArriving  NotificationType = "driver_arriving"
+	NotificationTypeDriverArrived   NotificationType = "driver_arrived"
+	NotificationTypeRideStarted     NotificationType = "ride_started"
+	NotificationTypeRideCompleted   NotificationType = "ride_completed"
+	NotificationTypeRideCancelled   NotificationType = "ride_cancelled"
+	NotificationTypePaymentReceived NotificationType = "payment_received"
+	NotificationTypePaymentFailed   NotificationType = "payment_failed"
+	NotificationTypeRefundIssued    NotificationType = "refund_issued"
+	NotificationTypeRatingReminder  NotificationType = "rating_reminder"
+	NotificationTypePromotion       NotificationType = "promotion"
+	NotificationTypeSafetyAlert     NotificationType = "safety_alert"
+)
+
+// ErrInvalidNotificationType is returned when parsing an invalid notification type.
+var ErrInvalidNotificationType = errors.New("invalid notification type")
+
+// ParseNotificationType parses a string into a NotificationType.
+func ParseNotificationType(s string) (NotificationType, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "ride_request":
+		return NotificationTypeRideRequest, nil
+	case "ride_accepted":
+		return NotificationTypeRideAccepted, nil
+	case "driver_arriving":
+		return NotificationTypeDriverArriving, nil
+	case "driver_arrived":
+		return NotificationTypeDriverArrived, nil
+	case "ride_started":
+		return NotificationTypeRideStarted, nil
+	case "ride_completed":
+		return NotificationTypeRideCompleted, nil
+	case "ride_cancelled":
+		return NotificationTypeRideCancelled, nil
+	case "payment_received":
+		return NotificationTypePaymentReceived, nil
+	case "payment_failed":
+		return NotificationTypePaymentFailed, nil
+	case "refund_issued":
+		return NotificationTypeRefundIssued, nil
+	case "rating_reminder":
+		return NotificationTypeRatingReminder, nil
+	case "promotion":
+		return NotificationTypePromotion, nil
+	case "safety_alert":
+		return NotificationTypeSafetyAlert, nil
+	default:
+		return "", parseError(ErrInvalidNotificationType, s)
+	}
+}
+
+// String returns the string representation.
+func (n NotificationType) String() string {
+	return string(n)
+}
+
+// Valid returns true if the NotificationType is valid.
+func (n NotificationType) Valid() bool {
+	switch n {
+	case NotificationTypeRideRequest, NotificationTypeRideAccepted, NotificationTypeDriverArriving,
+		NotificationTypeDriverArrived, NotificationTypeRideStarted, NotificationTypeRideCompleted,
+		NotificationTypeRideCancelled, NotificationTypePaymentReceived, NotificationTypePaymentFailed,
+		NotificationTypeRefundIssued, NotificationTypeRatingReminder, NotificationTypePromotion,
+		NotificationTypeSafetyAlert:
+		return true
+	default:
+		return false
+	}
+}
+
+// NotificationTypeValues returns all valid NotificationType values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func NotificationTypeValues() []NotificationType {
+	return []NotificationType{
+		NotificationTypeRideRequest,
+		NotificationTypeRideAccepted,
+		NotificationTypeDriverArriving,
+		NotificationTypeDriverArrived,
+		NotificationTypeRideStarted,
+		NotificationTypeRideCompleted,
+		NotificationTypeRideCancelled,
+		NotificationTypePaymentReceived,
+		NotificationTypePaymentFailed,
+		NotificationTypeRefundIssued,
+		NotificationTypeRatingReminder,
+		NotificationTypePromotion,
+		NotificationTypeSafetyAlert,
+	}
+}
+
+// Priority returns the delivery priority of the notification type, from
+// 1 (low, e.g. marketing) to 3 (critical, e.g. safety alerts and time-sensitive
+// ride events). Returns 0 for invalid values.
+func (n NotificationType) Priority() int {
+	switch n {
+	case NotificationTypeRideRequest, NotificationTypeDriverArrived, NotificationTypeRideCancelled,
+		NotificationTypePaymentFailed, NotificationTypeSafetyAlert:
+		return 3
+	case NotificationTypeRideAccepted, NotificationTypeDriverArriving, NotificationTypeRideStarted,
+		NotificationTypeRideCompleted, NotificationTypePaymentReceived, NotificationTypeRefundIssued:
+		return 2
+	case NotificationTypeRatingReminder, NotificationTypePromotion:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n NotificationType) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(n)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *NotificationType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, n, ParseNotificationType)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (n NotificationType) MarshalText() ([]byte, error) {
+	return marshalEnumText(n)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (n *NotificationType) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, n, ParseNotificationType)
+}
+
+// Scan implements sql.Scanner.
+func (n *NotificationType) Scan(src interface{}) error {
+	return scanEnum(src, n, ParseNotificationType)
+}
+
+// Value implements driver.Valuer.
+func (n NotificationType) Value() (driver.Value, error) {
+	return enumValue(n)
+}
+
+// NotificationPriority represents the importance of a push notification, mapped
+// to FCM and APNs priority levels.
+type NotificationPriority string
+
+const (
+	NotificationPriorityLow      NotificationPriority = "low"
+	NotificationPriorityNormal   NotificationPriority = "normal"
+	NotificationPriorityHigh     NotificationPriority = "high"
+	NotificationPriorityCritical NotificationPriority = "critical"
+)
+
+// ErrInvalidNotificationPriority is returned when parsing an invalid notification priority.
+var ErrInvalidNotificationPriority = errors.New("invalid notification priority")
+
+// ParseNotificationPriority parses a string into a NotificationPriority.
+func ParseNotificationPriority(s string) (NotificationPriority, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "low":
+		return NotificationPriorityLow, nil
+	case "normal":
+		return NotificationPriorityNormal, nil
+	case "high":
+		return NotificationPriorityHigh, nil
+	case "critical":
+		return NotificationPriorityCritical, nil
+	default:
+		return "", parseError(ErrInvalidNotificationPriority, s)
+	}
+}
+
+// String returns the string representation.
+func (n NotificationPriority) String() string {
+	return string(n)
+}
+
+// Valid returns true if the NotificationPriority is valid.
+func (n NotificationPriority) Valid() bool {
+	switch n {
+	case NotificationPriorityLow, NotificationPriorityNormal, NotificationPriorityHigh,
+		NotificationPriorityCritical:
+		return true
+	default:
+		return false
+	}
+}
+
+// NotificationPriorityValues returns all valid NotificationPriority values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func NotificationPriorityValues() []NotificationPriority {
+	return []NotificationPriority{
+		NotificationPriorityLow,
+		NotificationPriorityNormal,
+		NotificationPriorityHigh,
+		NotificationPriorityCritical,
+	}
+}
+
+// Level returns the numeric priority, from 1 (low) to 4 (critical).
+// Invalid priorities have Level 0, so they order below every valid priority.
+func (n NotificationPriority) Level() int {
+	switch n {
+	case NotificationPriorityLow:
+		return 1
+	case NotificationPriorityNormal:
+		return 2
+	case NotificationPriorityHigh:
+		return 3
+	case NotificationPriorityCritical:
+		return 4
+	default:
+		return 0
+	}
+}
+
+// Less returns true if n is a lower priority than other, comparing by Level.
+func (n NotificationPriority) Less(other NotificationPriority) bool {
+	return n.Level() < other.Level()
+}
+
+// AtLeast returns true if n is the same or a higher priority than other,
+// comparing by Level.
+func (n NotificationPriority) AtLeast(other NotificationPriority) bool {
+	return n.Level() >= other.Level()
+}
+
+// Push priorities for the events that trigger notifications. Emergency
+// alerts take their priority from the EmergencyType's DefaultSeverity, so
+// the two cannot disagree; emergencies are never below high. Every
+// IncidentSeverity and RideStatus value must have an entry.
+var (
+	severityPriorities = map[IncidentSeverity]NotificationPriority{
+		IncidentSeverityLow:      NotificationPriorityHigh,
+		IncidentSeverityMedium:   NotificationPriorityHigh,
+		IncidentSeverityHigh:     NotificationPriorityHigh,
+		IncidentSeverityCritical: NotificationPriorityCritical,
+	}
+	rideStatusPriorities = map[RideStatus]NotificationPriority{
+		RideStatusRequested:       NotificationPriorityLow,
+		RideStatusSearching:       NotificationPriorityLow,
+		RideStatusDriverAssigned:  NotificationPriorityHigh,
+		RideStatusDriverArriving:  NotificationPriorityHigh,
+		RideStatusWaitingForRider: NotificationPriorityHigh,
+		RideStatusInProgress:      NotificationPriorityNormal,
+		RideStatusCompleted:       NotificationPriorityNormal,
+		RideStatusCancelled:       NotificationPriorityHigh,
+	}
+)
+
+// PriorityForEmergency returns the push priority for an emergency alert:
+// critical for emergencies whose DefaultSeverity is critical, high
+// otherwise. Invalid types, which have no severity, are treated as critical
+// so an alert is never downgraded.
+func PriorityForEmergency(e EmergencyType) NotificationPriority {
+	if p, ok := severityPriorities[e.DefaultSeverity()]; ok {
+		return p
+	}
+	return NotificationPriorityCritical
+}
+
+// PriorityForRideStatus returns the push priority for a ride status update.
+// Statuses needing the rider's immediate attention, such as a driver
+// arriving or a cancellation, are high. Invalid statuses return normal.
+func PriorityForRideStatus(s RideStatus) NotificationPriority {
+	if p, ok := rideStatusPriorities[s]; ok {
+		return p
+	}
+	return NotificationPriorityNormal
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n NotificationPriority) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(n)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *NotificationPriority) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, n, ParseNotificationPriority)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (n NotificationPriority) MarshalText() ([]byte, error) {
+	return marshalEnumText(n)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (n *NotificationPriority) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, n, ParseNotificationPriority)
+}
+
+// Scan implements sql.Scanner.
+func (n *NotificationPriority) Scan(src interface{}) error {
+	return scanEnum(src, n, ParseNotificationPriority)
+}
+
+// Value implements driver.Valuer.
+func (n NotificationPriority) Value() (driver.Value, error) {
+	return enumValue(n)
+}
+
+// MessageChannel represents the channel a rider-driver message is routed through.
+type MessageChannel string
+
+const (
+	MessageChannelChat     MessageChannel = "chat"
+	MessageChannelSMS      MessageChannel = "sms"
+	MessageChannelPush     MessageChannel = "push"
+	MessageChannelEmail    MessageChannel = "email"
+	MessageChannelWhatsApp MessageChannel = "whatsapp"
+)
+
+// ErrInvalidMessageChannel is returned when parsing an invalid message channel.
+var ErrInvalidMessageChannel = errors.New("invalid message channel")
+
+// ParseMessageChannel parses a string into a MessageChannel.
+func ParseMessageChannel(s string) (MessageChannel, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "chat":
+		return MessageChannelChat, nil
+	case "sms":
+		return MessageChannelSMS, nil
+	case "push":
+		return MessageChannelPush, nil
+	case "email":
+		return MessageChannelEmail, nil
+	case "whatsapp":
+		return MessageChannelWhatsApp, nil
+	default:
+		return "", parseError(ErrInvalidMessageChannel, s)
+	}
+}
+
+// String returns the string representation.
+func (m MessageChannel) String() string {
+	return string(m)
+}
+
+// Valid returns true if the MessageChannel is valid.
+func (m MessageChannel) Valid() bool {
+	switch m {
+	case MessageChannelChat, MessageChannelSMS, MessageChannelPush, MessageChannelEmail, MessageChannelWhatsApp:
+		return true
+	default:
+		return false
+	}
+}
+
+// MessageChannelValues returns all valid MessageChannel values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func MessageChannelValues() []MessageChannel {
+	return []MessageChannel{
+		MessageChannelChat,
+		MessageChannelSMS,
+		MessageChannelPush,
+		MessageChannelEmail,
+		MessageChannelWhatsApp,
+	}
+}
+
+// RequiresInternet returns true if the channel needs a data connection on the
+// recipient's device. Only sms works over the cellular network alone.
+func (m MessageChannel) RequiresInternet() bool {
+	return m.Valid() && m != MessageChannelSMS
+}
+
+// SupportsRichMedia returns true if the channel can carry images, voice notes
+// and location pins (chat and whatsapp).
+func (m MessageChannel) SupportsRichMedia() bool {
+	return m == MessageChannelChat || m == MessageChannelWhatsApp
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m MessageChannel) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(m)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *MessageChannel) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, m, ParseMessageChannel)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (m MessageChannel) MarshalText() ([]byte, error) {
+	return marshalEnumText(m)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (m *MessageChannel) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, m, ParseMessageChannel)
+}
+
+// Scan implements sql.Scanner.
+func (m *MessageChannel) Scan(src interface{}) error {
+	return scanEnum(src, m, ParseMessageChannel)
+}
+
+// Value implements driver.Valuer.
+func (m MessageChannel) Value() (driver.Value, error) {
+	return enumValue(m)
+}
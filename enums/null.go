@@ -0,0 +1,78 @@
+package enums
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Null represents an enum value that may be NULL, distinguishing an unset
+// value from the enum's zero value. It mirrors sql.Null and implements
+// sql.Scanner, driver.Valuer, and JSON marshaling with null support.
+type Null[T ~string] struct {
+	V     T
+	Valid bool
+}
+
+// NullRideStatus is a RideStatus that may be NULL.
+type NullRideStatus = Null[RideStatus]
+
+// NullPaymentMethod is a PaymentMethod that may be NULL.
+type NullPaymentMethod = Null[PaymentMethod]
+
+// NewNull returns a Null holding v with Valid set.
+func NewNull[T ~string](v T) Null[T] {
+	return Null[T]{V: v, Valid: true}
+}
+
+// MarshalJSON implements json.Marshaler. NULL values encode as null.
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.V)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null yields NULL;
+// any other value is decoded with the enum's own JSON decoding.
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = Null[T]{}
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*n = Null[T]{V: v, Valid: true}
+	return nil
+}
+
+// Scan implements sql.Scanner. A NULL source yields NULL; any other source
+// is scanned with the enum's own Scan method.
+func (n *Null[T]) Scan(src interface{}) error {
+	if src == nil {
+		*n = Null[T]{}
+		return nil
+	}
+	var v T
+	scanner, ok := any(&v).(sql.Scanner)
+	if !ok {
+		return fmt.Errorf("cannot scan into %s: not an sql.Scanner", reflect.TypeFor[T]().Name())
+	}
+	if err := scanner.Scan(src); err != nil {
+		return err
+	}
+	*n = Null[T]{V: v, Valid: true}
+	return nil
+}
+
+// Value implements driver.Valuer. NULL values are stored as NULL.
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return string(n.V), nil
+}
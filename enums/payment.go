@@ -2,7 +2,6 @@ package enums
 
 import (
 	"database/sql/driver"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -22,18 +21,20 @@ const (
 var ErrInvalidPaymentMethod = errors.New("invalid payment method")
 
 // ParsePaymentMethod parses a string into a PaymentMethod.
+// The aliases "m-pesa", "m_pesa", and "m pesa" are accepted for mpesa and
+// "credit_card" for card.
 func ParsePaymentMethod(s string) (PaymentMethod, error) {
 	switch strings.ToLower(strings.TrimSpace(s)) {
 	case "cash":
 		return PaymentMethodCash, nil
-	case "mpesa":
+	case "mpesa", "m-pesa", "m_pesa", "m pesa":
 		return PaymentMethodMPesa, nil
-	case "card":
+	case "card", "credit_card":
 		return PaymentMethodCard, nil
 	case "wallet":
 		return PaymentMethodWallet, nil
 	default:
-		return "", ErrInvalidPaymentMethod
+		return "", parseError(ErrInvalidPaymentMethod, s)
 	}
 }
 
@@ -52,71 +53,68 @@ func (p PaymentMethod) Valid() bool {
 	}
 }
 
+// PaymentMethodValues returns all valid PaymentMethod values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func PaymentMethodValues() []PaymentMethod {
+	return []PaymentMethod{
+		PaymentMethodCash,
+		PaymentMethodMPesa,
+		PaymentMethodCard,
+		PaymentMethodWallet,
+	}
+}
+
+// IsElectronic returns true if the payment is made electronically rather
+// than in cash.
+func (p PaymentMethod) IsElectronic() bool {
+	switch p {
+	case PaymentMethodMPesa, PaymentMethodCard, PaymentMethodWallet:
+		return true
+	default:
+		return false
+	}
+}
+
+// RequiresExternalConfirmation returns true if the payment settles through an
+// external operator or card network and must be confirmed asynchronously.
+// Cash and wallet payments settle immediately.
+func (p PaymentMethod) RequiresExternalConfirmation() bool {
+	switch p {
+	case PaymentMethodMPesa, PaymentMethodCard:
+		return true
+	default:
+		return false
+	}
+}
+
 // MarshalJSON implements json.Marshaler.
 func (p PaymentMethod) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(p))
+	return marshalEnumJSON(p)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (p *PaymentMethod) UnmarshalJSON(data []byte) error {
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
-		return err
-	}
-	parsed, err := ParsePaymentMethod(s)
-	if err != nil {
-		return err
-	}
-	*p = parsed
-	return nil
+	return unmarshalEnumJSON(data, p, ParsePaymentMethod)
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (p PaymentMethod) MarshalText() ([]byte, error) {
-	return []byte(p), nil
+	return marshalEnumText(p)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (p *PaymentMethod) UnmarshalText(data []byte) error {
-	parsed, err := ParsePaymentMethod(string(data))
-	if err != nil {
-		return err
-	}
-	*p = parsed
-	return nil
+	return unmarshalEnumText(data, p, ParsePaymentMethod)
 }
 
 // Scan implements sql.Scanner.
 func (p *PaymentMethod) Scan(src interface{}) error {
-	switch v := src.(type) {
-	case string:
-		parsed, err := ParsePaymentMethod(v)
-		if err != nil {
-			return err
-		}
-		*p = parsed
-		return nil
-	case []byte:
-		parsed, err := ParsePaymentMethod(string(v))
-		if err != nil {
-			return err
-		}
-		*p = parsed
-		return nil
-	case nil:
-		*p = ""
-		return nil
-	default:
-		return fmt.Errorf("cannot scan %T into PaymentMethod", src)
-	}
+	return scanEnum(src, p, ParsePaymentMethod)
 }
 
 // Value implements driver.Valuer.
 func (p PaymentMethod) Value() (driver.Value, error) {
-	if p == "" {
-		return nil, nil
-	}
-	return string(p), nil
+	return enumValue(p)
 }
 
 // PaymentStatus represents the status of a payment.
@@ -133,6 +131,10 @@ const (
 // ErrInvalidPaymentStatus is returned when parsing an invalid payment status.
 var ErrInvalidPaymentStatus = errors.New("invalid payment status")
 
+// ErrInvalidPaymentTransition is returned when a payment status change is not
+// allowed by the payment lifecycle.
+var ErrInvalidPaymentTransition = errors.New("invalid payment status transition")
+
 // ParsePaymentStatus parses a string into a PaymentStatus.
 func ParsePaymentStatus(s string) (PaymentStatus, error) {
 	switch strings.ToLower(strings.TrimSpace(s)) {
@@ -147,7 +149,7 @@ func ParsePaymentStatus(s string) (PaymentStatus, error) {
 	case "refunded":
 		return PaymentStatusRefunded, nil
 	default:
-		return "", ErrInvalidPaymentStatus
+		return "", parseError(ErrInvalidPaymentStatus, s)
 	}
 }
 
@@ -167,71 +169,86 @@ func (p PaymentStatus) Valid() bool {
 	}
 }
 
+// PaymentStatusValues returns all valid PaymentStatus values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func PaymentStatusValues() []PaymentStatus {
+	return []PaymentStatus{
+		PaymentStatusPending,
+		PaymentStatusProcessing,
+		PaymentStatusCompleted,
+		PaymentStatusFailed,
+		PaymentStatusRefunded,
+	}
+}
+
+// paymentStatusTransitions defines the allowed payment lifecycle transitions.
+var paymentStatusTransitions = map[PaymentStatus][]PaymentStatus{
+	PaymentStatusPending:    {PaymentStatusProcessing},
+	PaymentStatusProcessing: {PaymentStatusCompleted, PaymentStatusFailed},
+	PaymentStatusCompleted:  {PaymentStatusRefunded},
+	PaymentStatusFailed:     {},
+	PaymentStatusRefunded:   {},
+}
+
+// CanTransitionTo returns true if a payment may move from p to next.
+func (p PaymentStatus) CanTransitionTo(next PaymentStatus) bool {
+	for _, s := range paymentStatusTransitions[p] {
+		if s == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTerminal returns true if no further status changes are allowed
+// (failed or refunded).
+func (p PaymentStatus) IsTerminal() bool {
+	return p == PaymentStatusFailed || p == PaymentStatusRefunded
+}
+
+// IsSettled returns true if funds have moved for the payment
+// (completed or refunded).
+func (p PaymentStatus) IsSettled() bool {
+	return p == PaymentStatusCompleted || p == PaymentStatusRefunded
+}
+
+// ValidatePaymentTransition returns an error wrapping
+// ErrInvalidPaymentTransition if a payment may not move from one status to another.
+func ValidatePaymentTransition(from, to PaymentStatus) error {
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %q to %q", ErrInvalidPaymentTransition, from, to)
+	}
+	return nil
+}
+
 // MarshalJSON implements json.Marshaler.
 func (p PaymentStatus) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(p))
+	return marshalEnumJSON(p)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (p *PaymentStatus) UnmarshalJSON(data []byte) error {
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
-		return err
-	}
-	parsed, err := ParsePaymentStatus(s)
-	if err != nil {
-		return err
-	}
-	*p = parsed
-	return nil
+	return unmarshalEnumJSON(data, p, ParsePaymentStatus)
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (p PaymentStatus) MarshalText() ([]byte, error) {
-	return []byte(p), nil
+	return marshalEnumText(p)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (p *PaymentStatus) UnmarshalText(data []byte) error {
-	parsed, err := ParsePaymentStatus(string(data))
-	if err != nil {
-		return err
-	}
-	*p = parsed
-	return nil
+	return unmarshalEnumText(data, p, ParsePaymentStatus)
 }
 
 // Scan implements sql.Scanner.
 func (p *PaymentStatus) Scan(src interface{}) error {
-	switch v := src.(type) {
-	case string:
-		parsed, err := ParsePaymentStatus(v)
-		if err != nil {
-			return err
-		}
-		*p = parsed
-		return nil
-	case []byte:
-		parsed, err := ParsePaymentStatus(string(v))
-		if err != nil {
-			return err
-		}
-		*p = parsed
-		return nil
-	case nil:
-		*p = ""
-		return nil
-	default:
-		return fmt.Errorf("cannot scan %T into PaymentStatus", src)
-	}
+	return scanEnum(src, p, ParsePaymentStatus)
 }
 
 // Value implements driver.Valuer.
 func (p PaymentStatus) Value() (driver.Value, error) {
-	if p == "" {
-		return nil, nil
-	}
-	return string(p), nil
+	return enumValue(p)
 }
 
 // TransactionType represents the type of financial transaction.
@@ -265,7 +282,7 @@ func ParseTransactionType(s string) (TransactionType, error) {
 	case "commission":
 		return TransactionTypeCommission, nil
 	default:
-		return "", ErrInvalidTransactionType
+		return "", parseError(ErrInvalidTransactionType, s)
 	}
 }
 
@@ -285,69 +302,1045 @@ func (t TransactionType) Valid() bool {
 	}
 }
 
+// TransactionTypeValues returns all valid TransactionType values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func TransactionTypeValues() []TransactionType {
+	return []TransactionType{
+		TransactionTypeRidePayment,
+		TransactionTypeDriverPayout,
+		TransactionTypeRefund,
+		TransactionTypeWalletTopup,
+		TransactionTypeBonus,
+		TransactionTypeCommission,
+	}
+}
+
+// transactionSigns is the authoritative direction of each transaction type,
+// seen from the wallet of the account the transaction is booked against:
+// +1 increases the balance (credit), -1 decreases it (debit).
+var transactionSigns = map[TransactionType]int{
+	TransactionTypeRidePayment:  -1, // rider pays for a ride
+	TransactionTypeDriverPayout: +1, // driver receives earnings
+	TransactionTypeRefund:       +1, // rider is reimbursed
+	TransactionTypeWalletTopup:  +1, // rider adds funds
+	TransactionTypeBonus:        +1, // promotional or incentive credit
+	TransactionTypeCommission:   -1, // platform fee withheld from driver
+}
+
+// Sign returns +1 if the transaction credits the account's wallet and -1 if
+// it debits it. Returns 0 for invalid values.
+func (t TransactionType) Sign() int {
+	return transactionSigns[t]
+}
+
+// IsCredit returns true if the transaction increases the account's wallet balance.
+func (t TransactionType) IsCredit() bool {
+	return t.Sign() > 0
+}
+
+// IsDebit returns true if the transaction decreases the account's wallet balance.
+func (t TransactionType) IsDebit() bool {
+	return t.Sign() < 0
+}
+
 // MarshalJSON implements json.Marshaler.
 func (t TransactionType) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(t))
+	return marshalEnumJSON(t)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (t *TransactionType) UnmarshalJSON(data []byte) error {
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
-		return err
-	}
-	parsed, err := ParseTransactionType(s)
-	if err != nil {
-		return err
-	}
-	*t = parsed
-	return nil
+	return unmarshalEnumJSON(data, t, ParseTransactionType)
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (t TransactionType) MarshalText() ([]byte, error) {
-	return []byte(t), nil
+	return marshalEnumText(t)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (t *TransactionType) UnmarshalText(data []byte) error {
-	parsed, err := ParseTransactionType(string(data))
-	if err != nil {
-		return err
+	return unmarshalEnumText(data, t, ParseTransactionType)
+}
+
+// Scan implements sql.Scanner.
+func (t *TransactionType) Scan(src interface{}) error {
+	return scanEnum(src, t, ParseTransactionType)
+}
+
+// Value implements driver.Valuer.
+func (t TransactionType) Value() (driver.Value, error) {
+	return enumValue(t)
+}
+
+// Currency represents an ISO 4217 currency supported by the platform.
+// Values are stored as upper-case ISO codes.
+type Currency string
+
+const (
+	CurrencyMZN Currency = "MZN"
+	CurrencyZAR Currency = "ZAR"
+	CurrencyUSD Currency = "USD"
+)
+
+// ErrInvalidCurrency is returned when parsing an invalid currency.
+var ErrInvalidCurrency = errors.New("invalid currency")
+
+// ParseCurrency parses a string into a Currency.
+func ParseCurrency(s string) (Currency, error) {
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "MZN":
+		return CurrencyMZN, nil
+	case "ZAR":
+		return CurrencyZAR, nil
+	case "USD":
+		return CurrencyUSD, nil
+	default:
+		return "", parseError(ErrInvalidCurrency, s)
+	}
+}
+
+// String returns the string representation.
+func (c Currency) String() string {
+	return string(c)
+}
+
+// Valid returns true if the Currency is valid.
+func (c Currency) Valid() bool {
+	switch c {
+	case CurrencyMZN, CurrencyZAR, CurrencyUSD:
+		return true
+	default:
+		return false
+	}
+}
+
+// CurrencyValues returns all valid Currency values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func CurrencyValues() []Currency {
+	return []Currency{
+		CurrencyMZN,
+		CurrencyZAR,
+		CurrencyUSD,
+	}
+}
+
+// Symbol returns the display symbol for the currency ("MT", "R" or "$").
+// Returns an empty string for invalid currencies.
+func (c Currency) Symbol() string {
+	switch c {
+	case CurrencyMZN:
+		return "MT"
+	case CurrencyZAR:
+		return "R"
+	case CurrencyUSD:
+		return "$"
+	default:
+		return ""
+	}
+}
+
+// ISOCode returns the ISO 4217 code for the currency.
+// Returns an empty string for invalid currencies.
+func (c Currency) ISOCode() string {
+	if !c.Valid() {
+		return ""
+	}
+	return string(c)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c Currency) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *Currency) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, c, ParseCurrency)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c Currency) MarshalText() ([]byte, error) {
+	return marshalEnumText(c)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *Currency) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, c, ParseCurrency)
+}
+
+// Scan implements sql.Scanner.
+func (c *Currency) Scan(src interface{}) error {
+	return scanEnum(src, c, ParseCurrency)
+}
+
+// Value implements driver.Valuer.
+func (c Currency) Value() (driver.Value, error) {
+	return enumValue(c)
+}
+
+// PayoutMethod represents the method used to pay out driver earnings.
+type PayoutMethod string
+
+const (
+	PayoutMethodMPesa        PayoutMethod = "mpesa"
+	PayoutMethodEMola        PayoutMethod = "emola"
+	PayoutMethodMKesh        PayoutMethod = "mkesh"
+	PayoutMethodBankTransfer PayoutMethod = "bank_transfer"
+)
+
+// ErrInvalidPayoutMethod is returned when parsing an invalid payout method.
+var ErrInvalidPayoutMethod = errors.New("invalid payout method")
+
+// ParsePayoutMethod parses a string into a PayoutMethod.
+func ParsePayoutMethod(s string) (PayoutMethod, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "mpesa":
+		return PayoutMethodMPesa, nil
+	case "emola":
+		return PayoutMethodEMola, nil
+	case "mkesh":
+		return PayoutMethodMKesh, nil
+	case "bank_transfer":
+		return PayoutMethodBankTransfer, nil
+	default:
+		return "", parseError(ErrInvalidPayoutMethod, s)
+	}
+}
+
+// String returns the string representation.
+func (p PayoutMethod) String() string {
+	return string(p)
+}
+
+// Valid returns true if the PayoutMethod is valid.
+func (p PayoutMethod) Valid() bool {
+	switch p {
+	case PayoutMethodMPesa, PayoutMethodEMola, PayoutMethodMKesh, PayoutMethodBankTransfer:
+		return true
+	default:
+		return false
+	}
+}
+
+// PayoutMethodValues returns all valid PayoutMethod values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func PayoutMethodValues() []PayoutMethod {
+	return []PayoutMethod{
+		PayoutMethodMPesa,
+		PayoutMethodEMola,
+		PayoutMethodMKesh,
+		PayoutMethodBankTransfer,
+	}
+}
+
+// IsInstant returns true if payouts settle immediately (mobile money).
+// Bank transfers are not instant.
+func (p PayoutMethod) IsInstant() bool {
+	switch p {
+	case PayoutMethodMPesa, PayoutMethodEMola, PayoutMethodMKesh:
+		return true
+	default:
+		return false
+	}
+}
+
+// RequiresBankDetails returns true if the payout needs the driver's bank
+// account details.
+func (p PayoutMethod) RequiresBankDetails() bool {
+	return p == PayoutMethodBankTransfer
+}
+
+// PayoutMethodFromPaymentMethod returns the PayoutMethod corresponding to a
+// PaymentMethod. The boolean is false when no payout equivalent exists
+// (cash, card and wallet).
+func PayoutMethodFromPaymentMethod(m PaymentMethod) (PayoutMethod, bool) {
+	switch m {
+	case PaymentMethodMPesa:
+		return PayoutMethodMPesa, true
+	default:
+		return "", false
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p PayoutMethod) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(p)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PayoutMethod) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, p, ParsePayoutMethod)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p PayoutMethod) MarshalText() ([]byte, error) {
+	return marshalEnumText(p)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *PayoutMethod) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, p, ParsePayoutMethod)
+}
+
+// Scan implements sql.Scanner.
+func (p *PayoutMethod) Scan(src interface{}) error {
+	return scanEnum(src, p, ParsePayoutMethod)
+}
+
+// Value implements driver.Valuer.
+func (p PayoutMethod) Value() (driver.Value, error) {
+	return enumValue(p)
+}
+
+// PaymentGateway represents the internal processor that handles a payment.
+// Unlike PaymentMethod it is not shown to riders.
+type PaymentGateway string
+
+const (
+	PaymentGatewayMPesaAPI    PaymentGateway = "mpesa_api"
+	PaymentGatewayStripe      PaymentGateway = "stripe"
+	PaymentGatewayPayFast     PaymentGateway = "payfast"
+	PaymentGatewayFlutterwave PaymentGateway = "flutterwave"
+)
+
+// ErrInvalidPaymentGateway is returned when parsing an invalid payment gateway.
+var ErrInvalidPaymentGateway = errors.New("invalid payment gateway")
+
+// ParsePaymentGateway parses a string into a PaymentGateway.
+func ParsePaymentGateway(s string) (PaymentGateway, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "mpesa_api":
+		return PaymentGatewayMPesaAPI, nil
+	case "stripe":
+		return PaymentGatewayStripe, nil
+	case "payfast":
+		return PaymentGatewayPayFast, nil
+	case "flutterwave":
+		return PaymentGatewayFlutterwave, nil
+	default:
+		return "", parseError(ErrInvalidPaymentGateway, s)
+	}
+}
+
+// String returns the string representation.
+func (g PaymentGateway) String() string {
+	return string(g)
+}
+
+// Valid returns true if the PaymentGateway is valid.
+func (g PaymentGateway) Valid() bool {
+	switch g {
+	case PaymentGatewayMPesaAPI, PaymentGatewayStripe, PaymentGatewayPayFast, PaymentGatewayFlutterwave:
+		return true
+	default:
+		return false
+	}
+}
+
+// PaymentGatewayValues returns all valid PaymentGateway values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func PaymentGatewayValues() []PaymentGateway {
+	return []PaymentGateway{
+		PaymentGatewayMPesaAPI,
+		PaymentGatewayStripe,
+		PaymentGatewayPayFast,
+		PaymentGatewayFlutterwave,
+	}
+}
+
+// SupportsRefund returns true if refunds can be issued through the gateway's
+// API. PayFast refunds must be handled manually.
+func (g PaymentGateway) SupportsRefund() bool {
+	switch g {
+	case PaymentGatewayMPesaAPI, PaymentGatewayStripe, PaymentGatewayFlutterwave:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (g PaymentGateway) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(g)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (g *PaymentGateway) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, g, ParsePaymentGateway)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (g PaymentGateway) MarshalText() ([]byte, error) {
+	return marshalEnumText(g)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (g *PaymentGateway) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, g, ParsePaymentGateway)
+}
+
+// Scan implements sql.Scanner.
+func (g *PaymentGateway) Scan(src interface{}) error {
+	return scanEnum(src, g, ParsePaymentGateway)
+}
+
+// Value implements driver.Valuer.
+func (g PaymentGateway) Value() (driver.Value, error) {
+	return enumValue(g)
+}
+
+// RefundReason represents why a refund was requested.
+type RefundReason string
+
+const (
+	RefundReasonDuplicateCharge  RefundReason = "duplicate_charge"
+	RefundReasonRideNotCompleted RefundReason = "ride_not_completed"
+	RefundReasonOvercharge       RefundReason = "overcharge"
+	RefundReasonFraud            RefundReason = "fraud"
+	RefundReasonGoodwill         RefundReason = "goodwill"
+	RefundReasonOther            RefundReason = "other"
+)
+
+// ErrInvalidRefundReason is returned when parsing an invalid refund reason.
+var ErrInvalidRefundReason = errors.New("invalid refund reason")
+
+// ParseRefundReason parses a string into a RefundReason.
+func ParseRefundReason(s string) (RefundReason, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "duplicate_charge", "duplicate", "dup":
+		return RefundReasonDuplicateCharge, nil
+	case "ride_not_completed":
+		return RefundReasonRideNotCompleted, nil
+	case "overcharge":
+		return RefundReasonOvercharge, nil
+	case "fraud":
+		return RefundReasonFraud, nil
+	case "goodwill":
+		return RefundReasonGoodwill, nil
+	case "other":
+		return RefundReasonOther, nil
+	default:
+		return "", parseError(ErrInvalidRefundReason, s)
+	}
+}
+
+// String returns the string representation.
+func (r RefundReason) String() string {
+	return string(r)
+}
+
+// Valid returns true if the RefundReason is valid.
+func (r RefundReason) Valid() bool {
+	switch r {
+	case RefundReasonDuplicateCharge, RefundReasonRideNotCompleted, RefundReasonOvercharge,
+		RefundReasonFraud, RefundReasonGoodwill, RefundReasonOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// RefundReasonValues returns all valid RefundReason values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func RefundReasonValues() []RefundReason {
+	return []RefundReason{
+		RefundReasonDuplicateCharge,
+		RefundReasonRideNotCompleted,
+		RefundReasonOvercharge,
+		RefundReasonFraud,
+		RefundReasonGoodwill,
+		RefundReasonOther,
+	}
+}
+
+// RequiresManualReview returns true if refunds for this reason must be
+// approved by an agent. Duplicate charges, incomplete rides and overcharges
+// can be verified automatically against ride and payment records.
+func (r RefundReason) RequiresManualReview() bool {
+	switch r {
+	case RefundReasonFraud, RefundReasonGoodwill, RefundReasonOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r RefundReason) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(r)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *RefundReason) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, r, ParseRefundReason)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (r RefundReason) MarshalText() ([]byte, error) {
+	return marshalEnumText(r)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (r *RefundReason) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, r, ParseRefundReason)
+}
+
+// Scan implements sql.Scanner.
+func (r *RefundReason) Scan(src interface{}) error {
+	return scanEnum(src, r, ParseRefundReason)
+}
+
+// Value implements driver.Valuer.
+func (r RefundReason) Value() (driver.Value, error) {
+	return enumValue(r)
+}
+
+// RefundStatus represents the status of a refund.
+type RefundStatus string
+
+const (
+	RefundStatusRequested  RefundStatus = "requested"
+	RefundStatusApproved   RefundStatus = "approved"
+	RefundStatusProcessing RefundStatus = "processing"
+	RefundStatusCompleted  RefundStatus = "completed"
+	RefundStatusRejected   RefundStatus = "rejected"
+)
+
+// ErrInvalidRefundStatus is returned when parsing an invalid refund status.
+var ErrInvalidRefundStatus = errors.New("invalid refund status")
+
+// ErrInvalidRefundTransition is returned when a refund status change is not
+// allowed by the refund lifecycle.
+var ErrInvalidRefundTransition = errors.New("invalid refund status transition")
+
+// ParseRefundStatus parses a string into a RefundStatus.
+func ParseRefundStatus(s string) (RefundStatus, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "requested":
+		return RefundStatusRequested, nil
+	case "approved":
+		return RefundStatusApproved, nil
+	case "processing":
+		return RefundStatusProcessing, nil
+	case "completed":
+		return RefundStatusCompleted, nil
+	case "rejected":
+		return RefundStatusRejected, nil
+	default:
+		return "", parseError(ErrInvalidRefundStatus, s)
+	}
+}
+
+// String returns the string representation.
+func (r RefundStatus) String() string {
+	return string(r)
+}
+
+// Valid returns true if the RefundStatus is valid.
+func (r RefundStatus) Valid() bool {
+	switch r {
+	case RefundStatusRequested, RefundStatusApproved, RefundStatusProcessing, RefundStatusCompleted,
+		RefundStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// RefundStatusValues returns all valid RefundStatus values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func RefundStatusValues() []RefundStatus {
+	return []RefundStatus{
+		RefundStatusRequested,
+		RefundStatusApproved,
+		RefundStatusProcessing,
+		RefundStatusCompleted,
+		RefundStatusRejected,
+	}
+}
+
+// refundStatusTransitions defines the allowed refund lifecycle transitions.
+var refundStatusTransitions = map[RefundStatus][]RefundStatus{
+	RefundStatusRequested:  {RefundStatusApproved, RefundStatusRejected},
+	RefundStatusApproved:   {RefundStatusProcessing},
+	RefundStatusProcessing: {RefundStatusCompleted},
+	RefundStatusCompleted:  {},
+	RefundStatusRejected:   {},
+}
+
+// CanTransitionTo returns true if a refund may move from r to next.
+func (r RefundStatus) CanTransitionTo(next RefundStatus) bool {
+	for _, s := range refundStatusTransitions[r] {
+		if s == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTerminal returns true if no further status changes are allowed
+// (completed or rejected).
+func (r RefundStatus) IsTerminal() bool {
+	return r == RefundStatusCompleted || r == RefundStatusRejected
+}
+
+// ValidateRefundTransition returns an error wrapping
+// ErrInvalidRefundTransition if a refund may not move from one status to another.
+func ValidateRefundTransition(from, to RefundStatus) error {
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %q to %q", ErrInvalidRefundTransition, from, to)
 	}
-	*t = parsed
 	return nil
 }
 
+// MarshalJSON implements json.Marshaler.
+func (r RefundStatus) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(r)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *RefundStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, r, ParseRefundStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (r RefundStatus) MarshalText() ([]byte, error) {
+	return marshalEnumText(r)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (r *RefundStatus) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, r, ParseRefundStatus)
+}
+
 // Scan implements sql.Scanner.
-func (t *TransactionType) Scan(src interface{}) error {
-	switch v := src.(type) {
-	case string:
-		parsed, err := ParseTransactionType(v)
-		if err != nil {
-			return err
+func (r *RefundStatus) Scan(src interface{}) error {
+	return scanEnum(src, r, ParseRefundStatus)
+}
+
+// Value implements driver.Valuer.
+func (r RefundStatus) Value() (driver.Value, error) {
+	return enumValue(r)
+}
+
+// DisputeStatus represents the stage of a charge dispute.
+type DisputeStatus string
+
+const (
+	DisputeStatusOpened                DisputeStatus = "opened"
+	DisputeStatusAwaitingCustomer      DisputeStatus = "awaiting_customer"
+	DisputeStatusAwaitingPartner       DisputeStatus = "awaiting_partner"
+	DisputeStatusResolvedInFavorRider  DisputeStatus = "resolved_in_favor_rider"
+	DisputeStatusResolvedInFavorDriver DisputeStatus = "resolved_in_favor_driver"
+	DisputeStatusWithdrawn             DisputeStatus = "withdrawn"
+)
+
+// ErrInvalidDisputeStatus is returned when parsing an invalid dispute status.
+var ErrInvalidDisputeStatus = errors.New("invalid dispute status")
+
+// ErrInvalidDisputeTransition is returned when a dispute status change is not
+// allowed by the dispute lifecycle.
+var ErrInvalidDisputeTransition = errors.New("invalid dispute status transition")
+
+// ParseDisputeStatus parses a string into a DisputeStatus.
+func ParseDisputeStatus(s string) (DisputeStatus, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "opened":
+		return DisputeStatusOpened, nil
+	case "awaiting_customer":
+		return DisputeStatusAwaitingCustomer, nil
+	case "awaiting_partner":
+		return DisputeStatusAwaitingPartner, nil
+	case "resolved_in_favor_rider":
+		return DisputeStatusResolvedInFavorRider, nil
+	case "resolved_in_favor_driver":
+		return DisputeStatusResolvedInFavorDriver, nil
+	case "withdrawn":
+		return DisputeStatusWithdrawn, nil
+	default:
+		return "", parseError(ErrInvalidDisputeStatus, s)
+	}
+}
+
+// String returns the string representation.
+func (d DisputeStatus) String() string {
+	return string(d)
+}
+
+// Valid returns true if the DisputeStatus is valid.
+func (d DisputeStatus) Valid() bool {
+	switch d {
+	case DisputeStatusOpened, DisputeStatusAwaitingCustomer, DisputeStatusAwaitingPartner,
+		DisputeStatusResolvedInFavorRider, DisputeStatusResolvedInFavorDriver, DisputeStatusWithdrawn:
+		return true
+	default:
+		return false
+	}
+}
+
+// DisputeStatusValues returns all valid DisputeStatus values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func DisputeStatusValues() []DisputeStatus {
+	return []DisputeStatus{
+		DisputeStatusOpened,
+		DisputeStatusAwaitingCustomer,
+		DisputeStatusAwaitingPartner,
+		DisputeStatusResolvedInFavorRider,
+		DisputeStatusResolvedInFavorDriver,
+		DisputeStatusWithdrawn,
+	}
+}
+
+// disputeOutcomes are the statuses that close a dispute.
+var disputeOutcomes = []DisputeStatus{
+	DisputeStatusResolvedInFavorRider,
+	DisputeStatusResolvedInFavorDriver,
+	DisputeStatusWithdrawn,
+}
+
+// disputeStatusTransitions defines the allowed dispute lifecycle transitions.
+// Open disputes may move between investigation stages or be closed; closed
+// disputes are terminal.
+var disputeStatusTransitions = map[DisputeStatus][]DisputeStatus{
+	DisputeStatusOpened: append(
+		[]DisputeStatus{DisputeStatusAwaitingCustomer, DisputeStatusAwaitingPartner}, disputeOutcomes...),
+	DisputeStatusAwaitingCustomer: append(
+		[]DisputeStatus{DisputeStatusOpened, DisputeStatusAwaitingPartner}, disputeOutcomes...),
+	DisputeStatusAwaitingPartner: append(
+		[]DisputeStatus{DisputeStatusOpened, DisputeStatusAwaitingCustomer}, disputeOutcomes...),
+	DisputeStatusResolvedInFavorRider:  {},
+	DisputeStatusResolvedInFavorDriver: {},
+	DisputeStatusWithdrawn:             {},
+}
+
+// disputeSLAHours is the maximum time, in hours, a dispute may remain in each
+// open status before it is escalated.
+var disputeSLAHours = map[DisputeStatus]int{
+	DisputeStatusOpened:           24,
+	DisputeStatusAwaitingCustomer: 72,
+	DisputeStatusAwaitingPartner:  48,
+}
+
+// CanTransitionTo returns true if a dispute may move from d to next.
+func (d DisputeStatus) CanTransitionTo(next DisputeStatus) bool {
+	for _, s := range disputeStatusTransitions[d] {
+		if s == next {
+			return true
 		}
-		*t = parsed
-		return nil
-	case []byte:
-		parsed, err := ParseTransactionType(string(v))
-		if err != nil {
-			return err
+	}
+	return false
+}
+
+// IsResolved returns true if the dispute was decided in favor of either party.
+// Withdrawn disputes are closed but not resolved.
+func (d DisputeStatus) IsResolved() bool {
+	return d == DisputeStatusResolvedInFavorRider || d == DisputeStatusResolvedInFavorDriver
+}
+
+// IsClosed returns true if no further status changes are allowed
+// (resolved or withdrawn).
+func (d DisputeStatus) IsClosed() bool {
+	for _, s := range disputeOutcomes {
+		if s == d {
+			return true
 		}
-		*t = parsed
-		return nil
-	case nil:
-		*t = ""
-		return nil
+	}
+	return false
+}
+
+// SLAHours returns the number of hours a dispute may remain in this status
+// before escalation. Returns 0 for closed statuses and invalid values, which
+// have no SLA.
+func (d DisputeStatus) SLAHours() int {
+	return disputeSLAHours[d]
+}
+
+// ValidateDisputeTransition returns an error wrapping
+// ErrInvalidDisputeTransition if a dispute may not move from one status to another.
+func ValidateDisputeTransition(from, to DisputeStatus) error {
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %q to %q", ErrInvalidDisputeTransition, from, to)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d DisputeStatus) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(d)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DisputeStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, d, ParseDisputeStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d DisputeStatus) MarshalText() ([]byte, error) {
+	return marshalEnumText(d)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *DisputeStatus) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, d, ParseDisputeStatus)
+}
+
+// Scan implements sql.Scanner.
+func (d *DisputeStatus) Scan(src interface{}) error {
+	return scanEnum(src, d, ParseDisputeStatus)
+}
+
+// Value implements driver.Valuer.
+func (d DisputeStatus) Value() (driver.Value, error) {
+	return enumValue(d)
+}
+
+// WalletStatus represents the lifecycle state of a wallet.
+type WalletStatus string
+
+const (
+	WalletStatusActive         WalletStatus = "active"
+	WalletStatusFrozen         WalletStatus = "frozen"
+	WalletStatusClosed         WalletStatus = "closed"
+	WalletStatusPendingClosure WalletStatus = "pending_closure"
+)
+
+// ErrInvalidWalletStatus is returned when parsing an invalid wallet status.
+var ErrInvalidWalletStatus = errors.New("invalid wallet status")
+
+// ErrInvalidWalletTransition is returned when a wallet status change is not
+// allowed by the wallet lifecycle.
+var ErrInvalidWalletTransition = errors.New("invalid wallet status transition")
+
+// ParseWalletStatus parses a string into a WalletStatus.
+func ParseWalletStatus(s string) (WalletStatus, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "active":
+		return WalletStatusActive, nil
+	case "frozen":
+		return WalletStatusFrozen, nil
+	case "closed":
+		return WalletStatusClosed, nil
+	case "pending_closure":
+		return WalletStatusPendingClosure, nil
 	default:
-		return fmt.Errorf("cannot scan %T into TransactionType", src)
+		return "", parseError(ErrInvalidWalletStatus, s)
 	}
 }
 
+// String returns the string representation.
+func (w WalletStatus) String() string {
+	return string(w)
+}
+
+// Valid returns true if the WalletStatus is valid.
+func (w WalletStatus) Valid() bool {
+	switch w {
+	case WalletStatusActive, WalletStatusFrozen, WalletStatusClosed, WalletStatusPendingClosure:
+		return true
+	default:
+		return false
+	}
+}
+
+// WalletStatusValues returns all valid WalletStatus values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func WalletStatusValues() []WalletStatus {
+	return []WalletStatus{
+		WalletStatusActive,
+		WalletStatusFrozen,
+		WalletStatusClosed,
+		WalletStatusPendingClosure,
+	}
+}
+
+// walletStatusTransitions defines the allowed wallet lifecycle transitions.
+var walletStatusTransitions = map[WalletStatus][]WalletStatus{
+	WalletStatusActive:         {WalletStatusFrozen, WalletStatusPendingClosure},
+	WalletStatusFrozen:         {WalletStatusActive, WalletStatusClosed},
+	WalletStatusPendingClosure: {WalletStatusClosed},
+	WalletStatusClosed:         {},
+}
+
+// CanTransitionTo returns true if a wallet may move from w to next.
+func (w WalletStatus) CanTransitionTo(next WalletStatus) bool {
+	for _, s := range walletStatusTransitions[w] {
+		if s == next {
+			return true
+		}
+	}
+	return false
+}
+
+// CanDebit returns true if funds may be taken from the wallet (active only).
+func (w WalletStatus) CanDebit() bool {
+	return w == WalletStatusActive
+}
+
+// CanCredit returns true if funds may be added to the wallet. Frozen wallets
+// still receive refunds, and wallets pending closure receive in-flight
+// refunds before they are closed.
+func (w WalletStatus) CanCredit() bool {
+	return w == WalletStatusActive || w == WalletStatusFrozen || w == WalletStatusPendingClosure
+}
+
+// ValidateWalletTransition returns an error wrapping
+// ErrInvalidWalletTransition if a wallet may not move from one status to another.
+func ValidateWalletTransition(from, to WalletStatus) error {
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %q to %q", ErrInvalidWalletTransition, from, to)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (w WalletStatus) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(w)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (w *WalletStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, w, ParseWalletStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (w WalletStatus) MarshalText() ([]byte, error) {
+	return marshalEnumText(w)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (w *WalletStatus) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, w, ParseWalletStatus)
+}
+
+// Scan implements sql.Scanner.
+func (w *WalletStatus) Scan(src interface{}) error {
+	return scanEnum(src, w, ParseWalletStatus)
+}
+
 // Value implements driver.Valuer.
-func (t TransactionType) Value() (driver.Value, error) {
-	if t == "" {
-		return nil, nil
+func (w WalletStatus) Value() (driver.Value, error) {
+	return enumValue(w)
+}
+
+// TipStatus represents the state of a post-ride tip.
+type TipStatus string
+
+const (
+	TipStatusOffered        TipStatus = "offered"
+	TipStatusPendingCapture TipStatus = "pending_capture"
+	TipStatusCaptured       TipStatus = "captured"
+	TipStatusFailed         TipStatus = "failed"
+	TipStatusReversed       TipStatus = "reversed"
+)
+
+// ErrInvalidTipStatus is returned when parsing an invalid tip status.
+var ErrInvalidTipStatus = errors.New("invalid tip status")
+
+// ErrInvalidTipTransition is returned when a tip status change is not allowed
+// by the tip lifecycle.
+var ErrInvalidTipTransition = errors.New("invalid tip status transition")
+
+// ParseTipStatus parses a string into a TipStatus.
+func ParseTipStatus(s string) (TipStatus, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "offered":
+		return TipStatusOffered, nil
+	case "pending_capture":
+		return TipStatusPendingCapture, nil
+	case "captured":
+		return TipStatusCaptured, nil
+	case "failed":
+		return TipStatusFailed, nil
+	case "reversed":
+		return TipStatusReversed, nil
+	default:
+		return "", parseError(ErrInvalidTipStatus, s)
 	}
-	return string(t), nil
+}
+
+// String returns the string representation.
+func (t TipStatus) String() string {
+	return string(t)
+}
+
+// Valid returns true if the TipStatus is valid.
+func (t TipStatus) Valid() bool {
+	switch t {
+	case TipStatusOffered, TipStatusPendingCapture, TipStatusCaptured, TipStatusFailed, TipStatusReversed:
+		return true
+	default:
+		return false
+	}
+}
+
+// TipStatusValues returns all valid TipStatus values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func TipStatusValues() []TipStatus {
+	return []TipStatus{
+		TipStatusOffered,
+		TipStatusPendingCapture,
+		TipStatusCaptured,
+		TipStatusFailed,
+		TipStatusReversed,
+	}
+}
+
+// tipStatusTransitions defines the allowed tip lifecycle transitions.
+// A failed capture may be retried; reversed is terminal.
+var tipStatusTransitions = map[TipStatus][]TipStatus{
+	TipStatusOffered:        {TipStatusPendingCapture},
+	TipStatusPendingCapture: {TipStatusCaptured, TipStatusFailed},
+	TipStatusCaptured:       {TipStatusReversed},
+	TipStatusFailed:         {TipStatusPendingCapture},
+	TipStatusReversed:       {},
+}
+
+// CanTransitionTo returns true if a tip may move from t to next.
+func (t TipStatus) CanTransitionTo(next TipStatus) bool {
+	for _, s := range tipStatusTransitions[t] {
+		if s == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTipTransition returns an error wrapping ErrInvalidTipTransition if
+// a tip may not move from one status to another.
+func ValidateTipTransition(from, to TipStatus) error {
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %q to %q", ErrInvalidTipTransition, from, to)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t TipStatus) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(t)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *TipStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, t, ParseTipStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t TipStatus) MarshalText() ([]byte, error) {
+	return marshalEnumText(t)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *TipStatus) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, t, ParseTipStatus)
+}
+
+// Scan implements sql.Scanner.
+func (t *TipStatus) Scan(src interface{}) error {
+	return scanEnum(src, t, ParseTipStatus)
+}
+
+// Value implements driver.Valuer.
+func (t TipStatus) Value() (driver.Value, error) {
+	return enumValue(t)
 }
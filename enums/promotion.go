@@ -0,0 +1,219 @@
+package enums
+
+import (
+	"database/sql/driver"
+	"errors"
+	"strings"
+)
+
+// PromotionType represents the kind of benefit a promotion grants.
+type PromotionType string
+
+const (
+	PromotionTypePercentOff    PromotionType = "percent_off"
+	PromotionTypeAmountOff     PromotionType = "amount_off"
+	PromotionTypeFreeRide      PromotionType = "free_ride"
+	PromotionTypeReferralBonus PromotionType = "referral_bonus"
+)
+
+// ErrInvalidPromotionType is returned when parsing an invalid promotion type.
+var ErrInvalidPromotionType = errors.New("invalid promotion type")
+
+// ParsePromotionType parses a string into a PromotionType.
+func ParsePromotionType(s string) (PromotionType, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "percent_off":
+		return PromotionTypePercentOff, nil
+	case "amount_off":
+		return PromotionTypeAmountOff, nil
+	case "free_ride":
+		return PromotionTypeFreeRide, nil
+	case "referral_bonus":
+		return PromotionTypeReferralBonus, nil
+	default:
+		return "", parseError(ErrInvalidPromotionType, s)
+	}
+}
+
+// String returns the string representation.
+func (p PromotionType) String() string {
+	return string(p)
+}
+
+// Valid returns true if the PromotionType is valid.
+func (p PromotionType) Valid() bool {
+	switch p {
+	case PromotionTypePercentOff, PromotionTypeAmountOff, PromotionTypeFreeRide, PromotionTypeReferralBonus:
+		return true
+	default:
+		return false
+	}
+}
+
+// PromotionTypeValues returns all valid PromotionType values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func PromotionTypeValues() []PromotionType {
+	return []PromotionType{
+		PromotionTypePercentOff,
+		PromotionTypeAmountOff,
+		PromotionTypeFreeRide,
+		PromotionTypeReferralBonus,
+	}
+}
+
+// RequiresAmount returns true if the promotion is configured with a fixed
+// money amount (amount_off and referral_bonus).
+func (p PromotionType) RequiresAmount() bool {
+	return p == PromotionTypeAmountOff || p == PromotionTypeReferralBonus
+}
+
+// RequiresPercentage returns true if the promotion is configured with a
+// discount percentage (percent_off).
+func (p PromotionType) RequiresPercentage() bool {
+	return p == PromotionTypePercentOff
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p PromotionType) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(p)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PromotionType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, p, ParsePromotionType)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p PromotionType) MarshalText() ([]byte, error) {
+	return marshalEnumText(p)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *PromotionType) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, p, ParsePromotionType)
+}
+
+// Scan implements sql.Scanner.
+func (p *PromotionType) Scan(src interface{}) error {
+	return scanEnum(src, p, ParsePromotionType)
+}
+
+// Value implements driver.Valuer.
+func (p PromotionType) Value() (driver.Value, error) {
+	return enumValue(p)
+}
+
+// PromotionStatus represents the lifecycle status of a promotion.
+type PromotionStatus string
+
+const (
+	PromotionStatusDraft     PromotionStatus = "draft"
+	PromotionStatusActive    PromotionStatus = "active"
+	PromotionStatusPaused    PromotionStatus = "paused"
+	PromotionStatusExpired   PromotionStatus = "expired"
+	PromotionStatusExhausted PromotionStatus = "exhausted"
+)
+
+// ErrInvalidPromotionStatus is returned when parsing an invalid promotion status.
+var ErrInvalidPromotionStatus = errors.New("invalid promotion status")
+
+// ParsePromotionStatus parses a string into a PromotionStatus.
+func ParsePromotionStatus(s string) (PromotionStatus, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "draft":
+		return PromotionStatusDraft, nil
+	case "active":
+		return PromotionStatusActive, nil
+	case "paused":
+		return PromotionStatusPaused, nil
+	case "expired":
+		return PromotionStatusExpired, nil
+	case "exhausted":
+		return PromotionStatusExhausted, nil
+	default:
+		return "", parseError(ErrInvalidPromotionStatus, s)
+	}
+}
+
+// String returns the string representation.
+func (p PromotionStatus) String() string {
+	return string(p)
+}
+
+// Valid returns true if the PromotionStatus is valid.
+func (p PromotionStatus) Valid() bool {
+	switch p {
+	case PromotionStatusDraft, PromotionStatusActive, PromotionStatusPaused, PromotionStatusExpired,
+		PromotionStatusExhausted:
+		return true
+	default:
+		return false
+	}
+}
+
+// PromotionStatusValues returns all valid PromotionStatus values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func PromotionStatusValues() []PromotionStatus {
+	return []PromotionStatus{
+		PromotionStatusDraft,
+		PromotionStatusActive,
+		PromotionStatusPaused,
+		PromotionStatusExpired,
+		PromotionStatusExhausted,
+	}
+}
+
+// promotionStatusTransitions defines the allowed promotion lifecycle transitions.
+var promotionStatusTransitions = map[PromotionStatus][]PromotionStatus{
+	PromotionStatusDraft:     {PromotionStatusActive},
+	PromotionStatusActive:    {PromotionStatusPaused, PromotionStatusExpired, PromotionStatusExhausted},
+	PromotionStatusPaused:    {PromotionStatusActive, PromotionStatusExpired},
+	PromotionStatusExpired:   {},
+	PromotionStatusExhausted: {},
+}
+
+// CanTransitionTo returns true if a promotion may move from p to next.
+func (p PromotionStatus) CanTransitionTo(next PromotionStatus) bool {
+	for _, s := range promotionStatusTransitions[p] {
+		if s == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTerminal returns true if the promotion can no longer change status
+// (expired or exhausted).
+func (p PromotionStatus) IsTerminal() bool {
+	return p == PromotionStatusExpired || p == PromotionStatusExhausted
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p PromotionStatus) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(p)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PromotionStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, p, ParsePromotionStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p PromotionStatus) MarshalText() ([]byte, error) {
+	return marshalEnumText(p)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *PromotionStatus) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, p, ParsePromotionStatus)
+}
+
+// Scan implements sql.Scanner.
+func (p *PromotionStatus) Scan(src interface{}) error {
+	return scanEnum(src, p, ParsePromotionStatus)
+}
+
+// Value implements driver.Valuer.
+func (p PromotionStatus) Value() (driver.Value, error) {
+	return enumValue(p)
+}
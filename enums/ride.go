@@ -2,7 +2,6 @@ package enums
 
 import (
 	"database/sql/driver"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -33,7 +32,7 @@ func ParseServiceType(s string) (ServiceType, error) {
 	case "moto":
 		return ServiceTypeMoto, nil
 	default:
-		return "", ErrInvalidServiceType
+		return "", parseError(ErrInvalidServiceType, s)
 	}
 }
 
@@ -52,71 +51,123 @@ func (s ServiceType) Valid() bool {
 	}
 }
 
+// ServiceTypeValues returns all valid ServiceType values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func ServiceTypeValues() []ServiceType {
+	return []ServiceType{
+		ServiceTypeStandard,
+		ServiceTypeComfort,
+		ServiceTypePremium,
+		ServiceTypeMoto,
+	}
+}
+
+// serviceTypeInfo holds the matching and display metadata for a ServiceType.
+type serviceTypeInfo struct {
+	passengerCapacity int
+	vehicleTypes      []VehicleType
+	displayRank       int
+	requiredDocuments []DocumentType
+}
+
+// Documents every driver must provide, and those additionally required for
+// car-based services.
+var (
+	baseRequiredDocuments = []DocumentType{DocumentTypeDriversLicense, DocumentTypeInsurance, DocumentTypeIDCard}
+	carRequiredDocuments  = []DocumentType{
+		DocumentTypeDriversLicense, DocumentTypeVehicleRegistration, DocumentTypeInsurance,
+		DocumentTypeInspectionCertificate, DocumentTypeIDCard,
+	}
+)
+
+// serviceTypeMetadata is the single source of truth for service type
+// capacity, vehicle eligibility, UI ordering and onboarding documents.
+var serviceTypeMetadata = map[ServiceType]serviceTypeInfo{
+	ServiceTypeMoto: {
+		passengerCapacity: 1,
+		vehicleTypes:      []VehicleType{VehicleTypeMotorcycle},
+		displayRank:       1,
+		requiredDocuments: baseRequiredDocuments,
+	},
+	ServiceTypeStandard: {
+		passengerCapacity: 4,
+		vehicleTypes:      []VehicleType{VehicleTypeSedan, VehicleTypeHatchback, VehicleTypeSUV, VehicleTypeMinivan},
+		displayRank:       2,
+		requiredDocuments: carRequiredDocuments,
+	},
+	ServiceTypeComfort: {
+		passengerCapacity: 4,
+		vehicleTypes:      []VehicleType{VehicleTypeSedan, VehicleTypeSUV, VehicleTypeMinivan},
+		displayRank:       3,
+		requiredDocuments: carRequiredDocuments,
+	},
+	ServiceTypePremium: {
+		passengerCapacity: 4,
+		vehicleTypes:      []VehicleType{VehicleTypeSedan, VehicleTypeSUV},
+		displayRank:       4,
+		requiredDocuments: carRequiredDocuments,
+	},
+}
+
+// PassengerCapacity returns the maximum number of passengers for the service.
+// Returns 0 for invalid service types.
+func (s ServiceType) PassengerCapacity() int {
+	return serviceTypeMetadata[s].passengerCapacity
+}
+
+// EligibleVehicleTypes returns the vehicle types that may fulfil rides of
+// this service type. Returns an empty slice for invalid service types.
+func (s ServiceType) EligibleVehicleTypes() []VehicleType {
+	vehicles := serviceTypeMetadata[s].vehicleTypes
+	result := make([]VehicleType, len(vehicles))
+	copy(result, vehicles)
+	return result
+}
+
+// RequiredDocuments returns the document types a driver must provide to
+// offer the service type, in DocumentType declaration order. Returns an empty
+// slice for invalid service types. The returned slice may be modified freely.
+func RequiredDocuments(s ServiceType) []DocumentType {
+	docs := serviceTypeMetadata[s].requiredDocuments
+	result := make([]DocumentType, len(docs))
+	copy(result, docs)
+	return result
+}
+
+// DisplayRank returns the position of the service type in UI listings,
+// starting at 1. Returns 0 for invalid service types.
+func (s ServiceType) DisplayRank() int {
+	return serviceTypeMetadata[s].displayRank
+}
+
 // MarshalJSON implements json.Marshaler.
 func (s ServiceType) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(s))
+	return marshalEnumJSON(s)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (s *ServiceType) UnmarshalJSON(data []byte) error {
-	var str string
-	if err := json.Unmarshal(data, &str); err != nil {
-		return err
-	}
-	parsed, err := ParseServiceType(str)
-	if err != nil {
-		return err
-	}
-	*s = parsed
-	return nil
+	return unmarshalEnumJSON(data, s, ParseServiceType)
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (s ServiceType) MarshalText() ([]byte, error) {
-	return []byte(s), nil
+	return marshalEnumText(s)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (s *ServiceType) UnmarshalText(data []byte) error {
-	parsed, err := ParseServiceType(string(data))
-	if err != nil {
-		return err
-	}
-	*s = parsed
-	return nil
+	return unmarshalEnumText(data, s, ParseServiceType)
 }
 
 // Scan implements sql.Scanner.
 func (s *ServiceType) Scan(src interface{}) error {
-	switch v := src.(type) {
-	case string:
-		parsed, err := ParseServiceType(v)
-		if err != nil {
-			return err
-		}
-		*s = parsed
-		return nil
-	case []byte:
-		parsed, err := ParseServiceType(string(v))
-		if err != nil {
-			return err
-		}
-		*s = parsed
-		return nil
-	case nil:
-		*s = ""
-		return nil
-	default:
-		return fmt.Errorf("cannot scan %T into ServiceType", src)
-	}
+	return scanEnum(src, s, ParseServiceType)
 }
 
 // Value implements driver.Valuer.
 func (s ServiceType) Value() (driver.Value, error) {
-	if s == "" {
-		return nil, nil
-	}
-	return string(s), nil
+	return enumValue(s)
 }
 
 // RideStatus represents the status of a ride.
@@ -156,7 +207,7 @@ func ParseRideStatus(s string) (RideStatus, error) {
 	case "cancelled":
 		return RideStatusCancelled, nil
 	default:
-		return "", ErrInvalidRideStatus
+		return "", parseError(ErrInvalidRideStatus, s)
 	}
 }
 
@@ -177,71 +228,108 @@ func (r RideStatus) Valid() bool {
 	}
 }
 
+// RideStatusValues returns all valid RideStatus values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func RideStatusValues() []RideStatus {
+	return []RideStatus{
+		RideStatusRequested,
+		RideStatusSearching,
+		RideStatusDriverAssigned,
+		RideStatusDriverArriving,
+		RideStatusWaitingForRider,
+		RideStatusInProgress,
+		RideStatusCompleted,
+		RideStatusCancelled,
+	}
+}
+
+// RideStatusUnknown is the sentinel returned by ParseRideStatusLenient for
+// statuses this package does not recognize. It is not Valid.
+const RideStatusUnknown RideStatus = UnknownValue
+
+// ParseRideStatusLenient parses a string into a RideStatus, returning
+// RideStatusUnknown instead of an error for unrecognized or empty input.
+func ParseRideStatusLenient(s string) RideStatus {
+	return ParseLenient(s, ParseRideStatus)
+}
+
+// IsUnknown returns true if the RideStatus is the RideStatusUnknown sentinel.
+func (r RideStatus) IsUnknown() bool {
+	return r == RideStatusUnknown
+}
+
+// rideStatusTransitions defines the allowed ride lifecycle transitions.
+// Rides progress linearly from requested to completed and may be cancelled
+// from any non-terminal state.
+var rideStatusTransitions = map[RideStatus][]RideStatus{
+	RideStatusRequested:       {RideStatusSearching, RideStatusCancelled},
+	RideStatusSearching:       {RideStatusDriverAssigned, RideStatusCancelled},
+	RideStatusDriverAssigned:  {RideStatusDriverArriving, RideStatusCancelled},
+	RideStatusDriverArriving:  {RideStatusWaitingForRider, RideStatusCancelled},
+	RideStatusWaitingForRider: {RideStatusInProgress, RideStatusCancelled},
+	RideStatusInProgress:      {RideStatusCompleted, RideStatusCancelled},
+	RideStatusCompleted:       {},
+	RideStatusCancelled:       {},
+}
+
+// CanTransitionTo returns true if a ride may move from r to next.
+func (r RideStatus) CanTransitionTo(next RideStatus) bool {
+	for _, s := range rideStatusTransitions[r] {
+		if s == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidNextStatuses returns the statuses a ride may move to from r.
+// Returns an empty slice for terminal or invalid statuses.
+func (r RideStatus) ValidNextStatuses() []RideStatus {
+	next := rideStatusTransitions[r]
+	result := make([]RideStatus, len(next))
+	copy(result, next)
+	return result
+}
+
+// IsTerminal returns true if the ride has finished (completed or cancelled).
+func (r RideStatus) IsTerminal() bool {
+	return r == RideStatusCompleted || r == RideStatusCancelled
+}
+
+// IsActive returns true if the ride is valid and has not yet reached a
+// terminal status.
+func (r RideStatus) IsActive() bool {
+	return r.Valid() && !r.IsTerminal()
+}
+
 // MarshalJSON implements json.Marshaler.
 func (r RideStatus) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(r))
+	return marshalEnumJSON(r)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (r *RideStatus) UnmarshalJSON(data []byte) error {
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
-		return err
-	}
-	parsed, err := ParseRideStatus(s)
-	if err != nil {
-		return err
-	}
-	*r = parsed
-	return nil
+	return unmarshalEnumJSON(data, r, ParseRideStatus)
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (r RideStatus) MarshalText() ([]byte, error) {
-	return []byte(r), nil
+	return marshalEnumText(r)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (r *RideStatus) UnmarshalText(data []byte) error {
-	parsed, err := ParseRideStatus(string(data))
-	if err != nil {
-		return err
-	}
-	*r = parsed
-	return nil
+	return unmarshalEnumText(data, r, ParseRideStatus)
 }
 
 // Scan implements sql.Scanner.
 func (r *RideStatus) Scan(src interface{}) error {
-	switch v := src.(type) {
-	case string:
-		parsed, err := ParseRideStatus(v)
-		if err != nil {
-			return err
-		}
-		*r = parsed
-		return nil
-	case []byte:
-		parsed, err := ParseRideStatus(string(v))
-		if err != nil {
-			return err
-		}
-		*r = parsed
-		return nil
-	case nil:
-		*r = ""
-		return nil
-	default:
-		return fmt.Errorf("cannot scan %T into RideStatus", src)
-	}
+	return scanEnum(src, r, ParseRideStatus)
 }
 
 // Value implements driver.Valuer.
 func (r RideStatus) Value() (driver.Value, error) {
-	if r == "" {
-		return nil, nil
-	}
-	return string(r), nil
+	return enumValue(r)
 }
 
 // CancellationReason represents the reason for ride cancellation.
@@ -278,7 +366,7 @@ func ParseCancellationReason(s string) (CancellationReason, error) {
 	case "other":
 		return CancellationReasonOther, nil
 	default:
-		return "", ErrInvalidCancellationReason
+		return "", parseError(ErrInvalidCancellationReason, s)
 	}
 }
 
@@ -299,69 +387,625 @@ func (c CancellationReason) Valid() bool {
 	}
 }
 
+// CancellationReasonValues returns all valid CancellationReason values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func CancellationReasonValues() []CancellationReason {
+	return []CancellationReason{
+		CancellationReasonRiderCancelled,
+		CancellationReasonDriverCancelled,
+		CancellationReasonNoDriversAvailable,
+		CancellationReasonRiderNoShow,
+		CancellationReasonDriverNoShow,
+		CancellationReasonSafetyConcern,
+		CancellationReasonOther,
+	}
+}
+
+// cancellationFaults attributes each cancellation reason to the party at fault.
+// Cancellation fees and driver penalties are derived from this table.
+var cancellationFaults = map[CancellationReason]Fault{
+	CancellationReasonRiderCancelled:     FaultRider,
+	CancellationReasonDriverCancelled:    FaultDriver,
+	CancellationReasonNoDriversAvailable: FaultPlatform,
+	CancellationReasonRiderNoShow:        FaultRider,
+	CancellationReasonDriverNoShow:       FaultDriver,
+	CancellationReasonSafetyConcern:      FaultNone,
+	CancellationReasonOther:              FaultNone,
+}
+
+// Fault returns the party responsible for the cancellation.
+// Returns an empty Fault for invalid reasons.
+func (c CancellationReason) Fault() Fault {
+	return cancellationFaults[c]
+}
+
+// IsChargeable returns true if the rider may be charged a cancellation fee,
+// i.e. the rider is at fault.
+func (c CancellationReason) IsChargeable() bool {
+	return c.Fault() == FaultRider
+}
+
+// CountsAgainstDriver returns true if the cancellation should count towards
+// the driver's cancellation rate, i.e. the driver is at fault.
+func (c CancellationReason) CountsAgainstDriver() bool {
+	return c.Fault() == FaultDriver
+}
+
 // MarshalJSON implements json.Marshaler.
 func (c CancellationReason) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(c))
+	return marshalEnumJSON(c)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (c *CancellationReason) UnmarshalJSON(data []byte) error {
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
-		return err
-	}
-	parsed, err := ParseCancellationReason(s)
-	if err != nil {
-		return err
-	}
-	*c = parsed
-	return nil
+	return unmarshalEnumJSON(data, c, ParseCancellationReason)
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (c CancellationReason) MarshalText() ([]byte, error) {
-	return []byte(c), nil
+	return marshalEnumText(c)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (c *CancellationReason) UnmarshalText(data []byte) error {
-	parsed, err := ParseCancellationReason(string(data))
-	if err != nil {
-		return err
-	}
-	*c = parsed
-	return nil
+	return unmarshalEnumText(data, c, ParseCancellationReason)
 }
 
 // Scan implements sql.Scanner.
 func (c *CancellationReason) Scan(src interface{}) error {
-	switch v := src.(type) {
-	case string:
-		parsed, err := ParseCancellationReason(v)
-		if err != nil {
-			return err
-		}
-		*c = parsed
-		return nil
-	case []byte:
-		parsed, err := ParseCancellationReason(string(v))
-		if err != nil {
-			return err
-		}
-		*c = parsed
-		return nil
-	case nil:
-		*c = ""
-		return nil
+	return scanEnum(src, c, ParseCancellationReason)
+}
+
+// Value implements driver.Valuer.
+func (c CancellationReason) Value() (driver.Value, error) {
+	return enumValue(c)
+}
+
+// Fault represents the party responsible for a cancelled ride.
+type Fault string
+
+const (
+	FaultRider    Fault = "rider"
+	FaultDriver   Fault = "driver"
+	FaultPlatform Fault = "platform"
+	FaultNone     Fault = "none"
+)
+
+// ErrInvalidFault is returned when parsing an invalid fault.
+var ErrInvalidFault = errors.New("invalid fault")
+
+// ParseFault parses a string into a Fault.
+func ParseFault(s string) (Fault, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "rider":
+		return FaultRider, nil
+	case "driver":
+		return FaultDriver, nil
+	case "platform":
+		return FaultPlatform, nil
+	case "none":
+		return FaultNone, nil
+	default:
+		return "", parseError(ErrInvalidFault, s)
+	}
+}
+
+// String returns the string representation.
+func (f Fault) String() string {
+	return string(f)
+}
+
+// Valid returns true if the Fault is valid.
+func (f Fault) Valid() bool {
+	switch f {
+	case FaultRider, FaultDriver, FaultPlatform, FaultNone:
+		return true
 	default:
-		return fmt.Errorf("cannot scan %T into CancellationReason", src)
+		return false
 	}
 }
 
+// FaultValues returns all valid Fault values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func FaultValues() []Fault {
+	return []Fault{
+		FaultRider,
+		FaultDriver,
+		FaultPlatform,
+		FaultNone,
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f Fault) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(f)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *Fault) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, f, ParseFault)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (f Fault) MarshalText() ([]byte, error) {
+	return marshalEnumText(f)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (f *Fault) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, f, ParseFault)
+}
+
+// Scan implements sql.Scanner.
+func (f *Fault) Scan(src interface{}) error {
+	return scanEnum(src, f, ParseFault)
+}
+
 // Value implements driver.Valuer.
-func (c CancellationReason) Value() (driver.Value, error) {
-	if c == "" {
-		return nil, nil
+func (f Fault) Value() (driver.Value, error) {
+	return enumValue(f)
+}
+
+// RideCancelledBy identifies who cancelled a ride. CancellationReason records why.
+type RideCancelledBy string
+
+const (
+	RideCancelledByRider  RideCancelledBy = "rider"
+	RideCancelledByDriver RideCancelledBy = "driver"
+	RideCancelledBySystem RideCancelledBy = "system"
+	RideCancelledByAdmin  RideCancelledBy = "admin"
+)
+
+// ErrInvalidRideCancelledBy is returned when parsing an invalid ride canceller.
+var ErrInvalidRideCancelledBy = errors.New("invalid ride canceller")
+
+// ParseRideCancelledBy parses a string into a RideCancelledBy.
+func ParseRideCancelledBy(s string) (RideCancelledBy, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "rider":
+		return RideCancelledByRider, nil
+	case "driver":
+		return RideCancelledByDriver, nil
+	case "system":
+		return RideCancelledBySystem, nil
+	case "admin":
+		return RideCancelledByAdmin, nil
+	default:
+		return "", parseError(ErrInvalidRideCancelledBy, s)
+	}
+}
+
+// String returns the string representation.
+func (c RideCancelledBy) String() string {
+	return string(c)
+}
+
+// Valid returns true if the RideCancelledBy is valid.
+func (c RideCancelledBy) Valid() bool {
+	switch c {
+	case RideCancelledByRider, RideCancelledByDriver, RideCancelledBySystem, RideCancelledByAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// RideCancelledByValues returns all valid RideCancelledBy values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func RideCancelledByValues() []RideCancelledBy {
+	return []RideCancelledBy{
+		RideCancelledByRider,
+		RideCancelledByDriver,
+		RideCancelledBySystem,
+		RideCancelledByAdmin,
+	}
+}
+
+// IsFaultOfDriver returns true if the driver cancelled the ride.
+func (c RideCancelledBy) IsFaultOfDriver() bool {
+	return c == RideCancelledByDriver
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c RideCancelledBy) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *RideCancelledBy) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, c, ParseRideCancelledBy)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c RideCancelledBy) MarshalText() ([]byte, error) {
+	return marshalEnumText(c)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *RideCancelledBy) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, c, ParseRideCancelledBy)
+}
+
+// Scan implements sql.Scanner.
+func (c *RideCancelledBy) Scan(src interface{}) error {
+	return scanEnum(src, c, ParseRideCancelledBy)
+}
+
+// Value implements driver.Valuer.
+func (c RideCancelledBy) Value() (driver.Value, error) {
+	return enumValue(c)
+}
+
+// FareAdjustmentReason represents why a fare was manually adjusted.
+type FareAdjustmentReason string
+
+const (
+	FareAdjustmentReasonRouteDeviation FareAdjustmentReason = "route_deviation"
+	FareAdjustmentReasonWaitingTime    FareAdjustmentReason = "waiting_time"
+	FareAdjustmentReasonToll           FareAdjustmentReason = "toll"
+	FareAdjustmentReasonCleaningFee    FareAdjustmentReason = "cleaning_fee"
+	FareAdjustmentReasonServiceFailure FareAdjustmentReason = "service_failure"
+	FareAdjustmentReasonPriceError     FareAdjustmentReason = "price_error"
+	FareAdjustmentReasonGoodwill       FareAdjustmentReason = "goodwill"
+)
+
+// ErrInvalidFareAdjustmentReason is returned when parsing an invalid fare adjustment reason.
+var ErrInvalidFareAdjustmentReason = errors.New("invalid fare adjustment reason")
+
+// ParseFareAdjustmentReason parses a string into a FareAdjustmentReason.
+func ParseFareAdjustmentReason(s string) (FareAdjustmentReason, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "route_deviation":
+		return FareAdjustmentReasonRouteDeviation, nil
+	case "waiting_time":
+		return FareAdjustmentReasonWaitingTime, nil
+	case "toll":
+		return FareAdjustmentReasonToll, nil
+	case "cleaning_fee":
+		return FareAdjustmentReasonCleaningFee, nil
+	case "service_failure":
+		return FareAdjustmentReasonServiceFailure, nil
+	case "price_error":
+		return FareAdjustmentReasonPriceError, nil
+	case "goodwill":
+		return FareAdjustmentReasonGoodwill, nil
+	default:
+		return "", parseError(ErrInvalidFareAdjustmentReason, s)
 	}
-	return string(c), nil
+}
+
+// String returns the string representation.
+func (f FareAdjustmentReason) String() string {
+	return string(f)
+}
+
+// Valid returns true if the FareAdjustmentReason is valid.
+func (f FareAdjustmentReason) Valid() bool {
+	switch f {
+	case FareAdjustmentReasonRouteDeviation, FareAdjustmentReasonWaitingTime,
+		FareAdjustmentReasonToll, FareAdjustmentReasonCleaningFee, FareAdjustmentReasonServiceFailure,
+		FareAdjustmentReasonPriceError, FareAdjustmentReasonGoodwill:
+		return true
+	default:
+		return false
+	}
+}
+
+// FareAdjustmentReasonValues returns all valid FareAdjustmentReason values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func FareAdjustmentReasonValues() []FareAdjustmentReason {
+	return []FareAdjustmentReason{
+		FareAdjustmentReasonRouteDeviation,
+		FareAdjustmentReasonWaitingTime,
+		FareAdjustmentReasonToll,
+		FareAdjustmentReasonCleaningFee,
+		FareAdjustmentReasonServiceFailure,
+		FareAdjustmentReasonPriceError,
+		FareAdjustmentReasonGoodwill,
+	}
+}
+
+// fareAdjustmentRules holds the allowed direction of each adjustment reason
+// (+1 increase only, -1 decrease only, 0 either) and whether the agent must
+// attach evidence such as a receipt or photos.
+var fareAdjustmentRules = map[FareAdjustmentReason]struct {
+	sign     int
+	evidence bool
+}{
+	FareAdjustmentReasonRouteDeviation: {sign: 0, evidence: false},  // longer or shorter than quoted
+	FareAdjustmentReasonWaitingTime:    {sign: +1, evidence: false}, // charged from trip timestamps
+	FareAdjustmentReasonToll:           {sign: +1, evidence: true},  // toll receipt
+	FareAdjustmentReasonCleaningFee:    {sign: +1, evidence: true},  // photos of the damage
+	FareAdjustmentReasonServiceFailure: {sign: -1, evidence: false},
+	FareAdjustmentReasonPriceError:     {sign: 0, evidence: false},
+	FareAdjustmentReasonGoodwill:       {sign: -1, evidence: false},
+}
+
+// RequiresEvidence returns true if an adjustment for this reason must be
+// backed by an attachment (toll and cleaning_fee).
+func (f FareAdjustmentReason) RequiresEvidence() bool {
+	return fareAdjustmentRules[f].evidence
+}
+
+// AllowedSign returns +1 if the reason may only increase the fare, -1 if it
+// may only decrease it, and 0 if it may do either. Invalid values also
+// return 0; check Valid first.
+func (f FareAdjustmentReason) AllowedSign() int {
+	return fareAdjustmentRules[f].sign
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f FareAdjustmentReason) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(f)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *FareAdjustmentReason) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, f, ParseFareAdjustmentReason)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (f FareAdjustmentReason) MarshalText() ([]byte, error) {
+	return marshalEnumText(f)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (f *FareAdjustmentReason) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, f, ParseFareAdjustmentReason)
+}
+
+// Scan implements sql.Scanner.
+func (f *FareAdjustmentReason) Scan(src interface{}) error {
+	return scanEnum(src, f, ParseFareAdjustmentReason)
+}
+
+// Value implements driver.Valuer.
+func (f FareAdjustmentReason) Value() (driver.Value, error) {
+	return enumValue(f)
+}
+
+// TripPurpose represents the intent of a trip for expense tracking and analytics.
+type TripPurpose string
+
+const (
+	TripPurposeBusiness TripPurpose = "business"
+	TripPurposePersonal TripPurpose = "personal"
+	TripPurposeShared   TripPurpose = "shared"
+	TripPurposeDelivery TripPurpose = "delivery"
+)
+
+// ErrInvalidTripPurpose is returned when parsing an invalid trip purpose.
+var ErrInvalidTripPurpose = errors.New("invalid trip purpose")
+
+// ParseTripPurpose parses a string into a TripPurpose.
+func ParseTripPurpose(s string) (TripPurpose, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "business":
+		return TripPurposeBusiness, nil
+	case "personal":
+		return TripPurposePersonal, nil
+	case "shared":
+		return TripPurposeShared, nil
+	case "delivery":
+		return TripPurposeDelivery, nil
+	default:
+		return "", parseError(ErrInvalidTripPurpose, s)
+	}
+}
+
+// String returns the string representation.
+func (p TripPurpose) String() string {
+	return string(p)
+}
+
+// Valid returns true if the TripPurpose is valid.
+func (p TripPurpose) Valid() bool {
+	switch p {
+	case TripPurposeBusiness, TripPurposePersonal, TripPurposeShared, TripPurposeDelivery:
+		return true
+	default:
+		return false
+	}
+}
+
+// TripPurposeValues returns all valid TripPurpose values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func TripPurposeValues() []TripPurpose {
+	return []TripPurpose{
+		TripPurposeBusiness,
+		TripPurposePersonal,
+		TripPurposeShared,
+		TripPurposeDelivery,
+	}
+}
+
+// IsExpenseable returns true if the trip may be claimed as a business
+// expense (business and delivery).
+func (p TripPurpose) IsExpenseable() bool {
+	return p == TripPurposeBusiness || p == TripPurposeDelivery
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p TripPurpose) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(p)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *TripPurpose) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, p, ParseTripPurpose)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p TripPurpose) MarshalText() ([]byte, error) {
+	return marshalEnumText(p)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *TripPurpose) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, p, ParseTripPurpose)
+}
+
+// Scan implements sql.Scanner.
+func (p *TripPurpose) Scan(src interface{}) error {
+	return scanEnum(src, p, ParseTripPurpose)
+}
+
+// Value implements driver.Valuer.
+func (p TripPurpose) Value() (driver.Value, error) {
+	return enumValue(p)
+}
+
+// ScheduledRideStatus represents the pre-dispatch lifecycle of a pre-booked ride.
+// Once converted, the ride continues as a live ride tracked by RideStatus.
+type ScheduledRideStatus string
+
+const (
+	ScheduledRideStatusScheduled    ScheduledRideStatus = "scheduled"
+	ScheduledRideStatusReminderSent ScheduledRideStatus = "reminder_sent"
+	ScheduledRideStatusDispatching  ScheduledRideStatus = "dispatching"
+	ScheduledRideStatusConverted    ScheduledRideStatus = "converted"
+	ScheduledRideStatusExpired      ScheduledRideStatus = "expired"
+	ScheduledRideStatusCancelled    ScheduledRideStatus = "cancelled"
+)
+
+// ErrInvalidScheduledRideStatus is returned when parsing an invalid scheduled ride status.
+var ErrInvalidScheduledRideStatus = errors.New("invalid scheduled ride status")
+
+// ErrInvalidScheduledRideTransition is returned when a scheduled ride status
+// change is not allowed by the pre-dispatch lifecycle.
+var ErrInvalidScheduledRideTransition = errors.New("invalid scheduled ride status transition")
+
+// ParseScheduledRideStatus parses a string into a ScheduledRideStatus.
+func ParseScheduledRideStatus(s string) (ScheduledRideStatus, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "scheduled":
+		return ScheduledRideStatusScheduled, nil
+	case "reminder_sent":
+		return ScheduledRideStatusReminderSent, nil
+	case "dispatching":
+		return ScheduledRideStatusDispatching, nil
+	case "converted":
+		return ScheduledRideStatusConverted, nil
+	case "expired":
+		return ScheduledRideStatusExpired, nil
+	case "cancelled":
+		return ScheduledRideStatusCancelled, nil
+	default:
+		return "", parseError(ErrInvalidScheduledRideStatus, s)
+	}
+}
+
+// String returns the string representation.
+func (s ScheduledRideStatus) String() string {
+	return string(s)
+}
+
+// Valid returns true if the ScheduledRideStatus is valid.
+func (s ScheduledRideStatus) Valid() bool {
+	switch s {
+	case ScheduledRideStatusScheduled, ScheduledRideStatusReminderSent,
+		ScheduledRideStatusDispatching, ScheduledRideStatusConverted, ScheduledRideStatusExpired,
+		ScheduledRideStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// ScheduledRideStatusValues returns all valid ScheduledRideStatus values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func ScheduledRideStatusValues() []ScheduledRideStatus {
+	return []ScheduledRideStatus{
+		ScheduledRideStatusScheduled,
+		ScheduledRideStatusReminderSent,
+		ScheduledRideStatusDispatching,
+		ScheduledRideStatusConverted,
+		ScheduledRideStatusExpired,
+		ScheduledRideStatusCancelled,
+	}
+}
+
+// scheduledRideStatusTransitions defines the allowed pre-dispatch lifecycle
+// transitions. Converted, expired and cancelled are terminal.
+var scheduledRideStatusTransitions = map[ScheduledRideStatus][]ScheduledRideStatus{
+	ScheduledRideStatusScheduled: {
+		ScheduledRideStatusReminderSent, ScheduledRideStatusDispatching,
+		ScheduledRideStatusExpired, ScheduledRideStatusCancelled,
+	},
+	ScheduledRideStatusReminderSent: {
+		ScheduledRideStatusDispatching, ScheduledRideStatusExpired, ScheduledRideStatusCancelled,
+	},
+	ScheduledRideStatusDispatching: {
+		ScheduledRideStatusConverted, ScheduledRideStatusExpired, ScheduledRideStatusCancelled,
+	},
+	ScheduledRideStatusConverted: {},
+	ScheduledRideStatusExpired:   {},
+	ScheduledRideStatusCancelled: {},
+}
+
+// CanTransitionTo returns true if a scheduled ride may move from s to next.
+func (s ScheduledRideStatus) CanTransitionTo(next ScheduledRideStatus) bool {
+	for _, status := range scheduledRideStatusTransitions[s] {
+		if status == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPreDispatch returns true if the ride is still waiting for its pickup
+// time and dispatch has not started (scheduled or reminder_sent).
+func (s ScheduledRideStatus) IsPreDispatch() bool {
+	return s == ScheduledRideStatusScheduled || s == ScheduledRideStatusReminderSent
+}
+
+// ToRideStatus returns the RideStatus a scheduled ride continues with once it
+// has been converted into a live ride, which is RideStatusRequested.
+// Returns false for every other status: those rides have no live
+// counterpart yet, or never will.
+func (s ScheduledRideStatus) ToRideStatus() (RideStatus, bool) {
+	if s == ScheduledRideStatusConverted {
+		return RideStatusRequested, true
+	}
+	return "", false
+}
+
+// ValidateScheduledRideTransition returns an error wrapping
+// ErrInvalidScheduledRideTransition if a scheduled ride may not move from one
+// status to another.
+func ValidateScheduledRideTransition(from, to ScheduledRideStatus) error {
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %q to %q", ErrInvalidScheduledRideTransition, from, to)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s ScheduledRideStatus) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *ScheduledRideStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, s, ParseScheduledRideStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ScheduledRideStatus) MarshalText() ([]byte, error) {
+	return marshalEnumText(s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ScheduledRideStatus) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, s, ParseScheduledRideStatus)
+}
+
+// Scan implements sql.Scanner.
+func (s *ScheduledRideStatus) Scan(src interface{}) error {
+	return scanEnum(src, s, ParseScheduledRideStatus)
+}
+
+// Value implements driver.Valuer.
+func (s ScheduledRideStatus) Value() (driver.Value, error) {
+	return enumValue(s)
 }
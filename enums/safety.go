@@ -2,7 +2,6 @@ package enums
 
 import (
 	"database/sql/driver"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -33,7 +32,7 @@ func ParseIncidentSeverity(s string) (IncidentSeverity, error) {
 	case "critical":
 		return IncidentSeverityCritical, nil
 	default:
-		return "", ErrInvalidIncidentSeverity
+		return "", parseError(ErrInvalidIncidentSeverity, s)
 	}
 }
 
@@ -52,71 +51,84 @@ func (i IncidentSeverity) Valid() bool {
 	}
 }
 
+// IncidentSeverityValues returns all valid IncidentSeverity values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func IncidentSeverityValues() []IncidentSeverity {
+	return []IncidentSeverity{
+		IncidentSeverityLow,
+		IncidentSeverityMedium,
+		IncidentSeverityHigh,
+		IncidentSeverityCritical,
+	}
+}
+
+// Level returns the numeric severity, from 1 (low) to 4 (critical).
+// Invalid severities have Level 0, so they order below every valid severity.
+func (i IncidentSeverity) Level() int {
+	switch i {
+	case IncidentSeverityLow:
+		return 1
+	case IncidentSeverityMedium:
+		return 2
+	case IncidentSeverityHigh:
+		return 3
+	case IncidentSeverityCritical:
+		return 4
+	default:
+		return 0
+	}
+}
+
+// Less returns true if i is less severe than other, comparing by Level.
+func (i IncidentSeverity) Less(other IncidentSeverity) bool {
+	return i.Level() < other.Level()
+}
+
+// AtLeast returns true if i is as severe as or more severe than other,
+// comparing by Level.
+func (i IncidentSeverity) AtLeast(other IncidentSeverity) bool {
+	return i.Level() >= other.Level()
+}
+
+// IncidentSeveritiesAscending returns all valid severities ordered from least
+// to most severe. The returned slice is a new copy on every call.
+func IncidentSeveritiesAscending() []IncidentSeverity {
+	return []IncidentSeverity{
+		IncidentSeverityLow,
+		IncidentSeverityMedium,
+		IncidentSeverityHigh,
+		IncidentSeverityCritical,
+	}
+}
+
 // MarshalJSON implements json.Marshaler.
 func (i IncidentSeverity) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(i))
+	return marshalEnumJSON(i)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (i *IncidentSeverity) UnmarshalJSON(data []byte) error {
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
-		return err
-	}
-	parsed, err := ParseIncidentSeverity(s)
-	if err != nil {
-		return err
-	}
-	*i = parsed
-	return nil
+	return unmarshalEnumJSON(data, i, ParseIncidentSeverity)
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (i IncidentSeverity) MarshalText() ([]byte, error) {
-	return []byte(i), nil
+	return marshalEnumText(i)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (i *IncidentSeverity) UnmarshalText(data []byte) error {
-	parsed, err := ParseIncidentSeverity(string(data))
-	if err != nil {
-		return err
-	}
-	*i = parsed
-	return nil
+	return unmarshalEnumText(data, i, ParseIncidentSeverity)
 }
 
 // Scan implements sql.Scanner.
 func (i *IncidentSeverity) Scan(src interface{}) error {
-	switch v := src.(type) {
-	case string:
-		parsed, err := ParseIncidentSeverity(v)
-		if err != nil {
-			return err
-		}
-		*i = parsed
-		return nil
-	case []byte:
-		parsed, err := ParseIncidentSeverity(string(v))
-		if err != nil {
-			return err
-		}
-		*i = parsed
-		return nil
-	case nil:
-		*i = ""
-		return nil
-	default:
-		return fmt.Errorf("cannot scan %T into IncidentSeverity", src)
-	}
+	return scanEnum(src, i, ParseIncidentSeverity)
 }
 
 // Value implements driver.Valuer.
 func (i IncidentSeverity) Value() (driver.Value, error) {
-	if i == "" {
-		return nil, nil
-	}
-	return string(i), nil
+	return enumValue(i)
 }
 
 // IncidentStatus represents the status of a safety incident.
@@ -132,6 +144,10 @@ const (
 // ErrInvalidIncidentStatus is returned when parsing an invalid incident status.
 var ErrInvalidIncidentStatus = errors.New("invalid incident status")
 
+// ErrInvalidIncidentTransition is returned when an incident status change is
+// not allowed by the incident workflow.
+var ErrInvalidIncidentTransition = errors.New("invalid incident status transition")
+
 // ParseIncidentStatus parses a string into an IncidentStatus.
 func ParseIncidentStatus(s string) (IncidentStatus, error) {
 	switch strings.ToLower(strings.TrimSpace(s)) {
@@ -144,7 +160,7 @@ func ParseIncidentStatus(s string) (IncidentStatus, error) {
 	case "dismissed":
 		return IncidentStatusDismissed, nil
 	default:
-		return "", ErrInvalidIncidentStatus
+		return "", parseError(ErrInvalidIncidentStatus, s)
 	}
 }
 
@@ -163,71 +179,84 @@ func (i IncidentStatus) Valid() bool {
 	}
 }
 
+// IncidentStatusValues returns all valid IncidentStatus values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func IncidentStatusValues() []IncidentStatus {
+	return []IncidentStatus{
+		IncidentStatusReported,
+		IncidentStatusInvestigating,
+		IncidentStatusResolved,
+		IncidentStatusDismissed,
+	}
+}
+
+// incidentStatusTransitions defines the allowed incident workflow transitions.
+// Closed incidents cannot be reopened.
+var incidentStatusTransitions = map[IncidentStatus][]IncidentStatus{
+	IncidentStatusReported:      {IncidentStatusInvestigating},
+	IncidentStatusInvestigating: {IncidentStatusResolved, IncidentStatusDismissed},
+	IncidentStatusResolved:      {},
+	IncidentStatusDismissed:     {},
+}
+
+// CanTransitionTo returns true if an incident may move from i to next.
+func (i IncidentStatus) CanTransitionTo(next IncidentStatus) bool {
+	for _, s := range incidentStatusTransitions[i] {
+		if s == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOpen returns true if the incident still requires attention
+// (reported or investigating).
+func (i IncidentStatus) IsOpen() bool {
+	return i == IncidentStatusReported || i == IncidentStatusInvestigating
+}
+
+// IsClosed returns true if the incident has been resolved or dismissed.
+func (i IncidentStatus) IsClosed() bool {
+	return i == IncidentStatusResolved || i == IncidentStatusDismissed
+}
+
+// ValidateIncidentTransition returns an error wrapping
+// ErrInvalidIncidentTransition if an incident may not move from one status to another.
+func ValidateIncidentTransition(from, to IncidentStatus) error {
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %q to %q", ErrInvalidIncidentTransition, from, to)
+	}
+	return nil
+}
+
 // MarshalJSON implements json.Marshaler.
 func (i IncidentStatus) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(i))
+	return marshalEnumJSON(i)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (i *IncidentStatus) UnmarshalJSON(data []byte) error {
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
-		return err
-	}
-	parsed, err := ParseIncidentStatus(s)
-	if err != nil {
-		return err
-	}
-	*i = parsed
-	return nil
+	return unmarshalEnumJSON(data, i, ParseIncidentStatus)
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (i IncidentStatus) MarshalText() ([]byte, error) {
-	return []byte(i), nil
+	return marshalEnumText(i)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (i *IncidentStatus) UnmarshalText(data []byte) error {
-	parsed, err := ParseIncidentStatus(string(data))
-	if err != nil {
-		return err
-	}
-	*i = parsed
-	return nil
+	return unmarshalEnumText(data, i, ParseIncidentStatus)
 }
 
 // Scan implements sql.Scanner.
 func (i *IncidentStatus) Scan(src interface{}) error {
-	switch v := src.(type) {
-	case string:
-		parsed, err := ParseIncidentStatus(v)
-		if err != nil {
-			return err
-		}
-		*i = parsed
-		return nil
-	case []byte:
-		parsed, err := ParseIncidentStatus(string(v))
-		if err != nil {
-			return err
-		}
-		*i = parsed
-		return nil
-	case nil:
-		*i = ""
-		return nil
-	default:
-		return fmt.Errorf("cannot scan %T into IncidentStatus", src)
-	}
+	return scanEnum(src, i, ParseIncidentStatus)
 }
 
 // Value implements driver.Valuer.
 func (i IncidentStatus) Value() (driver.Value, error) {
-	if i == "" {
-		return nil, nil
-	}
-	return string(i), nil
+	return enumValue(i)
 }
 
 // EmergencyType represents the type of emergency.
@@ -258,7 +287,7 @@ func ParseEmergencyType(s string) (EmergencyType, error) {
 	case "other":
 		return EmergencyTypeOther, nil
 	default:
-		return "", ErrInvalidEmergencyType
+		return "", parseError(ErrInvalidEmergencyType, s)
 	}
 }
 
@@ -278,69 +307,175 @@ func (e EmergencyType) Valid() bool {
 	}
 }
 
+// EmergencyTypeValues returns all valid EmergencyType values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func EmergencyTypeValues() []EmergencyType {
+	return []EmergencyType{
+		EmergencyTypeAccident,
+		EmergencyTypeHarassment,
+		EmergencyTypeTheft,
+		EmergencyTypeMedical,
+		EmergencyTypeOther,
+	}
+}
+
+// emergencyTriage holds the initial triage defaults for an EmergencyType.
+type emergencyTriage struct {
+	severity IncidentSeverity
+	dispatch bool
+}
+
+// emergencyTriageTable is the single source of truth for SOS triage
+// defaults. Every EmergencyType must have an entry.
+var emergencyTriageTable = map[EmergencyType]emergencyTriage{
+	EmergencyTypeAccident:   {severity: IncidentSeverityCritical, dispatch: true},
+	EmergencyTypeHarassment: {severity: IncidentSeverityHigh, dispatch: true},
+	EmergencyTypeTheft:      {severity: IncidentSeverityHigh, dispatch: false},
+	EmergencyTypeMedical:    {severity: IncidentSeverityCritical, dispatch: true},
+	EmergencyTypeOther:      {severity: IncidentSeverityMedium, dispatch: false},
+}
+
+// DefaultSeverity returns the initial IncidentSeverity assigned when an SOS
+// of this type is raised. Returns an empty IncidentSeverity for invalid values.
+func (e EmergencyType) DefaultSeverity() IncidentSeverity {
+	return emergencyTriageTable[e].severity
+}
+
+// RequiresImmediateDispatch returns true if an SOS of this type must be
+// escalated to responders without waiting for manual triage.
+func (e EmergencyType) RequiresImmediateDispatch() bool {
+	return emergencyTriageTable[e].dispatch
+}
+
 // MarshalJSON implements json.Marshaler.
 func (e EmergencyType) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(e))
+	return marshalEnumJSON(e)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (e *EmergencyType) UnmarshalJSON(data []byte) error {
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
-		return err
-	}
-	parsed, err := ParseEmergencyType(s)
-	if err != nil {
-		return err
-	}
-	*e = parsed
-	return nil
+	return unmarshalEnumJSON(data, e, ParseEmergencyType)
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (e EmergencyType) MarshalText() ([]byte, error) {
-	return []byte(e), nil
+	return marshalEnumText(e)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (e *EmergencyType) UnmarshalText(data []byte) error {
-	parsed, err := ParseEmergencyType(string(data))
-	if err != nil {
-		return err
-	}
-	*e = parsed
-	return nil
+	return unmarshalEnumText(data, e, ParseEmergencyType)
 }
 
 // Scan implements sql.Scanner.
 func (e *EmergencyType) Scan(src interface{}) error {
-	switch v := src.(type) {
-	case string:
-		parsed, err := ParseEmergencyType(v)
-		if err != nil {
-			return err
-		}
-		*e = parsed
-		return nil
-	case []byte:
-		parsed, err := ParseEmergencyType(string(v))
-		if err != nil {
-			return err
-		}
-		*e = parsed
-		return nil
-	case nil:
-		*e = ""
-		return nil
-	default:
-		return fmt.Errorf("cannot scan %T into EmergencyType", src)
-	}
+	return scanEnum(src, e, ParseEmergencyType)
 }
 
 // Value implements driver.Valuer.
 func (e EmergencyType) Value() (driver.Value, error) {
-	if e == "" {
-		return nil, nil
+	return enumValue(e)
+}
+
+// AccidentSeverity represents the severity grading of a traffic accident.
+type AccidentSeverity string
+
+const (
+	AccidentSeverityMinor    AccidentSeverity = "minor"
+	AccidentSeverityModerate AccidentSeverity = "moderate"
+	AccidentSeveritySevere   AccidentSeverity = "severe"
+	AccidentSeverityFatal    AccidentSeverity = "fatal"
+)
+
+// ErrInvalidAccidentSeverity is returned when parsing an invalid accident severity.
+var ErrInvalidAccidentSeverity = errors.New("invalid accident severity")
+
+// ParseAccidentSeverity parses a string into an AccidentSeverity.
+func ParseAccidentSeverity(s string) (AccidentSeverity, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "minor":
+		return AccidentSeverityMinor, nil
+	case "moderate":
+		return AccidentSeverityModerate, nil
+	case "severe":
+		return AccidentSeveritySevere, nil
+	case "fatal":
+		return AccidentSeverityFatal, nil
+	default:
+		return "", parseError(ErrInvalidAccidentSeverity, s)
+	}
+}
+
+// String returns the string representation.
+func (a AccidentSeverity) String() string {
+	return string(a)
+}
+
+// Valid returns true if the AccidentSeverity is valid.
+func (a AccidentSeverity) Valid() bool {
+	switch a {
+	case AccidentSeverityMinor, AccidentSeverityModerate, AccidentSeveritySevere,
+		AccidentSeverityFatal:
+		return true
+	default:
+		return false
+	}
+}
+
+// AccidentSeverityValues returns all valid AccidentSeverity values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func AccidentSeverityValues() []AccidentSeverity {
+	return []AccidentSeverity{
+		AccidentSeverityMinor,
+		AccidentSeverityModerate,
+		AccidentSeveritySevere,
+		AccidentSeverityFatal,
 	}
-	return string(e), nil
+}
+
+// RequiresHospital returns true if the accident is severe enough that
+// injured parties should be taken to hospital (severe and fatal).
+func (a AccidentSeverity) RequiresHospital() bool {
+	return a == AccidentSeveritySevere || a == AccidentSeverityFatal
+}
+
+// RequiresPoliceReport returns true if the accident must be reported to the
+// police (moderate and above).
+func (a AccidentSeverity) RequiresPoliceReport() bool {
+	switch a {
+	case AccidentSeverityModerate, AccidentSeveritySevere, AccidentSeverityFatal:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a AccidentSeverity) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(a)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *AccidentSeverity) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, a, ParseAccidentSeverity)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (a AccidentSeverity) MarshalText() ([]byte, error) {
+	return marshalEnumText(a)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (a *AccidentSeverity) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, a, ParseAccidentSeverity)
+}
+
+// Scan implements sql.Scanner.
+func (a *AccidentSeverity) Scan(src interface{}) error {
+	return scanEnum(src, a, ParseAccidentSeverity)
+}
+
+// Value implements driver.Valuer.
+func (a AccidentSeverity) Value() (driver.Value, error) {
+	return enumValue(a)
 }
@@ -0,0 +1,247 @@
+package enums
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WeekDay represents a day of the week, numbered Monday first as in ISO 8601.
+// Parsing accepts English and Portuguese names and abbreviations.
+type WeekDay string
+
+const (
+	WeekDayMonday    WeekDay = "monday"
+	WeekDayTuesday   WeekDay = "tuesday"
+	WeekDayWednesday WeekDay = "wednesday"
+	WeekDayThursday  WeekDay = "thursday"
+	WeekDayFriday    WeekDay = "friday"
+	WeekDaySaturday  WeekDay = "saturday"
+	WeekDaySunday    WeekDay = "sunday"
+)
+
+// ErrInvalidWeekDay is returned when parsing an invalid week day.
+var ErrInvalidWeekDay = errors.New("invalid week day")
+
+// ParseWeekDay parses a string into a WeekDay.
+func ParseWeekDay(s string) (WeekDay, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "monday", "mon", "segunda", "segunda-feira", "seg":
+		return WeekDayMonday, nil
+	case "tuesday", "tue", "tues", "terça", "terca", "terça-feira", "terca-feira", "ter":
+		return WeekDayTuesday, nil
+	case "wednesday", "wed", "quarta", "quarta-feira", "qua":
+		return WeekDayWednesday, nil
+	case "thursday", "thu", "thur", "thurs", "quinta", "quinta-feira", "qui":
+		return WeekDayThursday, nil
+	case "friday", "fri", "sexta", "sexta-feira", "sex":
+		return WeekDayFriday, nil
+	case "saturday", "sat", "sábado", "sabado", "sáb", "sab":
+		return WeekDaySaturday, nil
+	case "sunday", "sun", "domingo", "dom":
+		return WeekDaySunday, nil
+	default:
+		return "", parseError(ErrInvalidWeekDay, s)
+	}
+}
+
+// String returns the string representation.
+func (d WeekDay) String() string {
+	return string(d)
+}
+
+// Valid returns true if the WeekDay is valid.
+func (d WeekDay) Valid() bool {
+	switch d {
+	case WeekDayMonday, WeekDayTuesday, WeekDayWednesday, WeekDayThursday, WeekDayFriday,
+		WeekDaySaturday, WeekDaySunday:
+		return true
+	default:
+		return false
+	}
+}
+
+// WeekDayValues returns all valid WeekDay values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func WeekDayValues() []WeekDay {
+	return []WeekDay{
+		WeekDayMonday,
+		WeekDayTuesday,
+		WeekDayWednesday,
+		WeekDayThursday,
+		WeekDayFriday,
+		WeekDaySaturday,
+		WeekDaySunday,
+	}
+}
+
+// ISO returns the ISO 8601 day number, from 1 for Monday to 7 for Sunday.
+// Returns 0 for invalid days.
+func (d WeekDay) ISO() int {
+	for i, day := range WeekDayValues() {
+		if day == d {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// Weekday returns the equivalent time.Weekday.
+// Invalid days return time.Sunday; check Valid first.
+func (d WeekDay) Weekday() time.Weekday {
+	return time.Weekday(d.ISO() % 7)
+}
+
+// WeekDayOf returns the WeekDay for a time.Weekday.
+func WeekDayOf(w time.Weekday) WeekDay {
+	return WeekDayValues()[(int(w)+6)%7]
+}
+
+// IsWeekend returns true for Saturday and Sunday.
+func (d WeekDay) IsWeekend() bool {
+	return d == WeekDaySaturday || d == WeekDaySunday
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d WeekDay) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(d)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *WeekDay) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, d, ParseWeekDay)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d WeekDay) MarshalText() ([]byte, error) {
+	return marshalEnumText(d)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *WeekDay) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, d, ParseWeekDay)
+}
+
+// Scan implements sql.Scanner.
+func (d *WeekDay) Scan(src interface{}) error {
+	return scanEnum(src, d, ParseWeekDay)
+}
+
+// Value implements driver.Valuer.
+func (d WeekDay) Value() (driver.Value, error) {
+	return enumValue(d)
+}
+
+// MinutesPerDay is the number of minutes in a day, the exclusive upper bound
+// of TimeWindow offsets.
+const MinutesPerDay = 24 * 60
+
+// ErrInvalidTimeWindow is returned when a time window is malformed or out of range.
+var ErrInvalidTimeWindow = errors.New("invalid time window")
+
+// TimeWindow is a daily time range such as a surge window, stored as minutes
+// from midnight. Start is inclusive and End is exclusive. A window whose End
+// is before its Start spans midnight, e.g. 22:00-05:00.
+// The zero value is an unset window and never contains any time.
+type TimeWindow struct {
+	Start int
+	End   int
+}
+
+// NewTimeWindow creates a TimeWindow from minutes after midnight.
+// Both offsets must be in [0, MinutesPerDay) and must differ.
+func NewTimeWindow(start, end int) (TimeWindow, error) {
+	if start < 0 || start >= MinutesPerDay || end < 0 || end >= MinutesPerDay || start == end {
+		return TimeWindow{}, fmt.Errorf("%w: %d-%d", ErrInvalidTimeWindow, start, end)
+	}
+	return TimeWindow{Start: start, End: end}, nil
+}
+
+// ParseTimeWindow parses a window in "HH:MM-HH:MM" format.
+func ParseTimeWindow(s string) (TimeWindow, error) {
+	startStr, endStr, ok := strings.Cut(strings.TrimSpace(s), "-")
+	if !ok {
+		return TimeWindow{}, parseError(ErrInvalidTimeWindow, s)
+	}
+	start, err := parseClock(startStr)
+	if err != nil {
+		return TimeWindow{}, parseError(ErrInvalidTimeWindow, s)
+	}
+	end, err := parseClock(endStr)
+	if err != nil {
+		return TimeWindow{}, parseError(ErrInvalidTimeWindow, s)
+	}
+	return NewTimeWindow(start, end)
+}
+
+// parseClock parses "HH:MM" into minutes after midnight.
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// IsZero returns true if the window is the zero value (unset).
+func (w TimeWindow) IsZero() bool {
+	return w == TimeWindow{}
+}
+
+// IsOvernight returns true if the window spans midnight.
+func (w TimeWindow) IsOvernight() bool {
+	return w.End < w.Start
+}
+
+// Contains returns true if t, viewed in loc, falls within the window.
+// A nil loc uses t's own location.
+func (w TimeWindow) Contains(t time.Time, loc *time.Location) bool {
+	if w.IsZero() {
+		return false
+	}
+	if loc != nil {
+		t = t.In(loc)
+	}
+	minute := t.Hour()*60 + t.Minute()
+	if w.IsOvernight() {
+		return minute >= w.Start || minute < w.End
+	}
+	return minute >= w.Start && minute < w.End
+}
+
+// String returns the window in "HH:MM-HH:MM" format.
+func (w TimeWindow) String() string {
+	return fmt.Sprintf("%02d:%02d-%02d:%02d", w.Start/60, w.Start%60, w.End/60, w.End%60)
+}
+
+// MarshalJSON implements json.Marshaler. The zero value encodes as null.
+func (w TimeWindow) MarshalJSON() ([]byte, error) {
+	if w.IsZero() {
+		return []byte("null"), nil
+	}
+	if _, err := NewTimeWindow(w.Start, w.End); err != nil {
+		return nil, err
+	}
+	return json.Marshal(w.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null yields the zero value.
+func (w *TimeWindow) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*w = TimeWindow{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := ParseTimeWindow(s)
+	if err != nil {
+		return err
+	}
+	*w = parsed
+	return nil
+}
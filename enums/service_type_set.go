@@ -0,0 +1,187 @@
+package enums
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ServiceTypeSet is a set of service types, stored as a bitmask, such as the
+// services a driver is enabled for. The zero value is an empty set.
+// It marshals to JSON as a sorted array of service type strings and to SQL
+// as a sorted comma-separated string.
+type ServiceTypeSet uint8
+
+// serviceTypeBits assigns each ServiceType a fixed bit in ServiceTypeSet.
+var serviceTypeBits = map[ServiceType]ServiceTypeSet{
+	ServiceTypeStandard: 1 << 0,
+	ServiceTypeComfort:  1 << 1,
+	ServiceTypePremium:  1 << 2,
+	ServiceTypeMoto:     1 << 3,
+}
+
+// allServiceTypeBits is the union of every bit in serviceTypeBits.
+var allServiceTypeBits = func() ServiceTypeSet {
+	var all ServiceTypeSet
+	for _, bit := range serviceTypeBits {
+		all |= bit
+	}
+	return all
+}()
+
+// NewServiceTypeSet returns a set containing the given service types.
+// Duplicates are ignored. Returns ErrInvalidServiceType for invalid values.
+func NewServiceTypeSet(types []ServiceType) (ServiceTypeSet, error) {
+	var set ServiceTypeSet
+	for _, s := range types {
+		if err := set.Add(s); err != nil {
+			return 0, err
+		}
+	}
+	return set, nil
+}
+
+// Add inserts s into the set. Adding a member already present is a no-op.
+// Returns an error wrapping ErrInvalidServiceType for invalid values.
+func (set *ServiceTypeSet) Add(s ServiceType) error {
+	bit, ok := serviceTypeBits[s]
+	if !ok {
+		return parseError(ErrInvalidServiceType, string(s))
+	}
+	*set |= bit
+	return nil
+}
+
+// Remove deletes s from the set. Removing an absent member is a no-op.
+func (set *ServiceTypeSet) Remove(s ServiceType) {
+	*set &^= serviceTypeBits[s]
+}
+
+// Contains returns true if s is in the set.
+func (set ServiceTypeSet) Contains(s ServiceType) bool {
+	bit, ok := serviceTypeBits[s]
+	return ok && set&bit != 0
+}
+
+// Len returns the number of service types in the set.
+func (set ServiceTypeSet) Len() int {
+	n := 0
+	for _, bit := range serviceTypeBits {
+		if set&bit != 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// Intersects returns true if the sets have at least one service type in common.
+func (set ServiceTypeSet) Intersects(other ServiceTypeSet) bool {
+	return set&other != 0
+}
+
+// Types returns the members of the set sorted by their string value.
+// The returned slice may be modified freely.
+func (set ServiceTypeSet) Types() []ServiceType {
+	types := make([]ServiceType, 0, len(serviceTypeBits))
+	for s, bit := range serviceTypeBits {
+		if set&bit != 0 {
+			types = append(types, s)
+		}
+	}
+	slices.Sort(types)
+	return types
+}
+
+// String returns the canonical comma-separated form, e.g. "moto,standard".
+func (set ServiceTypeSet) String() string {
+	types := set.Types()
+	parts := make([]string, len(types))
+	for i, s := range types {
+		parts[i] = string(s)
+	}
+	return strings.Join(parts, ",")
+}
+
+// ParseServiceTypeSet parses a comma-separated list of service types.
+// Whitespace around entries is ignored and an empty string is an empty set.
+// Returns ErrInvalidServiceType if any entry is invalid.
+func ParseServiceTypeSet(s string) (ServiceTypeSet, error) {
+	var set ServiceTypeSet
+	if strings.TrimSpace(s) == "" {
+		return set, nil
+	}
+	for _, part := range strings.Split(s, ",") {
+		st, err := ParseServiceType(part)
+		if err != nil {
+			return 0, err
+		}
+		set |= serviceTypeBits[st]
+	}
+	return set, nil
+}
+
+// checkMarshal returns an error wrapping ErrInvalidEnumValue if the set has
+// bits that do not belong to any service type.
+func (set ServiceTypeSet) checkMarshal() error {
+	if unknown := set &^ allServiceTypeBits; unknown != 0 {
+		return fmt.Errorf("%w: ServiceTypeSet has unknown bits %#x", ErrInvalidEnumValue, uint8(unknown))
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// Returns ErrInvalidEnumValue if the set has bits outside any service type.
+func (set ServiceTypeSet) MarshalJSON() ([]byte, error) {
+	if err := set.checkMarshal(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(set.Types())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (set *ServiceTypeSet) UnmarshalJSON(data []byte) error {
+	var types []ServiceType
+	if err := json.Unmarshal(data, &types); err != nil {
+		return err
+	}
+	parsed, err := NewServiceTypeSet(types)
+	if err != nil {
+		return err
+	}
+	*set = parsed
+	return nil
+}
+
+// Scan implements sql.Scanner.
+func (set *ServiceTypeSet) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case nil:
+		*set = 0
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into ServiceTypeSet", src)
+	}
+	parsed, err := ParseServiceTypeSet(s)
+	if err != nil {
+		return err
+	}
+	*set = parsed
+	return nil
+}
+
+// Value implements driver.Valuer.
+// The empty set is stored as an empty string. Returns ErrInvalidEnumValue if
+// the set has bits outside any service type.
+func (set ServiceTypeSet) Value() (driver.Value, error) {
+	if err := set.checkMarshal(); err != nil {
+		return nil, err
+	}
+	return set.String(), nil
+}
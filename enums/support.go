@@ -0,0 +1,251 @@
+package enums
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// TicketStatus represents the lifecycle status of a support ticket.
+type TicketStatus string
+
+const (
+	TicketStatusOpen            TicketStatus = "open"
+	TicketStatusPendingCustomer TicketStatus = "pending_customer"
+	TicketStatusPendingInternal TicketStatus = "pending_internal"
+	TicketStatusResolved        TicketStatus = "resolved"
+	TicketStatusClosed          TicketStatus = "closed"
+)
+
+// ErrInvalidTicketStatus is returned when parsing an invalid ticket status.
+var ErrInvalidTicketStatus = errors.New("invalid ticket status")
+
+// ErrInvalidTicketTransition is returned when a support ticket status change
+// is not allowed.
+var ErrInvalidTicketTransition = errors.New("invalid ticket status transition")
+
+// ParseTicketStatus parses a string into a TicketStatus.
+func ParseTicketStatus(s string) (TicketStatus, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "open":
+		return TicketStatusOpen, nil
+	case "pending_customer":
+		return TicketStatusPendingCustomer, nil
+	case "pending_internal":
+		return TicketStatusPendingInternal, nil
+	case "resolved":
+		return TicketStatusResolved, nil
+	case "closed":
+		return TicketStatusClosed, nil
+	default:
+		return "", parseError(ErrInvalidTicketStatus, s)
+	}
+}
+
+// String returns the string representation.
+func (t TicketStatus) String() string {
+	return string(t)
+}
+
+// Valid returns true if the TicketStatus is valid.
+func (t TicketStatus) Valid() bool {
+	switch t {
+	case TicketStatusOpen, TicketStatusPendingCustomer, TicketStatusPendingInternal,
+		TicketStatusResolved, TicketStatusClosed:
+		return true
+	default:
+		return false
+	}
+}
+
+// TicketStatusValues returns all valid TicketStatus values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func TicketStatusValues() []TicketStatus {
+	return []TicketStatus{
+		TicketStatusOpen,
+		TicketStatusPendingCustomer,
+		TicketStatusPendingInternal,
+		TicketStatusResolved,
+		TicketStatusClosed,
+	}
+}
+
+// ticketStatusTransitions defines the allowed support ticket transitions.
+// Resolved tickets may be reopened; closed tickets are final.
+var ticketStatusTransitions = map[TicketStatus][]TicketStatus{
+	TicketStatusOpen: {
+		TicketStatusPendingCustomer, TicketStatusPendingInternal, TicketStatusResolved, TicketStatusClosed,
+	},
+	TicketStatusPendingCustomer: {
+		TicketStatusOpen, TicketStatusPendingInternal, TicketStatusResolved, TicketStatusClosed,
+	},
+	TicketStatusPendingInternal: {
+		TicketStatusOpen, TicketStatusPendingCustomer, TicketStatusResolved, TicketStatusClosed,
+	},
+	TicketStatusResolved: {TicketStatusOpen, TicketStatusClosed},
+	TicketStatusClosed:   {},
+}
+
+// CanTransitionTo returns true if a ticket may move from t to next.
+func (t TicketStatus) CanTransitionTo(next TicketStatus) bool {
+	for _, s := range ticketStatusTransitions[t] {
+		if s == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTicketTransition returns an error wrapping
+// ErrInvalidTicketTransition if a ticket may not move from one status to
+// another.
+func ValidateTicketTransition(from, to TicketStatus) error {
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %q to %q", ErrInvalidTicketTransition, from, to)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t TicketStatus) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(t)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *TicketStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, t, ParseTicketStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t TicketStatus) MarshalText() ([]byte, error) {
+	return marshalEnumText(t)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *TicketStatus) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, t, ParseTicketStatus)
+}
+
+// Scan implements sql.Scanner.
+func (t *TicketStatus) Scan(src interface{}) error {
+	return scanEnum(src, t, ParseTicketStatus)
+}
+
+// Value implements driver.Valuer.
+func (t TicketStatus) Value() (driver.Value, error) {
+	return enumValue(t)
+}
+
+// TicketPriority represents the urgency of a support ticket.
+type TicketPriority string
+
+const (
+	TicketPriorityLow    TicketPriority = "low"
+	TicketPriorityNormal TicketPriority = "normal"
+	TicketPriorityHigh   TicketPriority = "high"
+	TicketPriorityUrgent TicketPriority = "urgent"
+)
+
+// ErrInvalidTicketPriority is returned when parsing an invalid ticket priority.
+var ErrInvalidTicketPriority = errors.New("invalid ticket priority")
+
+// ParseTicketPriority parses a string into a TicketPriority.
+func ParseTicketPriority(s string) (TicketPriority, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "low":
+		return TicketPriorityLow, nil
+	case "normal":
+		return TicketPriorityNormal, nil
+	case "high":
+		return TicketPriorityHigh, nil
+	case "urgent":
+		return TicketPriorityUrgent, nil
+	default:
+		return "", parseError(ErrInvalidTicketPriority, s)
+	}
+}
+
+// String returns the string representation.
+func (p TicketPriority) String() string {
+	return string(p)
+}
+
+// Valid returns true if the TicketPriority is valid.
+func (p TicketPriority) Valid() bool {
+	switch p {
+	case TicketPriorityLow, TicketPriorityNormal, TicketPriorityHigh, TicketPriorityUrgent:
+		return true
+	default:
+		return false
+	}
+}
+
+// TicketPriorityValues returns all valid TicketPriority values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func TicketPriorityValues() []TicketPriority {
+	return []TicketPriority{
+		TicketPriorityLow,
+		TicketPriorityNormal,
+		TicketPriorityHigh,
+		TicketPriorityUrgent,
+	}
+}
+
+// Level returns the numeric priority, from 1 (low) to 4 (urgent).
+// Invalid priorities have Level 0, so they order below every valid priority.
+func (p TicketPriority) Level() int {
+	switch p {
+	case TicketPriorityLow:
+		return 1
+	case TicketPriorityNormal:
+		return 2
+	case TicketPriorityHigh:
+		return 3
+	case TicketPriorityUrgent:
+		return 4
+	default:
+		return 0
+	}
+}
+
+// Less returns true if p is a lower priority than other, comparing by Level.
+func (p TicketPriority) Less(other TicketPriority) bool {
+	return p.Level() < other.Level()
+}
+
+// AtLeast returns true if p is the same or a higher priority than other,
+// comparing by Level.
+func (p TicketPriority) AtLeast(other TicketPriority) bool {
+	return p.Level() >= other.Level()
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p TicketPriority) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(p)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *TicketPriority) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, p, ParseTicketPriority)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p TicketPriority) MarshalText() ([]byte, error) {
+	return marshalEnumText(p)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *TicketPriority) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, p, ParseTicketPriority)
+}
+
+// Scan implements sql.Scanner.
+func (p *TicketPriority) Scan(src interface{}) error {
+	return scanEnum(src, p, ParseTicketPriority)
+}
+
+// Value implements driver.Valuer.
+func (p TicketPriority) Value() (driver.Value, error) {
+	return enumValue(p)
+}
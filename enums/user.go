@@ -3,9 +3,7 @@ package enums
 
 import (
 	"database/sql/driver"
-	"encoding/json"
 	"errors"
-	"fmt"
 	"strings"
 )
 
@@ -22,6 +20,10 @@ const (
 // ErrInvalidUserType is returned when parsing an invalid user type.
 var ErrInvalidUserType = errors.New("invalid user type")
 
+// ErrNoUserCapabilities is returned when constructing a UserType with neither
+// riding nor driving capability.
+var ErrNoUserCapabilities = errors.New("user type requires at least one capability")
+
 // ParseUserType parses a string into a UserType.
 func ParseUserType(s string) (UserType, error) {
 	switch strings.ToLower(strings.TrimSpace(s)) {
@@ -34,7 +36,7 @@ func ParseUserType(s string) (UserType, error) {
 	case "admin":
 		return UserTypeAdmin, nil
 	default:
-		return "", ErrInvalidUserType
+		return "", parseError(ErrInvalidUserType, s)
 	}
 }
 
@@ -53,71 +55,77 @@ func (u UserType) Valid() bool {
 	}
 }
 
+// UserTypeValues returns all valid UserType values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func UserTypeValues() []UserType {
+	return []UserType{
+		UserTypeRider,
+		UserTypeDriver,
+		UserTypeBoth,
+		UserTypeAdmin,
+	}
+}
+
+// CanRide returns true if the user can request rides (rider or both).
+func (u UserType) CanRide() bool {
+	return u == UserTypeRider || u == UserTypeBoth
+}
+
+// CanDrive returns true if the user can accept rides (driver or both).
+func (u UserType) CanDrive() bool {
+	return u == UserTypeDriver || u == UserTypeBoth
+}
+
+// IsAdmin returns true if the user is a platform administrator.
+// Administrators can neither ride nor drive through their admin account.
+func (u UserType) IsAdmin() bool {
+	return u == UserTypeAdmin
+}
+
+// UserTypeFromCapabilities returns the UserType with the given riding and
+// driving capabilities. Returns ErrNoUserCapabilities if both are false;
+// UserTypeAdmin cannot be constructed this way.
+func UserTypeFromCapabilities(canRide, canDrive bool) (UserType, error) {
+	switch {
+	case canRide && canDrive:
+		return UserTypeBoth, nil
+	case canRide:
+		return UserTypeRider, nil
+	case canDrive:
+		return UserTypeDriver, nil
+	default:
+		return "", ErrNoUserCapabilities
+	}
+}
+
 // MarshalJSON implements json.Marshaler.
 func (u UserType) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(u))
+	return marshalEnumJSON(u)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (u *UserType) UnmarshalJSON(data []byte) error {
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
-		return err
-	}
-	parsed, err := ParseUserType(s)
-	if err != nil {
-		return err
-	}
-	*u = parsed
-	return nil
+	return unmarshalEnumJSON(data, u, ParseUserType)
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (u UserType) MarshalText() ([]byte, error) {
-	return []byte(u), nil
+	return marshalEnumText(u)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (u *UserType) UnmarshalText(data []byte) error {
-	parsed, err := ParseUserType(string(data))
-	if err != nil {
-		return err
-	}
-	*u = parsed
-	return nil
+	return unmarshalEnumText(data, u, ParseUserType)
 }
 
 // Scan implements sql.Scanner.
 func (u *UserType) Scan(src interface{}) error {
-	switch v := src.(type) {
-	case string:
-		parsed, err := ParseUserType(v)
-		if err != nil {
-			return err
-		}
-		*u = parsed
-		return nil
-	case []byte:
-		parsed, err := ParseUserType(string(v))
-		if err != nil {
-			return err
-		}
-		*u = parsed
-		return nil
-	case nil:
-		*u = ""
-		return nil
-	default:
-		return fmt.Errorf("cannot scan %T into UserType", src)
-	}
+	return scanEnum(src, u, ParseUserType)
 }
 
 // Value implements driver.Valuer.
 func (u UserType) Value() (driver.Value, error) {
-	if u == "" {
-		return nil, nil
-	}
-	return string(u), nil
+	return enumValue(u)
 }
 
 // UserStatus represents the status of a user account.
@@ -145,7 +153,7 @@ func ParseUserStatus(s string) (UserStatus, error) {
 	case "deleted":
 		return UserStatusDeleted, nil
 	default:
-		return "", ErrInvalidUserStatus
+		return "", parseError(ErrInvalidUserStatus, s)
 	}
 }
 
@@ -164,69 +172,774 @@ func (u UserStatus) Valid() bool {
 	}
 }
 
+// UserStatusValues returns all valid UserStatus values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func UserStatusValues() []UserStatus {
+	return []UserStatus{
+		UserStatusPending,
+		UserStatusActive,
+		UserStatusSuspended,
+		UserStatusDeleted,
+	}
+}
+
 // MarshalJSON implements json.Marshaler.
 func (u UserStatus) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(u))
+	return marshalEnumJSON(u)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (u *UserStatus) UnmarshalJSON(data []byte) error {
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
-		return err
-	}
-	parsed, err := ParseUserStatus(s)
-	if err != nil {
-		return err
-	}
-	*u = parsed
-	return nil
+	return unmarshalEnumJSON(data, u, ParseUserStatus)
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (u UserStatus) MarshalText() ([]byte, error) {
-	return []byte(u), nil
+	return marshalEnumText(u)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (u *UserStatus) UnmarshalText(data []byte) error {
-	parsed, err := ParseUserStatus(string(data))
-	if err != nil {
-		return err
-	}
-	*u = parsed
-	return nil
+	return unmarshalEnumText(data, u, ParseUserStatus)
 }
 
 // Scan implements sql.Scanner.
 func (u *UserStatus) Scan(src interface{}) error {
-	switch v := src.(type) {
-	case string:
-		parsed, err := ParseUserStatus(v)
-		if err != nil {
-			return err
-		}
-		*u = parsed
-		return nil
-	case []byte:
-		parsed, err := ParseUserStatus(string(v))
-		if err != nil {
-			return err
+	return scanEnum(src, u, ParseUserStatus)
+}
+
+// Value implements driver.Valuer.
+func (u UserStatus) Value() (driver.Value, error) {
+	return enumValue(u)
+}
+
+// Language represents a supported user interface language,
+// identified by its ISO 639-1 code.
+type Language string
+
+const (
+	LanguagePortuguese Language = "pt"
+	LanguageEnglish    Language = "en"
+	LanguageTsonga     Language = "ts"
+)
+
+// DefaultLanguage is the language used when a user has no preference.
+const DefaultLanguage = LanguagePortuguese
+
+// ErrInvalidLanguage is returned when parsing an invalid language.
+var ErrInvalidLanguage = errors.New("invalid language")
+
+// ParseLanguage parses a string into a Language.
+// BCP-47 tags are accepted case-insensitively and reduced to their primary
+// language subtag, so "pt-MZ", "PT_mz" and "pt" all parse to LanguagePortuguese.
+func ParseLanguage(s string) (Language, error) {
+	tag := strings.ToLower(strings.TrimSpace(s))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	switch tag {
+	case "pt":
+		return LanguagePortuguese, nil
+	case "en":
+		return LanguageEnglish, nil
+	case "ts":
+		return LanguageTsonga, nil
+	default:
+		return "", parseError(ErrInvalidLanguage, s)
+	}
+}
+
+// String returns the string representation.
+func (l Language) String() string {
+	return string(l)
+}
+
+// Valid returns true if the Language is valid.
+func (l Language) Valid() bool {
+	switch l {
+	case LanguagePortuguese, LanguageEnglish, LanguageTsonga:
+		return true
+	default:
+		return false
+	}
+}
+
+// LanguageValues returns all valid Language values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func LanguageValues() []Language {
+	return []Language{
+		LanguagePortuguese,
+		LanguageEnglish,
+		LanguageTsonga,
+	}
+}
+
+// Tag returns the canonical BCP-47 tag used for localization.
+// Portuguese and Tsonga (Changana) use their Mozambican regional variants.
+// Returns an empty string for invalid values.
+func (l Language) Tag() string {
+	switch l {
+	case LanguagePortuguese:
+		return "pt-MZ"
+	case LanguageEnglish:
+		return "en"
+	case LanguageTsonga:
+		return "ts-MZ"
+	default:
+		return ""
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (l Language) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(l)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (l *Language) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, l, ParseLanguage)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (l Language) MarshalText() ([]byte, error) {
+	return marshalEnumText(l)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (l *Language) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, l, ParseLanguage)
+}
+
+// Scan implements sql.Scanner.
+func (l *Language) Scan(src interface{}) error {
+	return scanEnum(src, l, ParseLanguage)
+}
+
+// Value implements driver.Valuer.
+func (l Language) Value() (driver.Value, error) {
+	return enumValue(l)
+}
+
+// AuthProvider represents the method a user authenticated with.
+type AuthProvider string
+
+const (
+	AuthProviderEmail    AuthProvider = "email"
+	AuthProviderGoogle   AuthProvider = "google"
+	AuthProviderFacebook AuthProvider = "facebook"
+	AuthProviderApple    AuthProvider = "apple"
+	AuthProviderPhone    AuthProvider = "phone"
+)
+
+// ErrInvalidAuthProvider is returned when parsing an invalid auth provider.
+var ErrInvalidAuthProvider = errors.New("invalid auth provider")
+
+// ParseAuthProvider parses a string into a AuthProvider.
+func ParseAuthProvider(s string) (AuthProvider, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "email":
+		return AuthProviderEmail, nil
+	case "google":
+		return AuthProviderGoogle, nil
+	case "facebook":
+		return AuthProviderFacebook, nil
+	case "apple":
+		return AuthProviderApple, nil
+	case "phone":
+		return AuthProviderPhone, nil
+	default:
+		return "", parseError(ErrInvalidAuthProvider, s)
+	}
+}
+
+// String returns the string representation.
+func (a AuthProvider) String() string {
+	return string(a)
+}
+
+// Valid returns true if the AuthProvider is valid.
+func (a AuthProvider) Valid() bool {
+	switch a {
+	case AuthProviderEmail, AuthProviderGoogle, AuthProviderFacebook, AuthProviderApple, AuthProviderPhone:
+		return true
+	default:
+		return false
+	}
+}
+
+// AuthProviderValues returns all valid AuthProvider values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func AuthProviderValues() []AuthProvider {
+	return []AuthProvider{
+		AuthProviderEmail,
+		AuthProviderGoogle,
+		AuthProviderFacebook,
+		AuthProviderApple,
+		AuthProviderPhone,
+	}
+}
+
+// IsOAuth returns true if the provider authenticates through a third-party
+// OAuth flow (Google, Facebook or Apple).
+func (a AuthProvider) IsOAuth() bool {
+	switch a {
+	case AuthProviderGoogle, AuthProviderFacebook, AuthProviderApple:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a AuthProvider) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(a)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *AuthProvider) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, a, ParseAuthProvider)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (a AuthProvider) MarshalText() ([]byte, error) {
+	return marshalEnumText(a)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (a *AuthProvider) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, a, ParseAuthProvider)
+}
+
+// Scan implements sql.Scanner.
+func (a *AuthProvider) Scan(src interface{}) error {
+	return scanEnum(src, a, ParseAuthProvider)
+}
+
+// Value implements driver.Valuer.
+func (a AuthProvider) Value() (driver.Value, error) {
+	return enumValue(a)
+}
+
+// KYCStatus represents the identity verification (know your customer) level of a rider.
+type KYCStatus string
+
+const (
+	KYCStatusUnverified KYCStatus = "unverified"
+	KYCStatusBasic      KYCStatus = "basic"
+	KYCStatusFull       KYCStatus = "full"
+	KYCStatusRejected   KYCStatus = "rejected"
+)
+
+// ErrInvalidKYCStatus is returned when parsing an invalid KYC status.
+var ErrInvalidKYCStatus = errors.New("invalid KYC status")
+
+// ParseKYCStatus parses a string into a KYCStatus.
+func ParseKYCStatus(s string) (KYCStatus, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "unverified":
+		return KYCStatusUnverified, nil
+	case "basic":
+		return KYCStatusBasic, nil
+	case "full":
+		return KYCStatusFull, nil
+	case "rejected":
+		return KYCStatusRejected, nil
+	default:
+		return "", parseError(ErrInvalidKYCStatus, s)
+	}
+}
+
+// String returns the string representation.
+func (k KYCStatus) String() string {
+	return string(k)
+}
+
+// Valid returns true if the KYCStatus is valid.
+func (k KYCStatus) Valid() bool {
+	switch k {
+	case KYCStatusUnverified, KYCStatusBasic, KYCStatusFull, KYCStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// KYCStatusValues returns all valid KYCStatus values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func KYCStatusValues() []KYCStatus {
+	return []KYCStatus{
+		KYCStatusUnverified,
+		KYCStatusBasic,
+		KYCStatusFull,
+		KYCStatusRejected,
+	}
+}
+
+// Level returns the verification level, from 1 (unverified) to 3 (full).
+// Rejected and invalid statuses have Level 0, so they order below every
+// other status.
+func (k KYCStatus) Level() int {
+	switch k {
+	case KYCStatusUnverified:
+		return 1
+	case KYCStatusBasic:
+		return 2
+	case KYCStatusFull:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Less returns true if k is a lower verification level than other.
+func (k KYCStatus) Less(other KYCStatus) bool {
+	return k.Level() < other.Level()
+}
+
+// AtLeast returns true if k is the same or a higher verification level than
+// other, e.g. status.AtLeast(KYCStatusBasic).
+func (k KYCStatus) AtLeast(other KYCStatus) bool {
+	return k.Level() >= other.Level()
+}
+
+// MarshalJSON implements json.Marshaler.
+func (k KYCStatus) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(k)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (k *KYCStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, k, ParseKYCStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (k KYCStatus) MarshalText() ([]byte, error) {
+	return marshalEnumText(k)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (k *KYCStatus) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, k, ParseKYCStatus)
+}
+
+// Scan implements sql.Scanner.
+func (k *KYCStatus) Scan(src interface{}) error {
+	return scanEnum(src, k, ParseKYCStatus)
+}
+
+// Value implements driver.Valuer.
+func (k KYCStatus) Value() (driver.Value, error) {
+	return enumValue(k)
+}
+
+// AdminRole represents a back-office staff role.
+type AdminRole string
+
+const (
+	AdminRoleSupportAgent AdminRole = "support_agent"
+	AdminRoleSupervisor   AdminRole = "supervisor"
+	AdminRoleFinance      AdminRole = "finance"
+	AdminRoleSafety       AdminRole = "safety"
+	AdminRoleSuperadmin   AdminRole = "superadmin"
+)
+
+// ErrInvalidAdminRole is returned when parsing an invalid admin role.
+var ErrInvalidAdminRole = errors.New("invalid admin role")
+
+// ParseAdminRole parses a string into a AdminRole.
+func ParseAdminRole(s string) (AdminRole, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "support_agent", "support":
+		return AdminRoleSupportAgent, nil
+	case "supervisor":
+		return AdminRoleSupervisor, nil
+	case "finance":
+		return AdminRoleFinance, nil
+	case "safety":
+		return AdminRoleSafety, nil
+	case "superadmin", "super_admin":
+		return AdminRoleSuperadmin, nil
+	default:
+		return "", parseError(ErrInvalidAdminRole, s)
+	}
+}
+
+// String returns the string representation.
+func (r AdminRole) String() string {
+	return string(r)
+}
+
+// Valid returns true if the AdminRole is valid.
+func (r AdminRole) Valid() bool {
+	switch r {
+	case AdminRoleSupportAgent, AdminRoleSupervisor, AdminRoleFinance, AdminRoleSafety, AdminRoleSuperadmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// AdminRoleValues returns all valid AdminRole values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func AdminRoleValues() []AdminRole {
+	return []AdminRole{
+		AdminRoleSupportAgent,
+		AdminRoleSupervisor,
+		AdminRoleFinance,
+		AdminRoleSafety,
+		AdminRoleSuperadmin,
+	}
+}
+
+// adminRoleGrants lists the permissions granted to each back-office role,
+// in Permission declaration order.
+var adminRoleGrants = map[AdminRole][]Permission{
+	AdminRoleSupportAgent: {PermissionViewRides},
+	AdminRoleSupervisor:   {PermissionViewRides, PermissionRefundPayment, PermissionSuspendDriver},
+	AdminRoleFinance:      {PermissionViewRides, PermissionRefundPayment},
+	AdminRoleSafety:       {PermissionViewRides, PermissionSuspendDriver, PermissionResolveIncident},
+	AdminRoleSuperadmin: {
+		PermissionViewRides,
+		PermissionRefundPayment,
+		PermissionSuspendDriver,
+		PermissionResolveIncident,
+		PermissionManagePromotions,
+	},
+}
+
+// Has returns true if the role is granted the given permission.
+// Invalid roles have no permissions.
+func (r AdminRole) Has(p Permission) bool {
+	for _, granted := range adminRoleGrants[r] {
+		if granted == p {
+			return true
 		}
-		*u = parsed
-		return nil
-	case nil:
-		*u = ""
-		return nil
+	}
+	return false
+}
+
+// Permissions returns the permissions granted to the role in declaration
+// order. The returned slice is a new copy on every call and may be modified
+// freely. Invalid roles return an empty slice.
+func (r AdminRole) Permissions() []Permission {
+	grants := adminRoleGrants[r]
+	result := make([]Permission, len(grants))
+	copy(result, grants)
+	return result
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r AdminRole) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(r)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *AdminRole) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, r, ParseAdminRole)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (r AdminRole) MarshalText() ([]byte, error) {
+	return marshalEnumText(r)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (r *AdminRole) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, r, ParseAdminRole)
+}
+
+// Scan implements sql.Scanner.
+func (r *AdminRole) Scan(src interface{}) error {
+	return scanEnum(src, r, ParseAdminRole)
+}
+
+// Value implements driver.Valuer.
+func (r AdminRole) Value() (driver.Value, error) {
+	return enumValue(r)
+}
+
+// Permission represents a back-office action that can be granted to an AdminRole.
+type Permission string
+
+const (
+	PermissionViewRides        Permission = "view_rides"
+	PermissionRefundPayment    Permission = "refund_payment"
+	PermissionSuspendDriver    Permission = "suspend_driver"
+	PermissionResolveIncident  Permission = "resolve_incident"
+	PermissionManagePromotions Permission = "manage_promotions"
+)
+
+// ErrInvalidPermission is returned when parsing an invalid permission.
+var ErrInvalidPermission = errors.New("invalid permission")
+
+// ParsePermission parses a string into a Permission.
+func ParsePermission(s string) (Permission, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "view_rides":
+		return PermissionViewRides, nil
+	case "refund_payment":
+		return PermissionRefundPayment, nil
+	case "suspend_driver":
+		return PermissionSuspendDriver, nil
+	case "resolve_incident":
+		return PermissionResolveIncident, nil
+	case "manage_promotions":
+		return PermissionManagePromotions, nil
 	default:
-		return fmt.Errorf("cannot scan %T into UserStatus", src)
+		return "", parseError(ErrInvalidPermission, s)
+	}
+}
+
+// String returns the string representation.
+func (p Permission) String() string {
+	return string(p)
+}
+
+// Valid returns true if the Permission is valid.
+func (p Permission) Valid() bool {
+	switch p {
+	case PermissionViewRides, PermissionRefundPayment, PermissionSuspendDriver,
+		PermissionResolveIncident, PermissionManagePromotions:
+		return true
+	default:
+		return false
 	}
 }
 
+// PermissionValues returns all valid Permission values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func PermissionValues() []Permission {
+	return []Permission{
+		PermissionViewRides,
+		PermissionRefundPayment,
+		PermissionSuspendDriver,
+		PermissionResolveIncident,
+		PermissionManagePromotions,
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p Permission) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(p)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *Permission) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, p, ParsePermission)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p Permission) MarshalText() ([]byte, error) {
+	return marshalEnumText(p)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *Permission) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, p, ParsePermission)
+}
+
+// Scan implements sql.Scanner.
+func (p *Permission) Scan(src interface{}) error {
+	return scanEnum(src, p, ParsePermission)
+}
+
 // Value implements driver.Valuer.
-func (u UserStatus) Value() (driver.Value, error) {
-	if u == "" {
-		return nil, nil
+func (p Permission) Value() (driver.Value, error) {
+	return enumValue(p)
+}
+
+// PhoneVerificationStatus represents the verification state of a user phone number.
+type PhoneVerificationStatus string
+
+const (
+	PhoneVerificationStatusUnverified PhoneVerificationStatus = "unverified"
+	PhoneVerificationStatusPending    PhoneVerificationStatus = "pending"
+	PhoneVerificationStatusVerified   PhoneVerificationStatus = "verified"
+	PhoneVerificationStatusFailed     PhoneVerificationStatus = "failed"
+	PhoneVerificationStatusBlocked    PhoneVerificationStatus = "blocked"
+)
+
+// ErrInvalidPhoneVerificationStatus is returned when parsing an invalid phone verification status.
+var ErrInvalidPhoneVerificationStatus = errors.New("invalid phone verification status")
+
+// ParsePhoneVerificationStatus parses a string into a PhoneVerificationStatus.
+func ParsePhoneVerificationStatus(s string) (PhoneVerificationStatus, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "unverified":
+		return PhoneVerificationStatusUnverified, nil
+	case "pending":
+		return PhoneVerificationStatusPending, nil
+	case "verified":
+		return PhoneVerificationStatusVerified, nil
+	case "failed":
+		return PhoneVerificationStatusFailed, nil
+	case "blocked":
+		return PhoneVerificationStatusBlocked, nil
+	default:
+		return "", parseError(ErrInvalidPhoneVerificationStatus, s)
+	}
+}
+
+// String returns the string representation.
+func (p PhoneVerificationStatus) String() string {
+	return string(p)
+}
+
+// Valid returns true if the PhoneVerificationStatus is valid.
+func (p PhoneVerificationStatus) Valid() bool {
+	switch p {
+	case PhoneVerificationStatusUnverified, PhoneVerificationStatusPending,
+		PhoneVerificationStatusVerified, PhoneVerificationStatusFailed, PhoneVerificationStatusBlocked:
+		return true
+	default:
+		return false
+	}
+}
+
+// PhoneVerificationStatusValues returns all valid PhoneVerificationStatus values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func PhoneVerificationStatusValues() []PhoneVerificationStatus {
+	return []PhoneVerificationStatus{
+		PhoneVerificationStatusUnverified,
+		PhoneVerificationStatusPending,
+		PhoneVerificationStatusVerified,
+		PhoneVerificationStatusFailed,
+		PhoneVerificationStatusBlocked,
+	}
+}
+
+// IsUsable returns true if the phone number may be used for login and
+// notifications (verified only).
+func (p PhoneVerificationStatus) IsUsable() bool {
+	return p == PhoneVerificationStatusVerified
+}
+
+// CanRetry returns true if a new verification code may be requested
+// (unverified or failed). Pending verifications must expire first and
+// blocked numbers cannot be retried.
+func (p PhoneVerificationStatus) CanRetry() bool {
+	return p == PhoneVerificationStatusUnverified || p == PhoneVerificationStatusFailed
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p PhoneVerificationStatus) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(p)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PhoneVerificationStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, p, ParsePhoneVerificationStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p PhoneVerificationStatus) MarshalText() ([]byte, error) {
+	return marshalEnumText(p)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *PhoneVerificationStatus) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, p, ParsePhoneVerificationStatus)
+}
+
+// Scan implements sql.Scanner.
+func (p *PhoneVerificationStatus) Scan(src interface{}) error {
+	return scanEnum(src, p, ParsePhoneVerificationStatus)
+}
+
+// Value implements driver.Valuer.
+func (p PhoneVerificationStatus) Value() (driver.Value, error) {
+	return enumValue(p)
+}
+
+// AccountType represents whether an account is personal or corporate.
+type AccountType string
+
+const (
+	AccountTypeIndividual    AccountType = "individual"
+	AccountTypeBusiness      AccountType = "business"
+	AccountTypeFleetOperator AccountType = "fleet_operator"
+)
+
+// ErrInvalidAccountType is returned when parsing an invalid account type.
+var ErrInvalidAccountType = errors.New("invalid account type")
+
+// ParseAccountType parses a string into a AccountType.
+func ParseAccountType(s string) (AccountType, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "individual":
+		return AccountTypeIndividual, nil
+	case "business":
+		return AccountTypeBusiness, nil
+	case "fleet_operator":
+		return AccountTypeFleetOperator, nil
+	default:
+		return "", parseError(ErrInvalidAccountType, s)
+	}
+}
+
+// String returns the string representation.
+func (a AccountType) String() string {
+	return string(a)
+}
+
+// Valid returns true if the AccountType is valid.
+func (a AccountType) Valid() bool {
+	switch a {
+	case AccountTypeIndividual, AccountTypeBusiness, AccountTypeFleetOperator:
+		return true
+	default:
+		return false
 	}
-	return string(u), nil
+}
+
+// AccountTypeValues returns all valid AccountType values in declaration order.
+// The returned slice is a new copy on every call and may be modified freely.
+func AccountTypeValues() []AccountType {
+	return []AccountType{
+		AccountTypeIndividual,
+		AccountTypeBusiness,
+		AccountTypeFleetOperator,
+	}
+}
+
+// IsCorporate returns true for accounts held by a company (business and
+// fleet_operator).
+func (a AccountType) IsCorporate() bool {
+	return a == AccountTypeBusiness || a == AccountTypeFleetOperator
+}
+
+// RequiresTaxID returns true if the account must register a tax
+// identification number (NUIT) for invoicing (business only).
+func (a AccountType) RequiresTaxID() bool {
+	return a == AccountTypeBusiness
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a AccountType) MarshalJSON() ([]byte, error) {
+	return marshalEnumJSON(a)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *AccountType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumJSON(data, a, ParseAccountType)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (a AccountType) MarshalText() ([]byte, error) {
+	return marshalEnumText(a)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (a *AccountType) UnmarshalText(data []byte) error {
+	return unmarshalEnumText(data, a, ParseAccountType)
+}
+
+// Scan implements sql.Scanner.
+func (a *AccountType) Scan(src interface{}) error {
+	return scanEnum(src, a, ParseAccountType)
+}
+
+// Value implements driver.Valuer.
+func (a AccountType) Value() (driver.Value, error) {
+	return enumValue(a)
 }
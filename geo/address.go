@@ -1,5 +1,7 @@
 package geo
 
+import "strings"
+
 // Address represents a structured postal address.
 type Address struct {
 	Street     string `json:"street,omitempty"`
@@ -57,3 +59,41 @@ func (a Address) String() string {
 	}
 	return result
 }
+
+// ToGeocodingQuery returns the address as a single-line search string for
+// geocoding APIs, e.g. "123 Main St, Maputo, Mozambique".
+// Fields are trimmed and empty fields are omitted.
+//
+//nolint:gocritic // hugeParam: value receiver for consistency with String() and NewAddress()
+func (a Address) ToGeocodingQuery() string {
+	fields := []string{a.Street, a.City, a.Province, a.PostalCode, a.Country}
+	parts := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if f = strings.TrimSpace(f); f != "" {
+			parts = append(parts, f)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ToStructuredComponents returns the address as parameters for structured
+// geocoding APIs, keyed by "street", "city", "state", "postal_code", and
+// "country". The province is reported as "state". Fields are trimmed and
+// empty fields are omitted, so an empty address yields an empty map.
+//
+//nolint:gocritic // hugeParam: value receiver for consistency with String() and NewAddress()
+func (a Address) ToStructuredComponents() map[string]string {
+	components := make(map[string]string, 5)
+	for key, value := range map[string]string{
+		"street":      a.Street,
+		"city":        a.City,
+		"state":       a.Province,
+		"postal_code": a.PostalCode,
+		"country":     a.Country,
+	} {
+		if value = strings.TrimSpace(value); value != "" {
+			components[key] = value
+		}
+	}
+	return components
+}
@@ -1,10 +1,13 @@
 package geo
 
 import (
+	cryptorand "crypto/rand"
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/rand"
 )
 
 var (
@@ -93,6 +96,29 @@ func (bb BoundingBox) Center() Location {
 	}
 }
 
+// RandomPoint returns a random location within the bounding box, for test
+// fixtures and load testing. The generator is seeded from crypto/rand; the
+// point is not suitable for security-sensitive use.
+// Returns an error if the seed cannot be read.
+func (bb BoundingBox) RandomPoint() (Location, error) {
+	var seed [8]byte
+	if _, err := cryptorand.Read(seed[:]); err != nil {
+		return Location{}, fmt.Errorf("seeding random point: %w", err)
+	}
+	r := rand.New(rand.NewSource(int64(binary.LittleEndian.Uint64(seed[:])))) //nolint:gosec // Sampling only; the seed bits are reinterpreted, not truncated.
+	return bb.RandomPointWithRand(r), nil
+}
+
+// RandomPointWithRand returns a location within the bounding box drawn from r,
+// uniformly distributed in latitude and longitude. Use a seeded r for
+// reproducible fixtures.
+func (bb BoundingBox) RandomPointWithRand(r *rand.Rand) Location {
+	return Location{
+		lat: min(bb.minLat+r.Float64()*(bb.maxLat-bb.minLat), bb.maxLat),
+		lon: min(bb.minLon+r.Float64()*(bb.maxLon-bb.minLon), bb.maxLon),
+	}
+}
+
 // IsZero returns true if the bounding box is the zero value.
 func (bb BoundingBox) IsZero() bool {
 	return bb.minLat == 0 && bb.minLon == 0 && bb.maxLat == 0 && bb.maxLon == 0
@@ -0,0 +1,118 @@
+package geo
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// ElevationService looks up the ground elevation of a location in meters
+// above sea level, for example from a terrain data provider.
+type ElevationService interface {
+	GetElevation(l Location) (float64, error)
+}
+
+// MockElevationService is an ElevationService for tests that returns
+// ElevationM for every location, or Err if it is set.
+type MockElevationService struct {
+	ElevationM float64
+	Err        error
+}
+
+var _ ElevationService = MockElevationService{}
+
+// GetElevation implements ElevationService.
+func (m MockElevationService) GetElevation(Location) (float64, error) {
+	if m.Err != nil {
+		return 0, m.Err
+	}
+	return m.ElevationM, nil
+}
+
+// LocationWithElevation is a Location with its elevation in meters above
+// sea level. It serializes like Location with the elevation added.
+type LocationWithElevation struct {
+	Location
+	ElevationM float64
+}
+
+// WithElevation returns the location annotated with the given elevation in meters.
+func (l Location) WithElevation(elev float64) LocationWithElevation {
+	return LocationWithElevation{Location: l, ElevationM: elev}
+}
+
+// locationWithElevationJSON is used for JSON marshaling/unmarshaling.
+type locationWithElevationJSON struct {
+	Latitude   float64 `json:"latitude"`
+	Longitude  float64 `json:"longitude"`
+	ElevationM float64 `json:"elevation_m"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (l LocationWithElevation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(locationWithElevationJSON{
+		Latitude:   l.lat,
+		Longitude:  l.lon,
+		ElevationM: l.ElevationM,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (l *LocationWithElevation) UnmarshalJSON(data []byte) error {
+	var lj locationWithElevationJSON
+	if err := json.Unmarshal(data, &lj); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidLocation, err.Error())
+	}
+
+	loc, err := NewLocation(lj.Latitude, lj.Longitude)
+	if err != nil {
+		return err
+	}
+
+	*l = loc.WithElevation(lj.ElevationM)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// The format is "lat,lon,elevation".
+func (l LocationWithElevation) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%f,%f,%f", l.lat, l.lon, l.ElevationM)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (l *LocationWithElevation) UnmarshalText(data []byte) error {
+	var lat, lon, elev float64
+	_, err := fmt.Sscanf(string(data), "%f,%f,%f", &lat, &lon, &elev)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidLocation, err.Error())
+	}
+
+	loc, err := NewLocation(lat, lon)
+	if err != nil {
+		return err
+	}
+
+	*l = loc.WithElevation(elev)
+	return nil
+}
+
+// Value implements driver.Valuer for database storage.
+// Stores as "lat,lon,elevation" string format.
+func (l LocationWithElevation) Value() (driver.Value, error) {
+	return fmt.Sprintf("%f,%f,%f", l.lat, l.lon, l.ElevationM), nil
+}
+
+// Scan implements sql.Scanner for database retrieval.
+func (l *LocationWithElevation) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		return l.UnmarshalText([]byte(v))
+	case []byte:
+		return l.UnmarshalText(v)
+	case nil:
+		*l = LocationWithElevation{}
+		return nil
+	default:
+		return fmt.Errorf("cannot scan type %T into LocationWithElevation", src)
+	}
+}
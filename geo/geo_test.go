@@ -2,7 +2,10 @@ package geo
 
 import (
 	"encoding/json"
+	"errors"
+	"maps"
 	"math"
+	"math/rand"
 	"testing"
 )
 
@@ -149,6 +152,32 @@ func TestDistanceKM(t *testing.T) {
 	}
 }
 
+func TestDistanceM(t *testing.T) {
+	t.Parallel()
+
+	pairs := [][2]Location{
+		{MustNewLocation(-25.9692, 32.5732), MustNewLocation(-25.9692, 32.5732)},
+		{MaputoDowntown, MaputoAirport},
+		{MustNewLocation(-25.9692, 32.5732), MustNewLocation(-19.8, 34.85)},
+		{MustNewLocation(-90, -180), MustNewLocation(90, 180)},
+	}
+	for _, p := range pairs {
+		from, to := p[0], p[1]
+		wantM := DistanceKM(from, to) * 1000
+		if got := DistanceM(from, to); got != wantM {
+			t.Errorf("DistanceM(%v, %v) = %f, want DistanceKM*1000 = %f", from, to, got, wantM)
+		}
+		if got := from.DistanceToM(to); got != wantM {
+			t.Errorf("%v.DistanceToM(%v) = %f, want %f", from, to, got, wantM)
+		}
+	}
+
+	equator := DistanceM(MustNewLocation(0, 0), MustNewLocation(0, 1))
+	if math.Abs(equator-111195) > 1 {
+		t.Errorf("DistanceM over 1° at the equator = %f, want approximately 111195", equator)
+	}
+}
+
 func TestLocation_JSON(t *testing.T) {
 	t.Parallel()
 
@@ -300,6 +329,113 @@ func TestLocation_SQL(t *testing.T) {
 	})
 }
 
+func TestLocation_PostGIS(t *testing.T) {
+	t.Parallel()
+
+	loc := MustNewLocation(-25.9692, 32.5732)
+
+	t.Run("ToPostGIS", func(t *testing.T) {
+		t.Parallel()
+		want := "ST_MakePoint(32.5732, -25.9692)"
+		if got := loc.ToPostGIS(); got != want {
+			t.Errorf("ToPostGIS() = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("ToPostGISGeography", func(t *testing.T) {
+		t.Parallel()
+		want := "ST_MakePoint(32.5732, -25.9692)::geography"
+		if got := loc.ToPostGISGeography(); got != want {
+			t.Errorf("ToPostGISGeography() = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("roundtrip", func(t *testing.T) {
+		t.Parallel()
+		for _, s := range []string{loc.ToPostGIS(), loc.ToPostGISGeography()} {
+			got, err := PostGISScanValue(s)
+			if err != nil {
+				t.Fatalf("PostGISScanValue(%q) error = %v", s, err)
+			}
+			if got != loc {
+				t.Errorf("PostGISScanValue(%q) = %v, want %v", s, got, loc)
+			}
+		}
+	})
+}
+
+func TestLocation_MapLinks(t *testing.T) {
+	t.Parallel()
+
+	loc := MustNewLocation(-25.9692, 32.5732)
+
+	t.Run("ToOSMUrl", func(t *testing.T) {
+		t.Parallel()
+		want := "https://www.openstreetmap.org/?mlat=-25.9692&mlon=32.5732&zoom=15"
+		if got := loc.ToOSMUrl(); got != want {
+			t.Errorf("ToOSMUrl() = %s, want %s", got, want)
+		}
+		if got := (Location{}).ToOSMUrl(); got != "" {
+			t.Errorf("zero Location ToOSMUrl() = %q, want empty", got)
+		}
+	})
+
+	t.Run("ToGoogleMapsUrl", func(t *testing.T) {
+		t.Parallel()
+		want := "https://maps.google.com/?q=-25.9692,32.5732"
+		if got := loc.ToGoogleMapsUrl(); got != want {
+			t.Errorf("ToGoogleMapsUrl() = %s, want %s", got, want)
+		}
+		if got := (Location{}).ToGoogleMapsUrl(); got != "" {
+			t.Errorf("zero Location ToGoogleMapsUrl() = %q, want empty", got)
+		}
+	})
+}
+
+func TestPostGISScanValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		wantLat float64
+		wantLon float64
+		wantErr bool
+	}{
+		{"WKT point", "POINT(32.5732 -25.9692)", -25.9692, 32.5732, false},
+		{"WKT with spaces", "  POINT (32.5732   -25.9692)  ", -25.9692, 32.5732, false},
+		{"lowercase WKT", "point(32.5732 -25.9692)", -25.9692, 32.5732, false},
+		{"EWKT with SRID", "SRID=4326;POINT(32.5732 -25.9692)", -25.9692, 32.5732, false},
+		{"ST_MakePoint", "ST_MakePoint(32.5732, -25.9692)", -25.9692, 32.5732, false},
+		{"geography fragment", "ST_MakePoint(32.5732, -25.9692)::geography", -25.9692, 32.5732, false},
+		{"empty", "", 0, 0, true},
+		{"unsupported type", "LINESTRING(0 0, 1 1)", 0, 0, true},
+		{"missing parentheses", "POINT 32.5732 -25.9692", 0, 0, true},
+		{"one coordinate", "POINT(32.5732)", 0, 0, true},
+		{"three coordinates", "POINT(32.5732 -25.9692 10)", 0, 0, true},
+		{"invalid longitude", "POINT(abc -25.9692)", 0, 0, true},
+		{"invalid latitude", "POINT(32.5732 abc)", 0, 0, true},
+		{"latitude out of range", "POINT(32.5732 -95)", 0, 0, true},
+		{"malformed ST_MakePoint", "ST_MakePoint(32.5732, -25.9692", 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := PostGISScanValue(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PostGISScanValue(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Latitude() != tt.wantLat || got.Longitude() != tt.wantLon {
+				t.Errorf("PostGISScanValue(%q) = %v, want (%v, %v)", tt.input, got, tt.wantLat, tt.wantLon)
+			}
+		})
+	}
+}
+
 func TestNewBoundingBox(t *testing.T) {
 	t.Parallel()
 
@@ -403,6 +539,47 @@ func TestBoundingBox_Center(t *testing.T) {
 	}
 }
 
+func TestBoundingBox_RandomPoint(t *testing.T) {
+	t.Parallel()
+
+	boxes := []BoundingBox{
+		MozambiqueBounds,
+		MaputoBounds,
+		BeiraBounds,
+		MustNewBoundingBox(-25.5, 32.5, -25.5, 32.5),
+	}
+
+	for _, bb := range boxes {
+		for range 1000 {
+			loc, err := bb.RandomPoint()
+			if err != nil {
+				t.Fatalf("RandomPoint() error = %v", err)
+			}
+			if !bb.Contains(loc) {
+				t.Fatalf("RandomPoint() = %v, not within %v", loc, bb)
+			}
+		}
+	}
+}
+
+func TestBoundingBox_RandomPointWithRand(t *testing.T) {
+	t.Parallel()
+
+	bb := MozambiqueBounds
+	r := rand.New(rand.NewSource(42))
+	for range 1000 {
+		if loc := bb.RandomPointWithRand(r); !bb.Contains(loc) {
+			t.Fatalf("RandomPointWithRand() = %v, not within %v", loc, bb)
+		}
+	}
+
+	a := bb.RandomPointWithRand(rand.New(rand.NewSource(7)))
+	b := bb.RandomPointWithRand(rand.New(rand.NewSource(7)))
+	if a != b {
+		t.Errorf("same seed produced %v and %v, want identical points", a, b)
+	}
+}
+
 func TestBoundingBox_IsZero(t *testing.T) {
 	t.Parallel()
 
@@ -505,6 +682,155 @@ func TestBoundingBox_SQL(t *testing.T) {
 	})
 }
 
+func TestNewPolygon(t *testing.T) {
+	t.Parallel()
+
+	a := MustNewLocation(-25.9, 32.5)
+	b := MustNewLocation(-25.9, 32.6)
+	c := MustNewLocation(-26.0, 32.55)
+
+	tests := []struct {
+		name    string
+		locs    []Location
+		wantErr bool
+	}{
+		{"triangle", []Location{a, b, c}, false},
+		{"closed triangle", []Location{a, b, c, a}, false},
+		{"empty", nil, true},
+		{"two points", []Location{a, b}, true},
+		{"three points, two distinct", []Location{a, b, a}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p, err := NewPolygon(tt.locs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewPolygon() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidPolygon) {
+					t.Errorf("NewPolygon() error = %v, want ErrInvalidPolygon", err)
+				}
+				return
+			}
+			if len(p) != len(tt.locs) {
+				t.Errorf("len(NewPolygon()) = %d, want %d", len(p), len(tt.locs))
+			}
+		})
+	}
+
+	t.Run("copies input", func(t *testing.T) {
+		t.Parallel()
+		locs := []Location{a, b, c}
+		p, _ := NewPolygon(locs)
+		locs[0] = MaputoAirport
+		if p[0] != a {
+			t.Error("NewPolygon() should copy the input slice")
+		}
+	})
+}
+
+func TestPolygon_Triangle(t *testing.T) {
+	t.Parallel()
+
+	// Right triangle with legs of 1 degree along each axis.
+	triangle, err := NewPolygon([]Location{
+		MustNewLocation(-26, 32),
+		MustNewLocation(-26, 33),
+		MustNewLocation(-25, 32),
+	})
+	if err != nil {
+		t.Fatalf("NewPolygon() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		loc  Location
+		want bool
+	}{
+		{"interior", MustNewLocation(-25.75, 32.25), true},
+		{"vertex", MustNewLocation(-26, 32), true},
+		{"on edge", MustNewLocation(-26, 32.5), true},
+		{"on hypotenuse", MustNewLocation(-25.5, 32.5), true},
+		{"outside hypotenuse", MustNewLocation(-25.25, 32.75), false},
+		{"inside bounding box only", MustNewLocation(-25.1, 32.9), false},
+		{"far away", MustNewLocation(-19.8, 34.85), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := triangle.Contains(tt.loc); got != tt.want {
+				t.Errorf("Contains(%v) = %v, want %v", tt.loc, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("Area", func(t *testing.T) {
+		t.Parallel()
+		if got := triangle.Area(); math.Abs(got-0.5) > 1e-9 {
+			t.Errorf("Area() = %v, want 0.5", got)
+		}
+	})
+
+	t.Run("BoundingBox", func(t *testing.T) {
+		t.Parallel()
+		want := MustNewBoundingBox(-26, 32, -25, 33)
+		if got := triangle.BoundingBox(); got != want {
+			t.Errorf("BoundingBox() = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestPolygon_MatchesBoundingBox(t *testing.T) {
+	t.Parallel()
+
+	bb := MaputoBounds
+	rect, err := NewPolygon([]Location{
+		MustNewLocation(bb.MinLatitude(), bb.MinLongitude()),
+		MustNewLocation(bb.MinLatitude(), bb.MaxLongitude()),
+		MustNewLocation(bb.MaxLatitude(), bb.MaxLongitude()),
+		MustNewLocation(bb.MaxLatitude(), bb.MinLongitude()),
+	})
+	if err != nil {
+		t.Fatalf("NewPolygon() error = %v", err)
+	}
+
+	if got := rect.BoundingBox(); got != bb {
+		t.Errorf("BoundingBox() = %v, want %v", got, bb)
+	}
+
+	wantArea := (bb.MaxLatitude() - bb.MinLatitude()) * (bb.MaxLongitude() - bb.MinLongitude())
+	if got := rect.Area(); math.Abs(got-wantArea) > 1e-9 {
+		t.Errorf("Area() = %v, want %v", got, wantArea)
+	}
+
+	for lat := -26.2; lat <= -25.7; lat += 0.05 {
+		for lon := 32.2; lon <= 32.8; lon += 0.05 {
+			loc := MustNewLocation(lat, lon)
+			if got, want := rect.Contains(loc), bb.Contains(loc); got != want {
+				t.Errorf("Contains(%v) = %v, BoundingBox.Contains = %v", loc, got, want)
+			}
+		}
+	}
+}
+
+func TestPolygon_Degenerate(t *testing.T) {
+	t.Parallel()
+
+	var empty Polygon
+	if empty.Contains(MaputoDowntown) {
+		t.Error("empty polygon should not contain any location")
+	}
+	if empty.Area() != 0 {
+		t.Errorf("empty Area() = %v, want 0", empty.Area())
+	}
+	if !empty.BoundingBox().IsZero() {
+		t.Errorf("empty BoundingBox() = %v, want zero", empty.BoundingBox())
+	}
+}
+
 func TestAddress(t *testing.T) {
 	t.Parallel()
 
@@ -558,6 +884,57 @@ func TestAddress(t *testing.T) {
 			t.Error("JSON round-trip failed")
 		}
 	})
+
+	t.Run("ToGeocodingQuery", func(t *testing.T) {
+		t.Parallel()
+		tests := []struct {
+			name string
+			addr Address
+			want string
+		}{
+			{"full", NewAddress("123 Main St", "Maputo", "Maputo City", "1100", "Mozambique"), "123 Main St, Maputo, Maputo City, 1100, Mozambique"},
+			{"partial", NewAddress("123 Main St", "Maputo", "", "", "Mozambique"), "123 Main St, Maputo, Mozambique"},
+			{"whitespace fields", NewAddress("  ", " Beira ", "", " ", "Mozambique"), "Beira, Mozambique"},
+			{"empty", Address{}, ""},
+		}
+		for _, tt := range tests {
+			if got := tt.addr.ToGeocodingQuery(); got != tt.want {
+				t.Errorf("%s: ToGeocodingQuery() = %q, want %q", tt.name, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("ToStructuredComponents", func(t *testing.T) {
+		t.Parallel()
+		tests := []struct {
+			name string
+			addr Address
+			want map[string]string
+		}{
+			{
+				"full",
+				NewAddress("123 Main St", "Maputo", "Maputo City", "1100", "Mozambique"),
+				map[string]string{
+					"street":      "123 Main St",
+					"city":        "Maputo",
+					"state":       "Maputo City",
+					"postal_code": "1100",
+					"country":     "Mozambique",
+				},
+			},
+			{
+				"partial",
+				NewAddress("", " Beira ", "Sofala", "", ""),
+				map[string]string{"city": "Beira", "state": "Sofala"},
+			},
+			{"empty", Address{}, map[string]string{}},
+		}
+		for _, tt := range tests {
+			if got := tt.addr.ToStructuredComponents(); !maps.Equal(got, tt.want) {
+				t.Errorf("%s: ToStructuredComponents() = %v, want %v", tt.name, got, tt.want)
+			}
+		}
+	})
 }
 
 func TestProvince(t *testing.T) {
@@ -758,6 +1135,51 @@ func TestMozambiqueBounds(t *testing.T) {
 	})
 }
 
+func TestInCity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		loc     Location
+		city    string
+		want    bool
+		wantErr error
+	}{
+		{"downtown in Maputo", MaputoDowntown, "Maputo", true, nil},
+		{"case-insensitive name", MaputoDowntown, "  maputo ", true, nil},
+		{"inside Matola", MustNewLocation(-25.95, 32.4), "Matola", true, nil},
+		{"inside Beira", MustNewLocation(-19.8, 34.85), "BEIRA", true, nil},
+		{"outside Beira", MaputoDowntown, "Beira", false, nil},
+		{"unknown city", MaputoDowntown, "Lisbon", false, ErrUnknownCity},
+		{"empty city", MaputoDowntown, "", false, ErrUnknownCity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := InCity(tt.loc, tt.city)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("InCity(%v, %q) error = %v, want %v", tt.loc, tt.city, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("InCity(%v, %q) = %v, want %v", tt.loc, tt.city, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("every city is valid and inside Mozambique", func(t *testing.T) {
+		t.Parallel()
+		for _, city := range MozambiqueCities {
+			if city.Name == "" || city.Bounds.IsZero() {
+				t.Errorf("MozambiqueCities entry %+v is incomplete", city)
+			}
+			if !InMozambique(city.Bounds.Center()) {
+				t.Errorf("%s center is outside Mozambique", city.Name)
+			}
+		}
+	})
+}
+
 func TestBoundingBox_Accessors(t *testing.T) {
 	t.Parallel()
 
@@ -906,3 +1328,110 @@ func TestProvince_Text(t *testing.T) {
 		}
 	})
 }
+
+func TestMockElevationService(t *testing.T) {
+	t.Parallel()
+
+	var svc ElevationService = MockElevationService{ElevationM: 47.5}
+	for _, loc := range []Location{MustNewLocation(-25.9692, 32.5732), MustNewLocation(-19.8436, 34.8389)} {
+		got, err := svc.GetElevation(loc)
+		if err != nil {
+			t.Fatalf("GetElevation() error = %v", err)
+		}
+		if got != 47.5 {
+			t.Errorf("GetElevation(%v) = %v, want 47.5", loc, got)
+		}
+	}
+
+	wantErr := errors.New("terrain data unavailable")
+	svc = MockElevationService{ElevationM: 47.5, Err: wantErr}
+	if _, err := svc.GetElevation(MustNewLocation(-25.9692, 32.5732)); !errors.Is(err, wantErr) {
+		t.Errorf("GetElevation() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestLocationWithElevation(t *testing.T) {
+	t.Parallel()
+
+	loc := MustNewLocation(-25.9692, 32.5732).WithElevation(47.5)
+	if loc.Latitude() != -25.9692 || loc.Longitude() != 32.5732 || loc.ElevationM != 47.5 {
+		t.Fatalf("WithElevation() = %+v", loc)
+	}
+
+	t.Run("JSON", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(loc)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		want := `{"latitude":-25.9692,"longitude":32.5732,"elevation_m":47.5}`
+		if string(data) != want {
+			t.Errorf("Marshal() = %s, want %s", data, want)
+		}
+
+		var decoded LocationWithElevation
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if decoded != loc {
+			t.Errorf("Unmarshal() = %+v, want %+v", decoded, loc)
+		}
+
+		if err := json.Unmarshal([]byte(`{"latitude":91,"longitude":0,"elevation_m":1}`), &decoded); !errors.Is(err, ErrInvalidLatitude) {
+			t.Errorf("Unmarshal(invalid latitude) error = %v, want ErrInvalidLatitude", err)
+		}
+		if err := json.Unmarshal([]byte(`"nope"`), &decoded); !errors.Is(err, ErrInvalidLocation) {
+			t.Errorf("Unmarshal(string) error = %v, want ErrInvalidLocation", err)
+		}
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := loc.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText() error = %v", err)
+		}
+		if string(data) != "-25.969200,32.573200,47.500000" {
+			t.Errorf("MarshalText() = %s", data)
+		}
+
+		var decoded LocationWithElevation
+		if err := decoded.UnmarshalText(data); err != nil {
+			t.Fatalf("UnmarshalText() error = %v", err)
+		}
+		if decoded != loc {
+			t.Errorf("UnmarshalText() = %+v, want %+v", decoded, loc)
+		}
+		if err := decoded.UnmarshalText([]byte("-25.9692,32.5732")); !errors.Is(err, ErrInvalidLocation) {
+			t.Errorf("UnmarshalText(no elevation) error = %v, want ErrInvalidLocation", err)
+		}
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		t.Parallel()
+
+		v, err := loc.Value()
+		if err != nil {
+			t.Fatalf("Value() error = %v", err)
+		}
+
+		var decoded LocationWithElevation
+		if err := decoded.Scan(v); err != nil {
+			t.Fatalf("Scan(string) error = %v", err)
+		}
+		if decoded != loc {
+			t.Errorf("Scan() = %+v, want %+v", decoded, loc)
+		}
+		if err := decoded.Scan([]byte(v.(string))); err != nil || decoded != loc {
+			t.Errorf("Scan([]byte) = %+v, %v, want %+v", decoded, err, loc)
+		}
+		if err := decoded.Scan(nil); err != nil || decoded != (LocationWithElevation{}) {
+			t.Errorf("Scan(nil) = %+v, %v, want zero", decoded, err)
+		}
+		if err := decoded.Scan(42); err == nil {
+			t.Error("Scan(int) error = nil, want error")
+		}
+	})
+}
@@ -0,0 +1,26 @@
+package geo
+
+// osmZoom is the map zoom level used for OpenStreetMap links, showing
+// individual streets.
+const osmZoom = "15"
+
+// ToOSMUrl returns an OpenStreetMap link with a marker at the location, e.g.
+// "https://www.openstreetmap.org/?mlat=-25.9692&mlon=32.5732&zoom=15".
+// Returns an empty string for the zero value.
+func (l Location) ToOSMUrl() string {
+	if l.IsZero() {
+		return ""
+	}
+	return "https://www.openstreetmap.org/?mlat=" + formatCoordinate(l.lat) +
+		"&mlon=" + formatCoordinate(l.lon) + "&zoom=" + osmZoom
+}
+
+// ToGoogleMapsUrl returns a Google Maps link for the location, e.g.
+// "https://maps.google.com/?q=-25.9692,32.5732".
+// Returns an empty string for the zero value.
+func (l Location) ToGoogleMapsUrl() string {
+	if l.IsZero() {
+		return ""
+	}
+	return "https://maps.google.com/?q=" + formatCoordinate(l.lat) + "," + formatCoordinate(l.lon)
+}
@@ -101,6 +101,17 @@ func DistanceKM(from, to Location) float64 {
 	return EarthRadiusKM * c
 }
 
+// DistanceM calculates the distance in meters between two locations
+// using the Haversine formula.
+func DistanceM(from, to Location) float64 {
+	return DistanceKM(from, to) * 1000
+}
+
+// DistanceToM returns the distance in meters from l to other.
+func (l Location) DistanceToM(other Location) float64 {
+	return DistanceM(l, other)
+}
+
 // degreesToRadians converts degrees to radians.
 func degreesToRadians(degrees float64) float64 {
 	return degrees * math.Pi / 180
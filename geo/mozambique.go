@@ -1,5 +1,20 @@
 package geo
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnknownCity is returned when a city name is not in MozambiqueCities.
+var ErrUnknownCity = errors.New("unknown city")
+
+// CityBounds associates a city name with its bounding box.
+type CityBounds struct {
+	Name   string
+	Bounds BoundingBox
+}
+
 // Mozambique geographic constants and boundaries.
 var (
 	// MozambiqueBounds defines the bounding box for Mozambique.
@@ -27,17 +42,46 @@ func InMozambique(loc Location) bool {
 	return MozambiqueBounds.Contains(loc)
 }
 
+// MozambiqueCities lists the cities with known boundaries, used by InCity.
+var MozambiqueCities = []CityBounds{
+	{Name: "Maputo", Bounds: MaputoBounds},
+	{Name: "Matola", Bounds: MatolaBounds},
+	{Name: "Beira", Bounds: BeiraBounds},
+}
+
+// InCity returns true if the location is within the named city's boundaries.
+// City names are matched case-insensitively against MozambiqueCities.
+// Returns ErrUnknownCity if the city is not listed.
+func InCity(loc Location, cityName string) (bool, error) {
+	name := strings.TrimSpace(cityName)
+	for _, city := range MozambiqueCities {
+		if strings.EqualFold(city.Name, name) {
+			return city.Bounds.Contains(loc), nil
+		}
+	}
+	return false, fmt.Errorf("%w: %q", ErrUnknownCity, cityName)
+}
+
 // InMaputo returns true if the location is within Maputo City's boundaries.
+//
+// Deprecated: Use InCity(loc, "Maputo") instead.
 func InMaputo(loc Location) bool {
-	return MaputoBounds.Contains(loc)
+	in, _ := InCity(loc, "Maputo")
+	return in
 }
 
 // InMatola returns true if the location is within Matola's boundaries.
+//
+// Deprecated: Use InCity(loc, "Matola") instead.
 func InMatola(loc Location) bool {
-	return MatolaBounds.Contains(loc)
+	in, _ := InCity(loc, "Matola")
+	return in
 }
 
 // InBeira returns true if the location is within Beira's boundaries.
+//
+// Deprecated: Use InCity(loc, "Beira") instead.
 func InBeira(loc Location) bool {
-	return BeiraBounds.Contains(loc)
+	in, _ := InCity(loc, "Beira")
+	return in
 }
@@ -0,0 +1,98 @@
+package geo
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrInvalidPolygon is returned when a polygon has fewer than 3 distinct points.
+var ErrInvalidPolygon = errors.New("polygon must have at least 3 distinct points")
+
+// Polygon represents a closed geographic area, such as a service zone, defined
+// by its vertices in order. The last vertex connects back to the first, so the
+// first point does not need to be repeated.
+type Polygon []Location
+
+// NewPolygon creates a new Polygon from the given vertices.
+// Returns ErrInvalidPolygon if there are fewer than 3 distinct points.
+// The input slice is copied.
+func NewPolygon(locs []Location) (Polygon, error) {
+	distinct := make(map[Location]struct{}, len(locs))
+	for _, loc := range locs {
+		distinct[loc] = struct{}{}
+	}
+	if len(distinct) < 3 {
+		return nil, ErrInvalidPolygon
+	}
+
+	p := make(Polygon, len(locs))
+	copy(p, locs)
+	return p, nil
+}
+
+// Contains returns true if the location is inside the polygon or on its edge,
+// using the ray-casting algorithm on latitude/longitude as planar coordinates.
+func (p Polygon) Contains(loc Location) bool {
+	if len(p) < 3 {
+		return false
+	}
+
+	inside := false
+	for i, j := 0, len(p)-1; i < len(p); j, i = i, i+1 {
+		a, b := p[i], p[j]
+		if onSegment(loc, a, b) {
+			return true
+		}
+		if (a.lat > loc.lat) != (b.lat > loc.lat) {
+			crossLon := (b.lon-a.lon)*(loc.lat-a.lat)/(b.lat-a.lat) + a.lon
+			if loc.lon < crossLon {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
+
+// BoundingBox returns the smallest BoundingBox containing every vertex.
+// Returns the zero BoundingBox for an empty polygon.
+func (p Polygon) BoundingBox() BoundingBox {
+	if len(p) == 0 {
+		return BoundingBox{}
+	}
+
+	bb := BoundingBox{minLat: p[0].lat, minLon: p[0].lon, maxLat: p[0].lat, maxLon: p[0].lon}
+	for _, loc := range p[1:] {
+		bb.minLat = math.Min(bb.minLat, loc.lat)
+		bb.minLon = math.Min(bb.minLon, loc.lon)
+		bb.maxLat = math.Max(bb.maxLat, loc.lat)
+		bb.maxLon = math.Max(bb.maxLon, loc.lon)
+	}
+	return bb
+}
+
+// Area returns the area of the polygon in square degrees using the Shoelace
+// formula, treating latitude/longitude as flat coordinates. This is only an
+// approximation suitable for comparing zones of similar latitude.
+func (p Polygon) Area() float64 {
+	if len(p) < 3 {
+		return 0
+	}
+
+	var sum float64
+	for i, j := 0, len(p)-1; i < len(p); j, i = i, i+1 {
+		sum += p[j].lon*p[i].lat - p[i].lon*p[j].lat
+	}
+	return math.Abs(sum) / 2
+}
+
+// onSegment returns true if loc lies on the line segment between a and b.
+func onSegment(loc, a, b Location) bool {
+	const epsilon = 1e-12
+
+	cross := (b.lon-a.lon)*(loc.lat-a.lat) - (b.lat-a.lat)*(loc.lon-a.lon)
+	if math.Abs(cross) > epsilon {
+		return false
+	}
+	return loc.lon >= math.Min(a.lon, b.lon) && loc.lon <= math.Max(a.lon, b.lon) &&
+		loc.lat >= math.Min(a.lat, b.lat) && loc.lat <= math.Max(a.lat, b.lat)
+}
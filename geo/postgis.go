@@ -0,0 +1,81 @@
+package geo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ToPostGIS returns a PostGIS SQL fragment constructing the location as a
+// geometry point, e.g. "ST_MakePoint(32.5732, -25.9692)".
+// PostGIS expects longitude before latitude.
+func (l Location) ToPostGIS() string {
+	return "ST_MakePoint(" + formatCoordinate(l.lon) + ", " + formatCoordinate(l.lat) + ")"
+}
+
+// ToPostGISGeography returns the ToPostGIS fragment cast to geography,
+// e.g. "ST_MakePoint(32.5732, -25.9692)::geography".
+func (l Location) ToPostGISGeography() string {
+	return l.ToPostGIS() + "::geography"
+}
+
+// PostGISScanValue parses a location from the WKT "POINT(lon lat)" format
+// returned by PostGIS (for example via ST_AsText). An EWKT "SRID=4326;"
+// prefix is ignored. The fragments produced by ToPostGIS and
+// ToPostGISGeography are also accepted.
+func PostGISScanValue(postgisStr string) (Location, error) {
+	s := strings.TrimSpace(postgisStr)
+	if i := strings.IndexByte(s, ';'); i >= 0 && strings.HasPrefix(strings.ToUpper(s), "SRID=") {
+		s = strings.TrimSpace(s[i+1:])
+	}
+	s = strings.TrimSuffix(s, "::geography")
+
+	var coords []string
+	upper := strings.ToUpper(s)
+	switch {
+	case strings.HasPrefix(upper, "POINT"):
+		inner, ok := parenthesized(s[len("POINT"):])
+		if !ok {
+			return Location{}, fmt.Errorf("%w: malformed POINT %q", ErrInvalidLocation, postgisStr)
+		}
+		coords = strings.Fields(inner)
+	case strings.HasPrefix(upper, "ST_MAKEPOINT"):
+		inner, ok := parenthesized(s[len("ST_MakePoint"):])
+		if !ok {
+			return Location{}, fmt.Errorf("%w: malformed ST_MakePoint %q", ErrInvalidLocation, postgisStr)
+		}
+		coords = strings.Split(inner, ",")
+	default:
+		return Location{}, fmt.Errorf("%w: unsupported PostGIS value %q", ErrInvalidLocation, postgisStr)
+	}
+
+	if len(coords) != 2 {
+		return Location{}, fmt.Errorf("%w: expected 2 coordinates in %q", ErrInvalidLocation, postgisStr)
+	}
+
+	lon, err := strconv.ParseFloat(strings.TrimSpace(coords[0]), 64)
+	if err != nil {
+		return Location{}, fmt.Errorf("%w: invalid longitude in %q", ErrInvalidLocation, postgisStr)
+	}
+	lat, err := strconv.ParseFloat(strings.TrimSpace(coords[1]), 64)
+	if err != nil {
+		return Location{}, fmt.Errorf("%w: invalid latitude in %q", ErrInvalidLocation, postgisStr)
+	}
+
+	return NewLocation(lat, lon)
+}
+
+// parenthesized returns the text between a leading "(" and a trailing ")".
+func parenthesized(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '(' || s[len(s)-1] != ')' {
+		return "", false
+	}
+	return s[1 : len(s)-1], true
+}
+
+// formatCoordinate formats a coordinate with the minimal number of digits
+// needed to represent it exactly.
+func formatCoordinate(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -1,3 +1,7 @@
 module github.com/Dorico-Dynamics/txova-go-types
 
 go 1.25.6
+
+require golang.org/x/net v0.57.0
+
+require golang.org/x/text v0.40.0 // indirect
@@ -0,0 +1,56 @@
+package ids
+
+// CachedID is an immutable typed ID together with its string form,
+// formatted once at construction. String returns the stored string without
+// formatting or allocating, for hot paths such as metrics labels that call
+// String on the same ID many times:
+//
+//	ride := ids.Cache(rideID)
+//	requests.WithLabelValues(ride.String()).Inc()
+//
+// A Go string holding freshly formatted bytes must live on the heap, so the
+// plain ID String methods always allocate once; CachedID pays that cost once
+// instead of on every call. CachedID is comparable and can be used as a map key.
+type CachedID[T Identifier] struct {
+	id  T
+	str string
+}
+
+// Cache returns id with its string form precomputed.
+func Cache[T Identifier](id T) CachedID[T] {
+	return CachedID[T]{id: id, str: id.String()}
+}
+
+// ID returns the wrapped ID.
+func (c CachedID[T]) ID() T { return c.id }
+
+// String returns the cached string form of the ID. It does not allocate.
+func (c CachedID[T]) String() string { return c.str }
+
+// IsZero returns true if the wrapped ID is the zero value.
+func (c CachedID[T]) IsZero() bool {
+	var zero T
+	return c.id == zero
+}
+
+// AppendText implements encoding.TextAppender.
+// It copies the cached string and does not allocate when b has enough
+// spare capacity.
+func (c CachedID[T]) AppendText(b []byte) ([]byte, error) {
+	return append(b, c.str...), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// The only allocation is the returned slice, which the caller owns.
+func (c CachedID[T]) MarshalText() ([]byte, error) {
+	return []byte(c.str), nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// The only allocation is the returned slice, which the caller owns.
+func (c CachedID[T]) MarshalJSON() ([]byte, error) {
+	buf := make([]byte, 0, len(c.str)+2)
+	buf = append(buf, '"')
+	buf = append(buf, c.str...)
+	return append(buf, '"'), nil
+}
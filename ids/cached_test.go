@@ -0,0 +1,66 @@
+package ids
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCachedID(t *testing.T) {
+	t.Parallel()
+
+	const validUUID = "550e8400-e29b-41d4-a716-446655440000"
+	rideID := MustParseRideID(validUUID)
+	cached := Cache(rideID)
+
+	if cached.ID() != rideID {
+		t.Errorf("ID() = %v, want %v", cached.ID(), rideID)
+	}
+	if cached.String() != rideID.String() {
+		t.Errorf("String() = %q, want %q", cached.String(), rideID.String())
+	}
+	if cached.IsZero() {
+		t.Error("IsZero() = true, want false")
+	}
+	if !Cache(RideID{}).IsZero() {
+		t.Error("Cache(RideID{}).IsZero() = false, want true")
+	}
+	if Cache(rideID) != cached {
+		t.Error("Cache() of equal IDs should be equal")
+	}
+
+	got, err := cached.AppendText([]byte("ride:"))
+	if err != nil || string(got) != "ride:"+validUUID {
+		t.Errorf("AppendText() = %s, %v, want ride:%s", got, err, validUUID)
+	}
+
+	text, err := cached.MarshalText()
+	if err != nil || string(text) != validUUID {
+		t.Errorf("MarshalText() = %s, %v, want %s", text, err, validUUID)
+	}
+
+	data, err := json.Marshal(cached)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	want, err := json.Marshal(rideID)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if string(data) != string(want) {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	// The returned slices are owned by the caller.
+	text[0] = 'X'
+	if cached.String() != validUUID {
+		t.Errorf("String() after modifying MarshalText result = %q", cached.String())
+	}
+}
+
+func BenchmarkCachedIDString(b *testing.B) {
+	cached := Cache(MustNewRideID())
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = cached.String()
+	}
+}
@@ -52,11 +52,14 @@ func (id UserID) IsZero() bool { return id.uuid.IsZero() }
 func (id UserID) MarshalJSON() ([]byte, error) { return id.uuid.MarshalJSON() }
 
 // UnmarshalJSON implements json.Unmarshaler.
-func (id *UserID) UnmarshalJSON(data []byte) error { return id.uuid.UnmarshalJSON(data) }
+func (id *UserID) UnmarshalJSON(data []byte) error { return id.uuid.unmarshalJSON("UserID", data) }
 
 // MarshalText implements encoding.TextMarshaler.
 func (id UserID) MarshalText() ([]byte, error) { return id.uuid.MarshalText() }
 
+// AppendText implements encoding.TextAppender.
+func (id UserID) AppendText(b []byte) ([]byte, error) { return id.uuid.AppendText(b) }
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (id *UserID) UnmarshalText(data []byte) error { return id.uuid.UnmarshalText(data) }
 
@@ -66,6 +69,9 @@ func (id UserID) Value() (driver.Value, error) { return id.uuid.Value() }
 // Scan implements sql.Scanner for database retrieval.
 func (id *UserID) Scan(src any) error { return id.uuid.Scan(src) }
 
+// ScanStrict is like Scan but rejects non-canonical UUIDs with ErrNonCanonicalUUID.
+func (id *UserID) ScanStrict(src any) error { return id.uuid.ScanStrict(src) }
+
 // DriverID uniquely identifies a driver in the system.
 type DriverID struct {
 	uuid UUID
@@ -113,11 +119,14 @@ func (id DriverID) IsZero() bool { return id.uuid.IsZero() }
 func (id DriverID) MarshalJSON() ([]byte, error) { return id.uuid.MarshalJSON() }
 
 // UnmarshalJSON implements json.Unmarshaler.
-func (id *DriverID) UnmarshalJSON(data []byte) error { return id.uuid.UnmarshalJSON(data) }
+func (id *DriverID) UnmarshalJSON(data []byte) error { return id.uuid.unmarshalJSON("DriverID", data) }
 
 // MarshalText implements encoding.TextMarshaler.
 func (id DriverID) MarshalText() ([]byte, error) { return id.uuid.MarshalText() }
 
+// AppendText implements encoding.TextAppender.
+func (id DriverID) AppendText(b []byte) ([]byte, error) { return id.uuid.AppendText(b) }
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (id *DriverID) UnmarshalText(data []byte) error { return id.uuid.UnmarshalText(data) }
 
@@ -127,6 +136,9 @@ func (id DriverID) Value() (driver.Value, error) { return id.uuid.Value() }
 // Scan implements sql.Scanner for database retrieval.
 func (id *DriverID) Scan(src any) error { return id.uuid.Scan(src) }
 
+// ScanStrict is like Scan but rejects non-canonical UUIDs with ErrNonCanonicalUUID.
+func (id *DriverID) ScanStrict(src any) error { return id.uuid.ScanStrict(src) }
+
 // RideID uniquely identifies a ride in the system.
 type RideID struct {
 	uuid UUID
@@ -174,11 +186,14 @@ func (id RideID) IsZero() bool { return id.uuid.IsZero() }
 func (id RideID) MarshalJSON() ([]byte, error) { return id.uuid.MarshalJSON() }
 
 // UnmarshalJSON implements json.Unmarshaler.
-func (id *RideID) UnmarshalJSON(data []byte) error { return id.uuid.UnmarshalJSON(data) }
+func (id *RideID) UnmarshalJSON(data []byte) error { return id.uuid.unmarshalJSON("RideID", data) }
 
 // MarshalText implements encoding.TextMarshaler.
 func (id RideID) MarshalText() ([]byte, error) { return id.uuid.MarshalText() }
 
+// AppendText implements encoding.TextAppender.
+func (id RideID) AppendText(b []byte) ([]byte, error) { return id.uuid.AppendText(b) }
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (id *RideID) UnmarshalText(data []byte) error { return id.uuid.UnmarshalText(data) }
 
@@ -188,6 +203,9 @@ func (id RideID) Value() (driver.Value, error) { return id.uuid.Value() }
 // Scan implements sql.Scanner for database retrieval.
 func (id *RideID) Scan(src any) error { return id.uuid.Scan(src) }
 
+// ScanStrict is like Scan but rejects non-canonical UUIDs with ErrNonCanonicalUUID.
+func (id *RideID) ScanStrict(src any) error { return id.uuid.ScanStrict(src) }
+
 // VehicleID uniquely identifies a vehicle in the system.
 type VehicleID struct {
 	uuid UUID
@@ -235,11 +253,16 @@ func (id VehicleID) IsZero() bool { return id.uuid.IsZero() }
 func (id VehicleID) MarshalJSON() ([]byte, error) { return id.uuid.MarshalJSON() }
 
 // UnmarshalJSON implements json.Unmarshaler.
-func (id *VehicleID) UnmarshalJSON(data []byte) error { return id.uuid.UnmarshalJSON(data) }
+func (id *VehicleID) UnmarshalJSON(data []byte) error {
+	return id.uuid.unmarshalJSON("VehicleID", data)
+}
 
 // MarshalText implements encoding.TextMarshaler.
 func (id VehicleID) MarshalText() ([]byte, error) { return id.uuid.MarshalText() }
 
+// AppendText implements encoding.TextAppender.
+func (id VehicleID) AppendText(b []byte) ([]byte, error) { return id.uuid.AppendText(b) }
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (id *VehicleID) UnmarshalText(data []byte) error { return id.uuid.UnmarshalText(data) }
 
@@ -249,6 +272,9 @@ func (id VehicleID) Value() (driver.Value, error) { return id.uuid.Value() }
 // Scan implements sql.Scanner for database retrieval.
 func (id *VehicleID) Scan(src any) error { return id.uuid.Scan(src) }
 
+// ScanStrict is like Scan but rejects non-canonical UUIDs with ErrNonCanonicalUUID.
+func (id *VehicleID) ScanStrict(src any) error { return id.uuid.ScanStrict(src) }
+
 // PaymentID uniquely identifies a payment in the system.
 type PaymentID struct {
 	uuid UUID
@@ -296,11 +322,16 @@ func (id PaymentID) IsZero() bool { return id.uuid.IsZero() }
 func (id PaymentID) MarshalJSON() ([]byte, error) { return id.uuid.MarshalJSON() }
 
 // UnmarshalJSON implements json.Unmarshaler.
-func (id *PaymentID) UnmarshalJSON(data []byte) error { return id.uuid.UnmarshalJSON(data) }
+func (id *PaymentID) UnmarshalJSON(data []byte) error {
+	return id.uuid.unmarshalJSON("PaymentID", data)
+}
 
 // MarshalText implements encoding.TextMarshaler.
 func (id PaymentID) MarshalText() ([]byte, error) { return id.uuid.MarshalText() }
 
+// AppendText implements encoding.TextAppender.
+func (id PaymentID) AppendText(b []byte) ([]byte, error) { return id.uuid.AppendText(b) }
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (id *PaymentID) UnmarshalText(data []byte) error { return id.uuid.UnmarshalText(data) }
 
@@ -310,6 +341,9 @@ func (id PaymentID) Value() (driver.Value, error) { return id.uuid.Value() }
 // Scan implements sql.Scanner for database retrieval.
 func (id *PaymentID) Scan(src any) error { return id.uuid.Scan(src) }
 
+// ScanStrict is like Scan but rejects non-canonical UUIDs with ErrNonCanonicalUUID.
+func (id *PaymentID) ScanStrict(src any) error { return id.uuid.ScanStrict(src) }
+
 // DocumentID uniquely identifies a document in the system.
 type DocumentID struct {
 	uuid UUID
@@ -357,11 +391,16 @@ func (id DocumentID) IsZero() bool { return id.uuid.IsZero() }
 func (id DocumentID) MarshalJSON() ([]byte, error) { return id.uuid.MarshalJSON() }
 
 // UnmarshalJSON implements json.Unmarshaler.
-func (id *DocumentID) UnmarshalJSON(data []byte) error { return id.uuid.UnmarshalJSON(data) }
+func (id *DocumentID) UnmarshalJSON(data []byte) error {
+	return id.uuid.unmarshalJSON("DocumentID", data)
+}
 
 // MarshalText implements encoding.TextMarshaler.
 func (id DocumentID) MarshalText() ([]byte, error) { return id.uuid.MarshalText() }
 
+// AppendText implements encoding.TextAppender.
+func (id DocumentID) AppendText(b []byte) ([]byte, error) { return id.uuid.AppendText(b) }
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (id *DocumentID) UnmarshalText(data []byte) error { return id.uuid.UnmarshalText(data) }
 
@@ -371,6 +410,9 @@ func (id DocumentID) Value() (driver.Value, error) { return id.uuid.Value() }
 // Scan implements sql.Scanner for database retrieval.
 func (id *DocumentID) Scan(src any) error { return id.uuid.Scan(src) }
 
+// ScanStrict is like Scan but rejects non-canonical UUIDs with ErrNonCanonicalUUID.
+func (id *DocumentID) ScanStrict(src any) error { return id.uuid.ScanStrict(src) }
+
 // IncidentID uniquely identifies a safety incident in the system.
 type IncidentID struct {
 	uuid UUID
@@ -418,11 +460,16 @@ func (id IncidentID) IsZero() bool { return id.uuid.IsZero() }
 func (id IncidentID) MarshalJSON() ([]byte, error) { return id.uuid.MarshalJSON() }
 
 // UnmarshalJSON implements json.Unmarshaler.
-func (id *IncidentID) UnmarshalJSON(data []byte) error { return id.uuid.UnmarshalJSON(data) }
+func (id *IncidentID) UnmarshalJSON(data []byte) error {
+	return id.uuid.unmarshalJSON("IncidentID", data)
+}
 
 // MarshalText implements encoding.TextMarshaler.
 func (id IncidentID) MarshalText() ([]byte, error) { return id.uuid.MarshalText() }
 
+// AppendText implements encoding.TextAppender.
+func (id IncidentID) AppendText(b []byte) ([]byte, error) { return id.uuid.AppendText(b) }
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (id *IncidentID) UnmarshalText(data []byte) error { return id.uuid.UnmarshalText(data) }
 
@@ -432,6 +479,9 @@ func (id IncidentID) Value() (driver.Value, error) { return id.uuid.Value() }
 // Scan implements sql.Scanner for database retrieval.
 func (id *IncidentID) Scan(src any) error { return id.uuid.Scan(src) }
 
+// ScanStrict is like Scan but rejects non-canonical UUIDs with ErrNonCanonicalUUID.
+func (id *IncidentID) ScanStrict(src any) error { return id.uuid.ScanStrict(src) }
+
 // TicketID uniquely identifies a support ticket in the system.
 type TicketID struct {
 	uuid UUID
@@ -479,11 +529,14 @@ func (id TicketID) IsZero() bool { return id.uuid.IsZero() }
 func (id TicketID) MarshalJSON() ([]byte, error) { return id.uuid.MarshalJSON() }
 
 // UnmarshalJSON implements json.Unmarshaler.
-func (id *TicketID) UnmarshalJSON(data []byte) error { return id.uuid.UnmarshalJSON(data) }
+func (id *TicketID) UnmarshalJSON(data []byte) error { return id.uuid.unmarshalJSON("TicketID", data) }
 
 // MarshalText implements encoding.TextMarshaler.
 func (id TicketID) MarshalText() ([]byte, error) { return id.uuid.MarshalText() }
 
+// AppendText implements encoding.TextAppender.
+func (id TicketID) AppendText(b []byte) ([]byte, error) { return id.uuid.AppendText(b) }
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (id *TicketID) UnmarshalText(data []byte) error { return id.uuid.UnmarshalText(data) }
 
@@ -492,3 +545,73 @@ func (id TicketID) Value() (driver.Value, error) { return id.uuid.Value() }
 
 // Scan implements sql.Scanner for database retrieval.
 func (id *TicketID) Scan(src any) error { return id.uuid.Scan(src) }
+
+// ScanStrict is like Scan but rejects non-canonical UUIDs with ErrNonCanonicalUUID.
+func (id *TicketID) ScanStrict(src any) error { return id.uuid.ScanStrict(src) }
+
+// ReviewID uniquely identifies a ride review in the system.
+type ReviewID struct {
+	uuid UUID
+}
+
+// NewReviewID generates a new random ReviewID.
+func NewReviewID() (ReviewID, error) {
+	uuid, err := NewUUID()
+	if err != nil {
+		return ReviewID{}, err
+	}
+	return ReviewID{uuid: uuid}, nil
+}
+
+// MustNewReviewID generates a new random ReviewID or panics on failure.
+func MustNewReviewID() ReviewID {
+	return ReviewID{uuid: MustNewUUID()}
+}
+
+// ParseReviewID parses a ReviewID from its string representation.
+func ParseReviewID(s string) (ReviewID, error) {
+	uuid, err := ParseUUID(s)
+	if err != nil {
+		return ReviewID{}, fmt.Errorf("invalid ReviewID: %w", err)
+	}
+	return ReviewID{uuid: uuid}, nil
+}
+
+// MustParseReviewID parses a ReviewID from its string representation or panics.
+func MustParseReviewID(s string) ReviewID {
+	id, err := ParseReviewID(s)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// String returns the string representation of the ReviewID.
+func (id ReviewID) String() string { return id.uuid.String() }
+
+// IsZero returns true if the ReviewID is the zero value.
+func (id ReviewID) IsZero() bool { return id.uuid.IsZero() }
+
+// MarshalJSON implements json.Marshaler.
+func (id ReviewID) MarshalJSON() ([]byte, error) { return id.uuid.MarshalJSON() }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (id *ReviewID) UnmarshalJSON(data []byte) error { return id.uuid.unmarshalJSON("ReviewID", data) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (id ReviewID) MarshalText() ([]byte, error) { return id.uuid.MarshalText() }
+
+// AppendText implements encoding.TextAppender.
+func (id ReviewID) AppendText(b []byte) ([]byte, error) { return id.uuid.AppendText(b) }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (id *ReviewID) UnmarshalText(data []byte) error { return id.uuid.UnmarshalText(data) }
+
+// Value implements driver.Valuer for database storage.
+func (id ReviewID) Value() (driver.Value, error) { return id.uuid.Value() }
+
+// Scan implements sql.Scanner for database retrieval.
+func (id *ReviewID) Scan(src any) error { return id.uuid.Scan(src) }
+
+// ScanStrict is like Scan but rejects non-canonical UUIDs with ErrNonCanonicalUUID.
+func (id *ReviewID) ScanStrict(src any) error { return id.uuid.ScanStrict(src) }
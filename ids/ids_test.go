@@ -2,6 +2,7 @@ package ids
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 )
 
@@ -18,6 +19,7 @@ type testTypedID[T any] struct {
 	unmarshal   func(*T, []byte) error
 	value       func(T) (any, error)
 	scan        func(*T, any) error
+	scanStrict  func(*T, any) error
 }
 
 func TestUserID(t *testing.T) {
@@ -34,6 +36,7 @@ func TestUserID(t *testing.T) {
 		unmarshal:   func(id *UserID, data []byte) error { return id.UnmarshalJSON(data) },
 		value:       func(id UserID) (any, error) { return id.Value() },
 		scan:        func(id *UserID, src any) error { return id.Scan(src) },
+		scanStrict:  func(id *UserID, src any) error { return id.ScanStrict(src) },
 	})
 }
 
@@ -51,6 +54,7 @@ func TestDriverID(t *testing.T) {
 		unmarshal:   func(id *DriverID, data []byte) error { return id.UnmarshalJSON(data) },
 		value:       func(id DriverID) (any, error) { return id.Value() },
 		scan:        func(id *DriverID, src any) error { return id.Scan(src) },
+		scanStrict:  func(id *DriverID, src any) error { return id.ScanStrict(src) },
 	})
 }
 
@@ -68,6 +72,7 @@ func TestRideID(t *testing.T) {
 		unmarshal:   func(id *RideID, data []byte) error { return id.UnmarshalJSON(data) },
 		value:       func(id RideID) (any, error) { return id.Value() },
 		scan:        func(id *RideID, src any) error { return id.Scan(src) },
+		scanStrict:  func(id *RideID, src any) error { return id.ScanStrict(src) },
 	})
 }
 
@@ -85,6 +90,7 @@ func TestVehicleID(t *testing.T) {
 		unmarshal:   func(id *VehicleID, data []byte) error { return id.UnmarshalJSON(data) },
 		value:       func(id VehicleID) (any, error) { return id.Value() },
 		scan:        func(id *VehicleID, src any) error { return id.Scan(src) },
+		scanStrict:  func(id *VehicleID, src any) error { return id.ScanStrict(src) },
 	})
 }
 
@@ -102,6 +108,7 @@ func TestPaymentID(t *testing.T) {
 		unmarshal:   func(id *PaymentID, data []byte) error { return id.UnmarshalJSON(data) },
 		value:       func(id PaymentID) (any, error) { return id.Value() },
 		scan:        func(id *PaymentID, src any) error { return id.Scan(src) },
+		scanStrict:  func(id *PaymentID, src any) error { return id.ScanStrict(src) },
 	})
 }
 
@@ -119,6 +126,7 @@ func TestDocumentID(t *testing.T) {
 		unmarshal:   func(id *DocumentID, data []byte) error { return id.UnmarshalJSON(data) },
 		value:       func(id DocumentID) (any, error) { return id.Value() },
 		scan:        func(id *DocumentID, src any) error { return id.Scan(src) },
+		scanStrict:  func(id *DocumentID, src any) error { return id.ScanStrict(src) },
 	})
 }
 
@@ -136,6 +144,7 @@ func TestIncidentID(t *testing.T) {
 		unmarshal:   func(id *IncidentID, data []byte) error { return id.UnmarshalJSON(data) },
 		value:       func(id IncidentID) (any, error) { return id.Value() },
 		scan:        func(id *IncidentID, src any) error { return id.Scan(src) },
+		scanStrict:  func(id *IncidentID, src any) error { return id.ScanStrict(src) },
 	})
 }
 
@@ -153,6 +162,25 @@ func TestTicketID(t *testing.T) {
 		unmarshal:   func(id *TicketID, data []byte) error { return id.UnmarshalJSON(data) },
 		value:       func(id TicketID) (any, error) { return id.Value() },
 		scan:        func(id *TicketID, src any) error { return id.Scan(src) },
+		scanStrict:  func(id *TicketID, src any) error { return id.ScanStrict(src) },
+	})
+}
+
+func TestReviewID(t *testing.T) {
+	t.Parallel()
+	runTypedIDTests(t, testTypedID[ReviewID]{
+		name:        "ReviewID",
+		newFunc:     NewReviewID,
+		mustNewFunc: MustNewReviewID,
+		parseFunc:   ParseReviewID,
+		mustParse:   MustParseReviewID,
+		stringer:    func(id ReviewID) string { return id.String() },
+		isZero:      func(id ReviewID) bool { return id.IsZero() },
+		marshal:     func(id ReviewID) ([]byte, error) { return id.MarshalJSON() },
+		unmarshal:   func(id *ReviewID, data []byte) error { return id.UnmarshalJSON(data) },
+		value:       func(id ReviewID) (any, error) { return id.Value() },
+		scan:        func(id *ReviewID, src any) error { return id.Scan(src) },
+		scanStrict:  func(id *ReviewID, src any) error { return id.ScanStrict(src) },
 	})
 }
 
@@ -266,6 +294,35 @@ func runTypedIDTests[T any](t *testing.T, tt testTypedID[T]) {
 		}
 	})
 
+	t.Run("JSON unmarshal number returns descriptive error", func(t *testing.T) {
+		t.Parallel()
+		var id T
+		err := tt.unmarshal(&id, []byte(`12345`))
+		if err == nil {
+			t.Fatalf("%s.UnmarshalJSON(12345) should return error", tt.name)
+		}
+		if !errors.Is(err, ErrInvalidID) || !errors.Is(err, ErrInvalidUUID) {
+			t.Errorf("%s.UnmarshalJSON(12345) error = %v, want ErrInvalidID and ErrInvalidUUID", tt.name, err)
+		}
+		want := "invalid " + tt.name + `: expected UUID string, got number "12345"`
+		if err.Error() != want {
+			t.Errorf("%s.UnmarshalJSON(12345) error = %q, want %q", tt.name, err.Error(), want)
+		}
+	})
+
+	t.Run("JSON unmarshal malformed string returns descriptive error", func(t *testing.T) {
+		t.Parallel()
+		var id T
+		err := tt.unmarshal(&id, []byte(`"not-a-uuid"`))
+		if !errors.Is(err, ErrInvalidID) {
+			t.Fatalf("%s.UnmarshalJSON() error = %v, want ErrInvalidID", tt.name, err)
+		}
+		want := "invalid " + tt.name + `: malformed UUID string "not-a-uuid"`
+		if err.Error() != want {
+			t.Errorf("%s.UnmarshalJSON() error = %q, want %q", tt.name, err.Error(), want)
+		}
+	})
+
 	t.Run("JSON round-trip", func(t *testing.T) {
 		t.Parallel()
 		original := tt.mustNewFunc()
@@ -325,6 +382,19 @@ func runTypedIDTests[T any](t *testing.T, tt testTypedID[T]) {
 		}
 	})
 
+	t.Run("SQL ScanStrict", func(t *testing.T) {
+		t.Parallel()
+		var id T
+		if err := tt.scanStrict(&id, "550e8400-e29b-41d4-a716-446655440000"); err != nil {
+			t.Fatalf("%s.ScanStrict() error = %v", tt.name, err)
+		}
+		// Microsoft variant bits in byte 8.
+		nonCanonical := MustParseUUID("550e8400-e29b-41d4-c716-446655440000").Bytes()
+		if err := tt.scanStrict(&id, nonCanonical); !errors.Is(err, ErrNonCanonicalUUID) {
+			t.Errorf("%s.ScanStrict(non-canonical) error = %v, want ErrNonCanonicalUUID", tt.name, err)
+		}
+	})
+
 	t.Run("SQL round-trip", func(t *testing.T) {
 		t.Parallel()
 		original := tt.mustNewFunc()
@@ -359,6 +429,7 @@ func TestTypeSafety(t *testing.T) {
 		_ DocumentID
 		_ IncidentID
 		_ TicketID
+		_ ReviewID
 	)
 
 	// Verify the types are indeed different by checking their string representations
@@ -372,6 +443,35 @@ func TestTypeSafety(t *testing.T) {
 	}
 }
 
+func TestTextAppender(t *testing.T) {
+	t.Parallel()
+
+	const validUUID = "550e8400-e29b-41d4-a716-446655440000"
+	appenders := map[string]interface {
+		AppendText(b []byte) ([]byte, error)
+	}{
+		"UserID":     MustParseUserID(validUUID),
+		"DriverID":   MustParseDriverID(validUUID),
+		"RideID":     MustParseRideID(validUUID),
+		"VehicleID":  MustParseVehicleID(validUUID),
+		"PaymentID":  MustParsePaymentID(validUUID),
+		"DocumentID": MustParseDocumentID(validUUID),
+		"IncidentID": MustParseIncidentID(validUUID),
+		"TicketID":   MustParseTicketID(validUUID),
+		"ReviewID":   MustParseReviewID(validUUID),
+	}
+
+	for name, id := range appenders {
+		got, err := id.AppendText(nil)
+		if err != nil {
+			t.Fatalf("%s.AppendText() error = %v", name, err)
+		}
+		if string(got) != validUUID {
+			t.Errorf("%s.AppendText() = %s, want %s", name, got, validUUID)
+		}
+	}
+}
+
 func TestTextMarshaler(t *testing.T) {
 	t.Parallel()
 
@@ -536,4 +636,24 @@ func TestTextMarshaler(t *testing.T) {
 			t.Errorf("UnmarshalText() result = %s, want %s", parsed.String(), validUUID)
 		}
 	})
+
+	t.Run("ReviewID", func(t *testing.T) {
+		t.Parallel()
+		id := MustParseReviewID(validUUID)
+		data, err := id.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText() error = %v", err)
+		}
+		if string(data) != validUUID {
+			t.Errorf("MarshalText() = %s, want %s", data, validUUID)
+		}
+
+		var parsed ReviewID
+		if err := parsed.UnmarshalText(data); err != nil {
+			t.Fatalf("UnmarshalText() error = %v", err)
+		}
+		if parsed.String() != validUUID {
+			t.Errorf("UnmarshalText() result = %s, want %s", parsed.String(), validUUID)
+		}
+	})
 }
@@ -0,0 +1,48 @@
+package ids
+
+// Identifier is satisfied by every typed ID in this package.
+type Identifier interface {
+	comparable
+	String() string
+}
+
+// Pair is an ordered pair of typed IDs.
+// Pair is comparable, so the struct itself can be used directly as a map key;
+// building a string key with fmt.Sprintf is unnecessary and allocates.
+// Pair{a, b} and Pair{b, a} are distinct keys.
+type Pair[A, B Identifier] struct {
+	first  A
+	second B
+}
+
+// RideDriverKey identifies a ride/driver combination, e.g. for dispatch deduplication.
+type RideDriverKey = Pair[RideID, DriverID]
+
+// NewPair creates a Pair from two IDs.
+func NewPair[A, B Identifier](first A, second B) Pair[A, B] {
+	return Pair[A, B]{first: first, second: second}
+}
+
+// First returns the first ID of the pair.
+func (p Pair[A, B]) First() A { return p.first }
+
+// Second returns the second ID of the pair.
+func (p Pair[A, B]) Second() B { return p.second }
+
+// IsZero returns true if both IDs are the zero value.
+func (p Pair[A, B]) IsZero() bool {
+	var zeroA A
+	var zeroB B
+	return p.first == zeroA && p.second == zeroB
+}
+
+// Key returns a "first:second" string representation of the pair.
+// It is intended for logging only; use the Pair itself as a map key.
+func (p Pair[A, B]) Key() string {
+	return p.first.String() + ":" + p.second.String()
+}
+
+// String returns the same representation as Key.
+func (p Pair[A, B]) String() string {
+	return p.Key()
+}
@@ -0,0 +1,115 @@
+package ids
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPair(t *testing.T) {
+	t.Parallel()
+
+	rideID := MustParseRideID("550e8400-e29b-41d4-a716-446655440000")
+	driverID := MustParseDriverID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	t.Run("accessors", func(t *testing.T) {
+		t.Parallel()
+		p := NewPair(rideID, driverID)
+		if p.First() != rideID {
+			t.Errorf("First() = %s, want %s", p.First(), rideID)
+		}
+		if p.Second() != driverID {
+			t.Errorf("Second() = %s, want %s", p.Second(), driverID)
+		}
+	})
+
+	t.Run("Key", func(t *testing.T) {
+		t.Parallel()
+		p := NewPair(rideID, driverID)
+		want := "550e8400-e29b-41d4-a716-446655440000:6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+		if p.Key() != want {
+			t.Errorf("Key() = %s, want %s", p.Key(), want)
+		}
+		if p.String() != want {
+			t.Errorf("String() = %s, want %s", p.String(), want)
+		}
+	})
+
+	t.Run("IsZero", func(t *testing.T) {
+		t.Parallel()
+		var zero RideDriverKey
+		if !zero.IsZero() {
+			t.Error("zero Pair.IsZero() = false, want true")
+		}
+		if NewPair(rideID, DriverID{}).IsZero() {
+			t.Error("half-set Pair.IsZero() = true, want false")
+		}
+	})
+
+	t.Run("usable as map key", func(t *testing.T) {
+		t.Parallel()
+		seen := make(map[RideDriverKey]bool)
+		seen[NewPair(rideID, driverID)] = true
+
+		// An equal pair built from separately parsed IDs hits the same entry.
+		again := NewPair(
+			MustParseRideID(rideID.String()),
+			MustParseDriverID(driverID.String()),
+		)
+		if !seen[again] {
+			t.Error("equal Pair not found in map")
+		}
+
+		other := NewPair(rideID, MustNewDriverID())
+		if seen[other] {
+			t.Error("different Pair found in map")
+		}
+	})
+
+	t.Run("order matters", func(t *testing.T) {
+		t.Parallel()
+		a := MustNewUserID()
+		b := MustNewUserID()
+		if NewPair(a, b) == NewPair(b, a) {
+			t.Error("NewPair(a, b) == NewPair(b, a), want distinct")
+		}
+	})
+}
+
+func BenchmarkPairMapKey(b *testing.B) {
+	rides, drivers := benchmarkIDs(b)
+	m := make(map[RideDriverKey]struct{}, len(rides))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := range b.N {
+		k := NewPair(rides[i%len(rides)], drivers[i%len(drivers)])
+		if _, ok := m[k]; !ok {
+			m[k] = struct{}{}
+		}
+	}
+}
+
+func BenchmarkSprintfMapKey(b *testing.B) {
+	rides, drivers := benchmarkIDs(b)
+	m := make(map[string]struct{}, len(rides))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := range b.N {
+		k := fmt.Sprintf("%s:%s", rides[i%len(rides)], drivers[i%len(drivers)])
+		if _, ok := m[k]; !ok {
+			m[k] = struct{}{}
+		}
+	}
+}
+
+func benchmarkIDs(b *testing.B) ([]RideID, []DriverID) {
+	b.Helper()
+	rides := make([]RideID, 1024)
+	drivers := make([]DriverID, 1024)
+	for i := range rides {
+		rides[i] = MustNewRideID()
+		drivers[i] = MustNewDriverID()
+	}
+	return rides, drivers
+}
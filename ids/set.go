@@ -0,0 +1,83 @@
+package ids
+
+// IDSet is a set of typed IDs, e.g. for authorization checks over
+// collections of users. The zero value is a nil set: it supports reads but
+// Add panics, so create sets with NewIDSet.
+type IDSet[T comparable] map[T]struct{}
+
+// NewIDSet creates a set containing the given IDs.
+func NewIDSet[T comparable](ids ...T) IDSet[T] {
+	s := make(IDSet[T], len(ids))
+	for _, id := range ids {
+		s[id] = struct{}{}
+	}
+	return s
+}
+
+// Add inserts id into the set. Adding an existing ID is a no-op.
+func (s IDSet[T]) Add(id T) {
+	s[id] = struct{}{}
+}
+
+// Remove deletes id from the set. Removing an absent ID is a no-op.
+func (s IDSet[T]) Remove(id T) {
+	delete(s, id)
+}
+
+// Contains returns true if id is in the set.
+func (s IDSet[T]) Contains(id T) bool {
+	_, ok := s[id]
+	return ok
+}
+
+// Len returns the number of IDs in the set.
+func (s IDSet[T]) Len() int {
+	return len(s)
+}
+
+// Union returns a new set with the IDs in either s or other.
+func (s IDSet[T]) Union(other IDSet[T]) IDSet[T] {
+	result := make(IDSet[T], len(s)+len(other))
+	for id := range s {
+		result[id] = struct{}{}
+	}
+	for id := range other {
+		result[id] = struct{}{}
+	}
+	return result
+}
+
+// Intersection returns a new set with the IDs in both s and other.
+func (s IDSet[T]) Intersection(other IDSet[T]) IDSet[T] {
+	small, large := s, other
+	if len(large) < len(small) {
+		small, large = large, small
+	}
+	result := make(IDSet[T])
+	for id := range small {
+		if large.Contains(id) {
+			result[id] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference returns a new set with the IDs in s that are not in other.
+func (s IDSet[T]) Difference(other IDSet[T]) IDSet[T] {
+	result := make(IDSet[T])
+	for id := range s {
+		if !other.Contains(id) {
+			result[id] = struct{}{}
+		}
+	}
+	return result
+}
+
+// ToSlice returns the IDs in the set in unspecified order.
+func (s IDSet[T]) ToSlice() []T {
+	result := make([]T, 0, len(s))
+	for id := range s {
+		result = append(result, id)
+	}
+	return result
+}
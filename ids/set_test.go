@@ -0,0 +1,108 @@
+package ids
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestIDSet(t *testing.T) {
+	t.Parallel()
+
+	a := MustParseUserID("550e8400-e29b-41d4-a716-446655440000")
+	b := MustParseUserID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	c := MustParseUserID("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+	d := MustParseUserID("6ba7b812-9dad-11d1-80b4-00c04fd430c8")
+
+	// sorted returns the set contents in a deterministic order for comparison.
+	sorted := func(s IDSet[UserID]) []string {
+		out := make([]string, 0, s.Len())
+		for _, id := range s.ToSlice() {
+			out = append(out, id.String())
+		}
+		slices.Sort(out)
+		return out
+	}
+	strs := func(ids ...UserID) []string {
+		out := make([]string, len(ids))
+		for i, id := range ids {
+			out[i] = id.String()
+		}
+		slices.Sort(out)
+		return out
+	}
+
+	t.Run("Add Remove Contains Len", func(t *testing.T) {
+		t.Parallel()
+		s := NewIDSet[UserID]()
+		if s.Len() != 0 || s.Contains(a) {
+			t.Fatalf("NewIDSet() = %v, want empty", s)
+		}
+
+		s.Add(a)
+		s.Add(a)
+		s.Add(b)
+		if s.Len() != 2 {
+			t.Errorf("Len() = %d, want 2", s.Len())
+		}
+		if !s.Contains(a) || !s.Contains(b) || s.Contains(c) {
+			t.Errorf("Contains() mismatch for %v", sorted(s))
+		}
+
+		s.Remove(a)
+		s.Remove(c)
+		if s.Len() != 1 || s.Contains(a) {
+			t.Errorf("after Remove = %v, want [%s]", sorted(s), b)
+		}
+	})
+
+	t.Run("NewIDSet with IDs", func(t *testing.T) {
+		t.Parallel()
+		s := NewIDSet(a, b, a)
+		if got, want := sorted(s), strs(a, b); !slices.Equal(got, want) {
+			t.Errorf("NewIDSet(a, b, a) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("set operations", func(t *testing.T) {
+		t.Parallel()
+		left := NewIDSet(a, b, c)
+		right := NewIDSet(b, c, d)
+		empty := NewIDSet[UserID]()
+
+		tests := []struct {
+			name string
+			got  IDSet[UserID]
+			want []string
+		}{
+			{"Union", left.Union(right), strs(a, b, c, d)},
+			{"Intersection", left.Intersection(right), strs(b, c)},
+			{"Intersection commutative", right.Intersection(left), strs(b, c)},
+			{"Difference", left.Difference(right), strs(a)},
+			{"Difference reversed", right.Difference(left), strs(d)},
+			{"Union with empty", left.Union(empty), strs(a, b, c)},
+			{"Intersection with empty", left.Intersection(empty), strs()},
+			{"Difference with empty", left.Difference(empty), strs(a, b, c)},
+			{"Union with nil", left.Union(nil), strs(a, b, c)},
+			{"Intersection with nil", left.Intersection(nil), strs()},
+		}
+		for _, tt := range tests {
+			if got := sorted(tt.got); !slices.Equal(got, tt.want) {
+				t.Errorf("%s = %v, want %v", tt.name, got, tt.want)
+			}
+		}
+
+		if left.Len() != 3 || right.Len() != 3 {
+			t.Error("set operations modified their operands")
+		}
+	})
+
+	t.Run("operations return new sets", func(t *testing.T) {
+		t.Parallel()
+		s := NewIDSet(a)
+		u := s.Union(NewIDSet[UserID]())
+		u.Add(b)
+		if s.Contains(b) {
+			t.Error("Union() result shares storage with receiver")
+		}
+	})
+}
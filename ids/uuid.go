@@ -5,25 +5,67 @@ package ids
 
 import (
 	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // Required for UUID v5.
 	"database/sql/driver"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
+	"slices"
+	"strings"
 )
 
 // UUID represents a universally unique identifier (UUID v4).
 // This is an internal type used as the foundation for all typed IDs.
 type UUID [16]byte
 
+const (
+	// uuidStringLen is the length of the hyphenated string form of a UUID.
+	uuidStringLen = 36
+
+	// base62Len is the fixed length of a base62-encoded UUID.
+	// 62^22 is the smallest power of 62 that exceeds 2^128.
+	base62Len = 22
+
+	// base62Alphabet is the digit set used for base62 encoding.
+	base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
 var (
 	// ErrInvalidUUID is returned when parsing an invalid UUID string.
 	ErrInvalidUUID = errors.New("invalid UUID format")
 
+	// ErrNonCanonicalUUID is returned by ScanStrict when a UUID is not an
+	// RFC 4122 version 4 or version 7 UUID.
+	ErrNonCanonicalUUID = errors.New("non-canonical UUID: expected RFC 4122 version 4 or 7")
+
+	// ErrInvalidBase62 is returned when parsing an invalid base62 UUID string.
+	ErrInvalidBase62 = errors.New("invalid base62 UUID")
+
+	// ErrInvalidAlphabet is returned by Shorten when the alphabet has fewer
+	// than two characters or contains duplicates.
+	ErrInvalidAlphabet = errors.New("invalid alphabet: need at least 2 unique characters")
+
+	// ErrInvalidID is returned when a typed ID cannot be decoded from JSON.
+	// Errors matching ErrInvalidID also match ErrInvalidUUID.
+	ErrInvalidID = errors.New("invalid ID")
+
 	// zeroUUID represents the zero value UUID.
 	zeroUUID UUID
 )
 
+// Well-known namespaces for NamespaceUUID, as defined in RFC 4122 Appendix C.
+var (
+	// NamespaceDNS is the namespace for fully-qualified domain names.
+	NamespaceDNS = MustParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	// NamespaceURL is the namespace for URLs.
+	NamespaceURL = MustParseUUID("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+
+	// NamespaceOID is the namespace for ISO object identifiers.
+	NamespaceOID = MustParseUUID("6ba7b812-9dad-11d1-80b4-00c04fd430c8")
+)
+
 // NewUUID generates a new random UUID v4.
 func NewUUID() (UUID, error) {
 	var uuid UUID
@@ -48,6 +90,24 @@ func MustNewUUID() UUID {
 	return uuid
 }
 
+// NamespaceUUID generates a deterministic UUID v5 (SHA-1 name-based) from a
+// namespace and a name. The same namespace and name always produce the same
+// UUID, which makes it suitable for mapping natural keys such as external
+// references to IDs. Note that v5 UUIDs are rejected by ScanStrict.
+func NamespaceUUID(namespace UUID, name string) UUID {
+	h := sha1.New() //nolint:gosec // SHA-1 is mandated by RFC 4122 for v5 UUIDs.
+	h.Write(namespace[:])
+	h.Write([]byte(name))
+
+	var uuid UUID
+	copy(uuid[:], h.Sum(nil))
+
+	uuid[6] = (uuid[6] & 0x0f) | 0x50 // Version 5
+	uuid[8] = (uuid[8] & 0x3f) | 0x80 // Variant RFC 4122
+
+	return uuid
+}
+
 // ParseUUID parses a UUID from its string representation.
 // Accepts formats: xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx or xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx.
 func ParseUUID(s string) (UUID, error) {
@@ -85,20 +145,120 @@ func MustParseUUID(s string) UUID {
 	return uuid
 }
 
+// ParseBase62UUID parses a UUID from its 22-character base62 representation
+// as produced by EncodeToBase62.
+func ParseBase62UUID(s string) (UUID, error) {
+	if len(s) != base62Len {
+		return UUID{}, ErrInvalidBase62
+	}
+
+	var uuid UUID
+	for i := 0; i < len(s); i++ {
+		digit := strings.IndexByte(base62Alphabet, s[i])
+		if digit < 0 {
+			return UUID{}, ErrInvalidBase62
+		}
+		// uuid = uuid*62 + digit, processed from the least significant byte.
+		carry := uint(digit)
+		for j := len(uuid) - 1; j >= 0; j-- {
+			acc := uint(uuid[j])*62 + carry
+			uuid[j] = byte(acc)
+			carry = acc >> 8
+		}
+		if carry != 0 {
+			return UUID{}, ErrInvalidBase62
+		}
+	}
+
+	return uuid, nil
+}
+
 // String returns the string representation of the UUID.
 // Format: xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx.
+// The only allocation is the returned string itself; use AppendText to
+// format into a caller-owned buffer, or Cache to format once and reuse the
+// string, without allocating.
 func (u UUID) String() string {
-	buf := make([]byte, 36)
-	hex.Encode(buf[0:8], u[0:4])
-	buf[8] = '-'
-	hex.Encode(buf[9:13], u[4:6])
-	buf[13] = '-'
-	hex.Encode(buf[14:18], u[6:8])
-	buf[18] = '-'
-	hex.Encode(buf[19:23], u[8:10])
-	buf[23] = '-'
-	hex.Encode(buf[24:36], u[10:16])
-	return string(buf)
+	var buf [uuidStringLen]byte
+	return string(u.appendHex(buf[:0]))
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the canonical string form of the UUID to b and does not
+// allocate when b has at least 36 bytes of spare capacity.
+func (u UUID) AppendText(b []byte) ([]byte, error) {
+	return u.appendHex(b), nil
+}
+
+// EncodeToBase62 returns the UUID as a fixed-length 22-character base62
+// string (0-9A-Za-z), suitable for short share links.
+// The encoding is zero-padded, so the zero UUID encodes as 22 zeros.
+func (u UUID) EncodeToBase62() string {
+	var buf [base62Len]byte
+	num := u
+	for i := base62Len - 1; i >= 0; i-- {
+		// num, rem = num/62, num%62, processed from the most significant byte.
+		var rem uint
+		for j := range num {
+			acc := rem<<8 | uint(num[j])
+			num[j] = byte(acc / 62)
+			rem = acc % 62
+		}
+		buf[i] = base62Alphabet[rem]
+	}
+	return string(buf[:])
+}
+
+// Shorten encodes the UUID as a base-N number using the characters of
+// alphabet as digits, most significant first, left-padded with the first
+// alphabet character to at least minLength characters.
+// The result is not truncated: it always encodes the full 128 bits, so
+// short codes need a large alphabet or a longer length.
+// Returns ErrInvalidAlphabet for alphabets shorter than 2 characters or with
+// duplicate characters.
+func (u UUID) Shorten(alphabet string, minLength int) (string, error) {
+	digits := []rune(alphabet)
+	if len(digits) < 2 {
+		return "", ErrInvalidAlphabet
+	}
+	seen := make(map[rune]struct{}, len(digits))
+	for _, r := range digits {
+		if _, dup := seen[r]; dup {
+			return "", ErrInvalidAlphabet
+		}
+		seen[r] = struct{}{}
+	}
+
+	base := uint(len(digits))
+	var out []rune // least significant digit first
+	num := u
+	for !num.IsZero() || len(out) == 0 {
+		// num, rem = num/base, num%base, processed from the most significant byte.
+		var rem uint
+		for j := range num {
+			acc := rem<<8 | uint(num[j])
+			num[j] = byte(acc / base)
+			rem = acc % base
+		}
+		out = append(out, digits[rem])
+	}
+	for len(out) < minLength {
+		out = append(out, digits[0])
+	}
+	slices.Reverse(out)
+	return string(out), nil
+}
+
+// appendHex appends the hyphenated hex form of the UUID to dst.
+func (u UUID) appendHex(dst []byte) []byte {
+	const hexDigits = "0123456789abcdef"
+	for i, c := range u {
+		if i == 4 || i == 6 || i == 8 || i == 10 {
+			dst = append(dst, '-')
+		}
+		dst = append(dst, hexDigits[c>>4], hexDigits[c&0x0f])
+	}
+	return dst
 }
 
 // IsZero returns true if the UUID is the zero value.
@@ -106,6 +266,17 @@ func (u UUID) IsZero() bool {
 	return u == zeroUUID
 }
 
+// IsCanonicalV4OrV7 returns true if the UUID has the RFC 4122 variant bits
+// and is version 4 (random) or version 7 (time-ordered).
+// UUIDs generated by this package always satisfy this check.
+func (u UUID) IsCanonicalV4OrV7() bool {
+	if u[8]&0xc0 != 0x80 {
+		return false
+	}
+	version := u[6] >> 4
+	return version == 4 || version == 7
+}
+
 // Bytes returns the raw bytes of the UUID.
 func (u UUID) Bytes() []byte {
 	b := make([]byte, 16)
@@ -113,29 +284,112 @@ func (u UUID) Bytes() []byte {
 	return b
 }
 
+// XOR returns the bitwise XOR of u and other. XOR-ing twice with the same
+// value restores the original, so a secret mask can hide raw IDs in opaque
+// keys. This is obfuscation, not encryption, and the result is generally not
+// a canonical v4 or v7 UUID.
+func (u UUID) XOR(other UUID) UUID {
+	var out UUID
+	for i := range u {
+		out[i] = u[i] ^ other[i]
+	}
+	return out
+}
+
 // MarshalJSON implements json.Marshaler.
 func (u UUID) MarshalJSON() ([]byte, error) {
-	return []byte(`"` + u.String() + `"`), nil
+	buf := make([]byte, 0, uuidStringLen+2)
+	buf = append(buf, '"')
+	buf = u.appendHex(buf)
+	return append(buf, '"'), nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (u *UUID) UnmarshalJSON(data []byte) error {
+	return u.unmarshalJSON("UUID", data)
+}
+
+// maxErrorTokenLen is the maximum number of bytes of an offending JSON token
+// included in an error message.
+const maxErrorTokenLen = 40
+
+// idDecodeError describes a JSON value that could not be decoded into a typed ID.
+// It matches both ErrInvalidID and ErrInvalidUUID with errors.Is.
+type idDecodeError struct {
+	typeName string
+	reason   string
+}
+
+// Error implements the error interface.
+func (e *idDecodeError) Error() string {
+	return "invalid " + e.typeName + ": " + e.reason
+}
+
+// Is reports whether target is ErrInvalidID.
+func (e *idDecodeError) Is(target error) bool {
+	return target == ErrInvalidID
+}
+
+// Unwrap returns ErrInvalidUUID so existing errors.Is checks keep working.
+func (e *idDecodeError) Unwrap() error {
+	return ErrInvalidUUID
+}
+
+// unmarshalJSON decodes a JSON string into u, reporting failures in terms of typeName.
+func (u *UUID) unmarshalJSON(typeName string, data []byte) error {
 	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
-		return ErrInvalidUUID
+		return &idDecodeError{
+			typeName: typeName,
+			reason:   fmt.Sprintf("expected UUID string, got %s %q", jsonKind(data), truncateToken(data)),
+		}
 	}
 
 	parsed, err := ParseUUID(string(data[1 : len(data)-1]))
 	if err != nil {
-		return err
+		return &idDecodeError{
+			typeName: typeName,
+			reason:   fmt.Sprintf("malformed UUID string %s", truncateToken(data)),
+		}
 	}
 
 	*u = parsed
 	return nil
 }
 
+// jsonKind returns a human-readable name for the kind of JSON value in data.
+func jsonKind(data []byte) string {
+	if len(data) == 0 {
+		return "empty input"
+	}
+	switch c := data[0]; {
+	case c == '"':
+		return "string"
+	case c == '{':
+		return "object"
+	case c == '[':
+		return "array"
+	case c == 't' || c == 'f':
+		return "boolean"
+	case c == 'n':
+		return "null"
+	case c == '-' || (c >= '0' && c <= '9'):
+		return "number"
+	default:
+		return "token"
+	}
+}
+
+// truncateToken returns data as a string, shortened to maxErrorTokenLen bytes.
+func truncateToken(data []byte) string {
+	if len(data) <= maxErrorTokenLen {
+		return string(data)
+	}
+	return string(data[:maxErrorTokenLen]) + "..."
+}
+
 // MarshalText implements encoding.TextMarshaler.
 func (u UUID) MarshalText() ([]byte, error) {
-	return []byte(u.String()), nil
+	return u.appendHex(make([]byte, 0, uuidStringLen)), nil
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -179,3 +433,18 @@ func (u *UUID) Scan(src any) error {
 	}
 	return nil
 }
+
+// ScanStrict behaves like Scan but additionally rejects non-zero UUIDs that
+// are not canonical RFC 4122 version 4 or 7 values, returning ErrNonCanonicalUUID.
+// Scan itself remains permissive and accepts any 16-byte pattern.
+func (u *UUID) ScanStrict(src any) error {
+	var scanned UUID
+	if err := scanned.Scan(src); err != nil {
+		return err
+	}
+	if !scanned.IsZero() && !scanned.IsCanonicalV4OrV7() {
+		return fmt.Errorf("%w: %s", ErrNonCanonicalUUID, scanned.String())
+	}
+	*u = scanned
+	return nil
+}
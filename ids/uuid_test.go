@@ -2,6 +2,8 @@ package ids
 
 import (
 	"encoding/json"
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -149,6 +151,55 @@ func TestParseUUID(t *testing.T) {
 	}
 }
 
+func TestNamespaceUUID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		namespace UUID
+		input     string
+		want      string
+	}{
+		{"DNS namespace", NamespaceDNS, "python.org", "886313e1-3b8a-5372-9b90-0c9aee199e5d"},
+		{"URL namespace", NamespaceURL, "https://txova.co.mz/rides/EXT-123", "54cbe27f-95b3-5692-a3d9-d3d874a77a64"},
+		{"OID namespace", NamespaceOID, "1.3.6.1", "1447fa61-5277-5fef-a9b3-fbc6e44f4af3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NamespaceUUID(tt.namespace, tt.input)
+			if got.String() != tt.want {
+				t.Errorf("NamespaceUUID() = %s, want %s", got, tt.want)
+			}
+			if again := NamespaceUUID(tt.namespace, tt.input); again != got {
+				t.Errorf("NamespaceUUID() is not deterministic: %s != %s", again, got)
+			}
+			if got[6]>>4 != 5 {
+				t.Errorf("version = %d, want 5", got[6]>>4)
+			}
+			if got[8]&0xc0 != 0x80 {
+				t.Errorf("variant bits = %#x, want 0x80", got[8]&0xc0)
+			}
+		})
+	}
+
+	t.Run("different inputs produce different UUIDs", func(t *testing.T) {
+		t.Parallel()
+		seen := make(map[UUID]string)
+		for _, ns := range []UUID{NamespaceDNS, NamespaceURL, NamespaceOID} {
+			for _, name := range []string{"", "EXT-1", "EXT-2", "ext-1"} {
+				id := NamespaceUUID(ns, name)
+				key := ns.String() + "/" + name
+				if prev, dup := seen[id]; dup {
+					t.Errorf("NamespaceUUID collision for %s and %s", prev, key)
+				}
+				seen[id] = key
+			}
+		}
+	})
+}
+
 func TestMustParseUUID(t *testing.T) {
 	t.Parallel()
 
@@ -207,6 +258,206 @@ func TestUUID_String(t *testing.T) {
 	}
 }
 
+func TestUUID_AppendText(t *testing.T) {
+	t.Parallel()
+
+	uuid := MustParseUUID("550e8400-e29b-41d4-a716-446655440000")
+
+	got, err := uuid.AppendText([]byte("ride:"))
+	if err != nil {
+		t.Fatalf("AppendText() error = %v", err)
+	}
+	if want := "ride:550e8400-e29b-41d4-a716-446655440000"; string(got) != want {
+		t.Errorf("AppendText() = %s, want %s", got, want)
+	}
+}
+
+// Package-level sinks make formatted results escape, as they do in real
+// callers, so the compiler cannot keep them on the stack.
+var (
+	sinkString string
+	sinkBytes  []byte
+)
+
+func TestUUID_FormattingAllocs(t *testing.T) {
+	uuid := MustParseUUID("550e8400-e29b-41d4-a716-446655440000")
+	cached := Cache(MustParseRideID(uuid.String()))
+	buf := make([]byte, 0, 64)
+
+	// Each result that is returned to the caller (a string or slice of
+	// formatted bytes) costs exactly one allocation; nothing else may allocate.
+	// Repeated String calls on the same ID allocate nothing via CachedID.
+	tests := []struct {
+		name string
+		want float64
+		fn   func()
+	}{
+		{"AppendText", 0, func() { buf, _ = uuid.AppendText(buf[:0]) }},
+		{"String", 1, func() { sinkString = uuid.String() }},
+		{"MarshalText", 1, func() { sinkBytes, _ = uuid.MarshalText() }},
+		{"MarshalJSON", 1, func() { sinkBytes, _ = uuid.MarshalJSON() }},
+		{"CachedID.String", 0, func() { sinkString = cached.String() }},
+		{"CachedID.AppendText", 0, func() { buf, _ = cached.AppendText(buf[:0]) }},
+		{"CachedID.MarshalText", 1, func() { sinkBytes, _ = cached.MarshalText() }},
+		{"CachedID.MarshalJSON", 1, func() { sinkBytes, _ = cached.MarshalJSON() }},
+	}
+
+	for _, tt := range tests {
+		if got := testing.AllocsPerRun(100, tt.fn); got != tt.want {
+			t.Errorf("%s allocs = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkUUIDString(b *testing.B) {
+	uuid := MustNewUUID()
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = uuid.String()
+	}
+}
+
+func BenchmarkUUIDAppendText(b *testing.B) {
+	uuid := MustNewUUID()
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for b.Loop() {
+		buf, _ = uuid.AppendText(buf[:0])
+	}
+}
+
+func BenchmarkUUIDMarshalJSON(b *testing.B) {
+	uuid := MustNewUUID()
+	b.ReportAllocs()
+	for b.Loop() {
+		_, _ = uuid.MarshalJSON()
+	}
+}
+
+func TestUUID_Base62(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		uuid string
+		want string
+	}{
+		{"zero UUID", "00000000-0000-0000-0000-000000000000", "0000000000000000000000"},
+		{"well-known UUID", "550e8400-e29b-41d4-a716-446655440000", "2aUyqjCzEIiEcYMKj7TZtw"},
+		{"max UUID", "ffffffff-ffff-ffff-ffff-ffffffffffff", "7n42DGM5Tflk9n8mt7Fhc7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			uuid := MustParseUUID(tt.uuid)
+			got := uuid.EncodeToBase62()
+			if got != tt.want {
+				t.Errorf("EncodeToBase62() = %s, want %s", got, tt.want)
+			}
+			parsed, err := ParseBase62UUID(got)
+			if err != nil {
+				t.Fatalf("ParseBase62UUID(%q) error = %v", got, err)
+			}
+			if parsed != uuid {
+				t.Errorf("ParseBase62UUID(%q) = %s, want %s", got, parsed, uuid)
+			}
+		})
+	}
+
+	t.Run("random roundtrip", func(t *testing.T) {
+		t.Parallel()
+		for range 1000 {
+			uuid := MustNewUUID()
+			encoded := uuid.EncodeToBase62()
+			if len(encoded) != 22 {
+				t.Fatalf("EncodeToBase62() length = %d, want 22", len(encoded))
+			}
+			parsed, err := ParseBase62UUID(encoded)
+			if err != nil {
+				t.Fatalf("ParseBase62UUID(%q) error = %v", encoded, err)
+			}
+			if parsed != uuid {
+				t.Fatalf("roundtrip = %s, want %s", parsed, uuid)
+			}
+		}
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		t.Parallel()
+		invalid := []struct {
+			name  string
+			input string
+		}{
+			{"empty", ""},
+			{"too short", "2aUyqjCzEIiEcYMKj7TZt"},
+			{"too long", "2aUyqjCzEIiEcYMKj7TZtww"},
+			{"invalid character", "2aUyqjCzEIiEcYMKj7TZt-"},
+			{"hex UUID", "550e8400-e29b-41d4-a716-446655440000"},
+			{"overflow", "zzzzzzzzzzzzzzzzzzzzzz"},
+			{"just over max", "7n42DGM5Tflk9n8mt7Fhc8"},
+		}
+		for _, tt := range invalid {
+			if _, err := ParseBase62UUID(tt.input); !errors.Is(err, ErrInvalidBase62) {
+				t.Errorf("ParseBase62UUID(%q) [%s] error = %v, want ErrInvalidBase62", tt.input, tt.name, err)
+			}
+		}
+	})
+}
+
+func TestUUID_Shorten(t *testing.T) {
+	t.Parallel()
+
+	const (
+		base62    = base62Alphabet
+		uppercase = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+		numeric   = "0123456789"
+	)
+
+	tests := []struct {
+		name      string
+		uuid      string
+		alphabet  string
+		minLength int
+		want      string
+	}{
+		{"base62 matches EncodeToBase62", "550e8400-e29b-41d4-a716-446655440000", base62, 22, "2aUyqjCzEIiEcYMKj7TZtw"},
+		{"base62 unpadded", "00000000-0000-0000-0000-00000000003e", base62, 0, "10"},
+		{"base62 padded", "00000000-0000-0000-0000-00000000003e", base62, 8, "00000010"},
+		{"uppercase", "00000000-0000-0000-0000-00000000001b", uppercase, 0, "BB"},
+		{"uppercase padded", "00000000-0000-0000-0000-00000000001b", uppercase, 4, "AABB"},
+		{"numeric", "550e8400-e29b-41d4-a716-446655440000", numeric, 0, "113059749145936325402354257176981405696"},
+		{"numeric max UUID", "ffffffff-ffff-ffff-ffff-ffffffffffff", numeric, 0, "340282366920938463463374607431768211455"},
+		{"zero UUID", "00000000-0000-0000-0000-000000000000", numeric, 0, "0"},
+		{"zero UUID padded", "00000000-0000-0000-0000-000000000000", uppercase, 3, "AAA"},
+		{"minLength shorter than encoding", "ffffffff-ffff-ffff-ffff-ffffffffffff", base62, 8, "7n42DGM5Tflk9n8mt7Fhc7"},
+		{"multi-byte alphabet", "00000000-0000-0000-0000-000000000002", "αβ", 0, "βα"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := MustParseUUID(tt.uuid).Shorten(tt.alphabet, tt.minLength)
+			if err != nil {
+				t.Fatalf("Shorten() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Shorten(%q, %d) = %q, want %q", tt.alphabet, tt.minLength, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("invalid alphabet", func(t *testing.T) {
+		t.Parallel()
+		uuid := MustParseUUID("550e8400-e29b-41d4-a716-446655440000")
+		for _, alphabet := range []string{"", "a", "abca", "00"} {
+			if _, err := uuid.Shorten(alphabet, 0); !errors.Is(err, ErrInvalidAlphabet) {
+				t.Errorf("Shorten(%q) error = %v, want %v", alphabet, err, ErrInvalidAlphabet)
+			}
+		}
+	})
+}
+
 func TestUUID_IsZero(t *testing.T) {
 	t.Parallel()
 
@@ -304,6 +555,41 @@ func TestUUID_JSON(t *testing.T) {
 		}
 	})
 
+	t.Run("unmarshal error describes offending token", func(t *testing.T) {
+		t.Parallel()
+		tests := []struct {
+			name string
+			data string
+			want string
+		}{
+			{"number", `123`, `invalid UUID: expected UUID string, got number "123"`},
+			{"negative number", `-1.5`, `invalid UUID: expected UUID string, got number "-1.5"`},
+			{"boolean", `true`, `invalid UUID: expected UUID string, got boolean "true"`},
+			{"null", `null`, `invalid UUID: expected UUID string, got null "null"`},
+			{"object", `{"id":1}`, `invalid UUID: expected UUID string, got object "{\"id\":1}"`},
+			{"array", `[1]`, `invalid UUID: expected UUID string, got array "[1]"`},
+			{"malformed string", `"abc"`, `invalid UUID: malformed UUID string "abc"`},
+			{
+				"long token truncated",
+				`"` + strings.Repeat("a", 60) + `"`,
+				`invalid UUID: malformed UUID string "` + strings.Repeat("a", 39) + `...`,
+			},
+		}
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+				var uuid UUID
+				err := uuid.UnmarshalJSON([]byte(tc.data))
+				if !errors.Is(err, ErrInvalidID) || !errors.Is(err, ErrInvalidUUID) {
+					t.Fatalf("UnmarshalJSON(%s) error = %v, want ErrInvalidID and ErrInvalidUUID", tc.data, err)
+				}
+				if err.Error() != tc.want {
+					t.Errorf("UnmarshalJSON(%s) error = %q, want %q", tc.data, err.Error(), tc.want)
+				}
+			})
+		}
+	})
+
 	t.Run("JSON round-trip", func(t *testing.T) {
 		t.Parallel()
 		original := MustNewUUID()
@@ -459,3 +745,172 @@ func TestUUID_SQL(t *testing.T) {
 		}
 	})
 }
+
+func TestUUID_XOR(t *testing.T) {
+	t.Parallel()
+
+	fixed := []UUID{
+		{},
+		MustParseUUID("550e8400-e29b-41d4-a716-446655440000"),
+		MustParseUUID("ffffffff-ffff-ffff-ffff-ffffffffffff"),
+	}
+	for range 50 {
+		fixed = append(fixed, MustNewUUID())
+	}
+
+	t.Run("involution", func(t *testing.T) {
+		for _, a := range fixed {
+			for _, b := range fixed {
+				if got := a.XOR(b).XOR(b); got != a {
+					t.Fatalf("(%s XOR %s) XOR %s = %s, want %s", a, b, b, got, a)
+				}
+				if a.XOR(b) != b.XOR(a) {
+					t.Fatalf("%s XOR %s is not commutative", a, b)
+				}
+			}
+		}
+	})
+
+	t.Run("self is zero", func(t *testing.T) {
+		for _, a := range fixed {
+			if got := a.XOR(a); !got.IsZero() {
+				t.Errorf("%s XOR itself = %s, want zero", a, got)
+			}
+		}
+	})
+
+	t.Run("zero is identity", func(t *testing.T) {
+		for _, a := range fixed {
+			if got := a.XOR(UUID{}); got != a {
+				t.Errorf("%s XOR zero = %s, want %s", a, got, a)
+			}
+		}
+	})
+
+	t.Run("known value", func(t *testing.T) {
+		a := MustParseUUID("550e8400-e29b-41d4-a716-446655440000")
+		b := MustParseUUID("ffffffff-ffff-ffff-ffff-ffffffffffff")
+		want := "aaf17bff-1d64-be2b-58e9-bb99aabbffff"
+		if got := a.XOR(b).String(); got != want {
+			t.Errorf("XOR() = %s, want %s", got, want)
+		}
+	})
+}
+
+func TestUUID_IsCanonicalV4OrV7(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		uuid string
+		want bool
+	}{
+		{"v4 RFC 4122", "550e8400-e29b-41d4-a716-446655440000", true},
+		{"v7 RFC 4122", "01890a5d-ac96-774b-bcce-b302099a8057", true},
+		{"v1 RFC 4122", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", false},
+		{"v4 Microsoft variant", "550e8400-e29b-41d4-c716-446655440000", false},
+		{"v4 NCS variant", "550e8400-e29b-41d4-0716-446655440000", false},
+		{"zero", "00000000-0000-0000-0000-000000000000", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := MustParseUUID(tt.uuid).IsCanonicalV4OrV7(); got != tt.want {
+				t.Errorf("IsCanonicalV4OrV7() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("generated UUIDs are canonical", func(t *testing.T) {
+		t.Parallel()
+		for range 100 {
+			if u := MustNewUUID(); !u.IsCanonicalV4OrV7() {
+				t.Fatalf("NewUUID() = %s is not canonical", u)
+			}
+		}
+	})
+}
+
+func TestUUID_ScanStrict(t *testing.T) {
+	t.Parallel()
+
+	// Microsoft (GUID) variant: byte 8 has the 110x variant bits.
+	microsoft := MustParseUUID("550e8400-e29b-41d4-c716-446655440000")
+
+	t.Run("Scan accepts non-canonical binary", func(t *testing.T) {
+		t.Parallel()
+		var uuid UUID
+		if err := uuid.Scan(microsoft.Bytes()); err != nil {
+			t.Fatalf("Scan() error = %v", err)
+		}
+		if uuid != microsoft {
+			t.Errorf("Scan() = %s, want %s", uuid, microsoft)
+		}
+	})
+
+	t.Run("ScanStrict rejects Microsoft variant binary", func(t *testing.T) {
+		t.Parallel()
+		uuid := MustNewUUID()
+		original := uuid
+		err := uuid.ScanStrict(microsoft.Bytes())
+		if !errors.Is(err, ErrNonCanonicalUUID) {
+			t.Fatalf("ScanStrict() error = %v, want ErrNonCanonicalUUID", err)
+		}
+		if uuid != original {
+			t.Error("ScanStrict() modified destination on error")
+		}
+	})
+
+	t.Run("ScanStrict rejects non-canonical string", func(t *testing.T) {
+		t.Parallel()
+		var uuid UUID
+		err := uuid.ScanStrict(microsoft.String())
+		if !errors.Is(err, ErrNonCanonicalUUID) {
+			t.Errorf("ScanStrict() error = %v, want ErrNonCanonicalUUID", err)
+		}
+	})
+
+	t.Run("ScanStrict accepts canonical binary", func(t *testing.T) {
+		t.Parallel()
+		want := MustNewUUID()
+		var uuid UUID
+		if err := uuid.ScanStrict(want.Bytes()); err != nil {
+			t.Fatalf("ScanStrict() error = %v", err)
+		}
+		if uuid != want {
+			t.Errorf("ScanStrict() = %s, want %s", uuid, want)
+		}
+	})
+
+	t.Run("ScanStrict accepts nil", func(t *testing.T) {
+		t.Parallel()
+		uuid := MustNewUUID()
+		if err := uuid.ScanStrict(nil); err != nil {
+			t.Fatalf("ScanStrict(nil) error = %v", err)
+		}
+		if !uuid.IsZero() {
+			t.Error("ScanStrict(nil) should result in zero UUID")
+		}
+	})
+
+	t.Run("ScanStrict propagates scan errors", func(t *testing.T) {
+		t.Parallel()
+		var uuid UUID
+		if err := uuid.ScanStrict(123); err == nil {
+			t.Error("ScanStrict(int) should return error")
+		}
+	})
+
+	t.Run("typed IDs", func(t *testing.T) {
+		t.Parallel()
+		var rideID RideID
+		if err := rideID.ScanStrict(microsoft.Bytes()); !errors.Is(err, ErrNonCanonicalUUID) {
+			t.Errorf("RideID.ScanStrict() error = %v, want ErrNonCanonicalUUID", err)
+		}
+		var userID UserID
+		if err := userID.ScanStrict("550e8400-e29b-41d4-a716-446655440000"); err != nil {
+			t.Errorf("UserID.ScanStrict() error = %v", err)
+		}
+	})
+}
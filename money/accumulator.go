@@ -0,0 +1,39 @@
+package money
+
+// Accumulator builds a running total in place, for summing large slices of
+// amounts without creating a Money value per step. The zero value is an
+// empty total ready to use:
+//
+//	var acc money.Accumulator
+//	for _, f := range fares {
+//		acc.Add(f.Amount)
+//	}
+//	total := acc.Result()
+//
+// Like Money arithmetic, sums are not checked for overflow.
+type Accumulator struct {
+	centavos int64
+}
+
+// Add adds m to the running total and returns the accumulator for chaining.
+func (a *Accumulator) Add(m Money) *Accumulator {
+	a.centavos += m.centavos
+	return a
+}
+
+// Subtract subtracts m from the running total and returns the accumulator
+// for chaining.
+func (a *Accumulator) Subtract(m Money) *Accumulator {
+	a.centavos -= m.centavos
+	return a
+}
+
+// Result returns the running total as Money.
+func (a *Accumulator) Result() Money {
+	return Money{centavos: a.centavos}
+}
+
+// Reset sets the running total back to zero.
+func (a *Accumulator) Reset() {
+	a.centavos = 0
+}
@@ -0,0 +1,22 @@
+package money
+
+import "github.com/Dorico-Dynamics/txova-go-types/enums"
+
+// CurrencyCode returns the ISO 4217 code of the amount's currency.
+// Money is always denominated in Mozambican metical, so this is "MZN".
+func (m Money) CurrencyCode() string {
+	return enums.CurrencyMZN.ISOCode()
+}
+
+// CurrencySymbol returns the display symbol of the amount's currency, "MT".
+func (m Money) CurrencySymbol() string {
+	return enums.CurrencyMZN.Symbol()
+}
+
+// Pair is an amount with an explicit currency, for values that may be
+// denominated in currencies other than MZN. Amount holds minor units
+// (cents or centavos) of Currency.
+type Pair struct {
+	Amount   Money          `json:"amount"`
+	Currency enums.Currency `json:"currency"`
+}
@@ -4,8 +4,12 @@ package money
 
 import (
 	"database/sql/driver"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
+	"math/bits"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -29,6 +33,31 @@ var (
 
 	// ErrInvalidPercentage is returned when percentage is out of valid range.
 	ErrInvalidPercentage = errors.New("percentage must be between 0 and 100")
+
+	// ErrInvalidBinaryLength is returned when decoding binary money data
+	// that is not exactly 8 bytes long.
+	ErrInvalidBinaryLength = errors.New("binary money data must be exactly 8 bytes")
+
+	// ErrNegativeCap is returned when a surcharge cap is negative.
+	ErrNegativeCap = errors.New("surcharge cap cannot be negative")
+
+	// ErrOverflow is returned when a result exceeds the representable range
+	// of centavos.
+	ErrOverflow = errors.New("money amount overflow")
+
+	// ErrInsufficientTender is returned when the amount tendered is less
+	// than the amount due.
+	ErrInsufficientTender = errors.New("tendered amount is less than amount due")
+
+	// ErrInvalidDenomination is returned when a denomination is not positive.
+	ErrInvalidDenomination = errors.New("denomination must be positive")
+
+	// ErrInvalidPeriod is returned when a rate period is not positive.
+	ErrInvalidPeriod = errors.New("period must be positive")
+
+	// ErrCannotMakeChange is returned when the change cannot be made exactly
+	// from the available denominations.
+	ErrCannotMakeChange = errors.New("cannot make exact change")
 )
 
 // Zero returns a Money value representing zero MZN.
@@ -118,6 +147,37 @@ func (m Money) MustPercentage(rate int) Money {
 	return result
 }
 
+// Surcharge calculates a percentage premium on the money amount, limited to
+// maxSurcharge. It is intended for surge pricing, where the final price is
+// m.Add(surcharge). Percent must be between 0 and 100 and the cap must not
+// be negative.
+func (m Money) Surcharge(percent int, maxSurcharge Money) (Money, error) {
+	if maxSurcharge.IsNegative() {
+		return Zero(), ErrNegativeCap
+	}
+	surcharge, err := m.Percentage(percent)
+	if err != nil {
+		return Zero(), err
+	}
+	if surcharge.GreaterThan(maxSurcharge) {
+		return maxSurcharge, nil
+	}
+	return surcharge, nil
+}
+
+// GrossFromNet treats m as a net (tax-exclusive) price and returns the gross
+// (tax-inclusive) price along with the tax component, using taxRate as a
+// whole percentage between 0 and 100 (e.g. 17 for 17% VAT).
+// The tax is rounded to the nearest centavo like Percentage, and
+// gross.Subtract(tax) always equals m exactly.
+func (m Money) GrossFromNet(taxRate int) (gross, tax Money, err error) {
+	tax, err = m.Percentage(taxRate)
+	if err != nil {
+		return Zero(), Zero(), err
+	}
+	return m.Add(tax), tax, nil
+}
+
 // Split divides the money amount into n equal parts.
 // Returns a slice of Money values. Any remainder centavos are distributed
 // to the first parts (one extra centavo each for positive amounts, or one
@@ -150,6 +210,88 @@ func (m Money) Split(n int) ([]Money, error) {
 	return parts, nil
 }
 
+// PerDay returns the daily rate for m spread over the given number of days,
+// rounded to the nearest centavo (away from zero). Multiplying the rate back
+// with DailyTotal may differ from m by the rounding.
+// Returns ErrInvalidPeriod if days is not positive.
+func (m Money) PerDay(days int) (Money, error) {
+	return m.perUnit(days)
+}
+
+// PerHour returns the hourly rate for m spread over the given number of
+// hours, rounded to the nearest centavo (away from zero).
+// Returns ErrInvalidPeriod if hours is not positive.
+func (m Money) PerHour(hours int) (Money, error) {
+	return m.perUnit(hours)
+}
+
+// perUnit divides m by units with integer arithmetic, rounding half away from zero.
+func (m Money) perUnit(units int) (Money, error) {
+	if units <= 0 {
+		return Zero(), ErrInvalidPeriod
+	}
+	n := int64(units)
+	result := m.centavos / n
+	remainder := m.centavos % n
+	if remainder*2 >= n {
+		result++
+	} else if remainder*2 <= -n {
+		result--
+	}
+	return Money{centavos: result}, nil
+}
+
+// DailyTotal returns the total cost of dailyRate over the given number of days.
+func DailyTotal(dailyRate Money, days int) Money {
+	return dailyRate.MultiplyInt(days)
+}
+
+// HourlyTotal returns the total cost of hourlyRate over the given number of hours.
+func HourlyTotal(hourlyRate Money, hours int) Money {
+	return hourlyRate.MultiplyInt(hours)
+}
+
+// BreakChange decomposes the change owed when tendered is paid against the
+// amount due m into notes and coins. Denominations are used greedily from
+// largest to smallest, which yields the fewest pieces for canonical currency
+// systems such as MZN notes and coins. The result maps each denomination in
+// centavos to the number of pieces; exact payment returns an empty map.
+// Returns ErrInvalidAmount if m is negative, ErrInsufficientTender if
+// tendered is less than m, ErrInvalidDenomination for zero or negative denominations, and
+// ErrCannotMakeChange if the change cannot be made exactly.
+func (m Money) BreakChange(tendered Money, denominations []Money) (map[int64]int, error) {
+	if m.centavos < 0 {
+		return nil, ErrInvalidAmount
+	}
+	if tendered.centavos < m.centavos {
+		return nil, ErrInsufficientTender
+	}
+
+	denoms := make([]int64, 0, len(denominations))
+	for _, d := range denominations {
+		if d.centavos <= 0 {
+			return nil, ErrInvalidDenomination
+		}
+		denoms = append(denoms, d.centavos)
+	}
+	slices.Sort(denoms)
+	denoms = slices.Compact(denoms)
+	slices.Reverse(denoms)
+
+	remaining := tendered.centavos - m.centavos
+	change := make(map[int64]int)
+	for _, d := range denoms {
+		if n := remaining / d; n > 0 {
+			change[d] = int(n) //nolint:gosec // Piece counts fit in int on 64-bit platforms.
+			remaining -= n * d
+		}
+	}
+	if remaining != 0 {
+		return nil, ErrCannotMakeChange
+	}
+	return change, nil
+}
+
 // Equals returns true if m equals other.
 func (m Money) Equals(other Money) bool {
 	return m.centavos == other.centavos
@@ -175,6 +317,30 @@ func (m Money) LessThanOrEqual(other Money) bool {
 	return m.centavos <= other.centavos
 }
 
+// Between returns true if m is within the inclusive range [min, max].
+// It panics if min is greater than max.
+func (m Money) Between(minAmount, maxAmount Money) bool {
+	if minAmount.centavos > maxAmount.centavos {
+		panic(fmt.Sprintf("money: Between called with min %s greater than max %s", minAmount, maxAmount))
+	}
+	return m.centavos >= minAmount.centavos && m.centavos <= maxAmount.centavos
+}
+
+// Constrain clamps m to the inclusive range [min, max].
+// Unlike Between it never panics: inverted bounds are swapped.
+func (m Money) Constrain(minAmount, maxAmount Money) Money {
+	if minAmount.centavos > maxAmount.centavos {
+		minAmount, maxAmount = maxAmount, minAmount
+	}
+	if m.centavos < minAmount.centavos {
+		return minAmount
+	}
+	if m.centavos > maxAmount.centavos {
+		return maxAmount
+	}
+	return m
+}
+
 // IsZero returns true if the amount is zero.
 func (m Money) IsZero() bool {
 	return m.centavos == 0
@@ -203,6 +369,46 @@ func (m Money) Negate() Money {
 	return Money{centavos: -m.centavos}
 }
 
+// GCD returns the greatest common divisor of the two amounts in centavos,
+// computed with the Euclidean algorithm on absolute values. GCD of zero with
+// zero is zero. The result is non-negative for every input except when both
+// amounts are math.MinInt64 or zero (not both zero): the true GCD, 2^63, does
+// not fit in an int64 and overflows to math.MinInt64 centavos.
+func (m Money) GCD(other Money) Money {
+	return Money{centavos: int64(gcd(absCentavos(m.centavos), absCentavos(other.centavos)))} //nolint:gosec // 2^63 wraps to math.MinInt64, as documented.
+}
+
+// LCM returns the least common multiple of the two amounts in centavos.
+// The result is never negative, and LCM with zero is zero.
+// Returns ErrOverflow if the result exceeds math.MaxInt64 centavos.
+func (m Money) LCM(other Money) (Money, error) {
+	a, b := absCentavos(m.centavos), absCentavos(other.centavos)
+	if a == 0 || b == 0 {
+		return Zero(), nil
+	}
+	hi, lo := bits.Mul64(a/gcd(a, b), b)
+	if hi != 0 || lo > math.MaxInt64 {
+		return Money{}, ErrOverflow
+	}
+	return Money{centavos: int64(lo)}, nil //nolint:gosec // Bounded by math.MaxInt64 above.
+}
+
+// absCentavos returns |c| as a uint64, which also represents math.MinInt64.
+func absCentavos(c int64) uint64 {
+	if c < 0 {
+		return uint64(-(c + 1)) + 1 //nolint:gosec // -(c+1) is non-negative.
+	}
+	return uint64(c) //nolint:gosec // c is non-negative.
+}
+
+// gcd implements the Euclidean algorithm.
+func gcd(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
 // String returns the string representation in "150.00 MZN" format.
 func (m Money) String() string {
 	sign := ""
@@ -325,6 +531,26 @@ func (m *Money) UnmarshalText(data []byte) error {
 	return nil
 }
 
+// serializedSize is the length of the binary encoding produced by Serialize.
+const serializedSize = 8
+
+// Serialize returns a compact binary encoding of the amount for message
+// queues: the centavos as an 8-byte little-endian int64.
+func (m Money) Serialize() []byte {
+	b := make([]byte, serializedSize)
+	binary.LittleEndian.PutUint64(b, uint64(m.centavos)) //nolint:gosec // Two's complement round-trips via int64.
+	return b
+}
+
+// DeserializeMoney decodes a Money value produced by Serialize.
+// Returns ErrInvalidBinaryLength if b is not exactly 8 bytes.
+func DeserializeMoney(b []byte) (Money, error) {
+	if len(b) != serializedSize {
+		return Zero(), fmt.Errorf("%w: got %d", ErrInvalidBinaryLength, len(b))
+	}
+	return Money{centavos: int64(binary.LittleEndian.Uint64(b))}, nil //nolint:gosec // Inverse of Serialize.
+}
+
 // Value implements driver.Valuer for database storage.
 // Stores as integer centavos.
 func (m Money) Value() (driver.Value, error) {
@@ -1,8 +1,15 @@
 package money
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"maps"
+	"math"
+	"sync"
 	"testing"
+
+	"github.com/Dorico-Dynamics/txova-go-types/enums"
 )
 
 func TestZero(t *testing.T) {
@@ -274,6 +281,111 @@ func TestMoney_MustPercentage(t *testing.T) {
 	})
 }
 
+func TestMoney_Surcharge(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		amount  int64
+		percent int
+		cap     int64
+		want    int64
+		wantErr error
+	}{
+		{"below cap", 10000, 20, 5000, 2000, nil},
+		{"cap applied", 10000, 80, 5000, 5000, nil},
+		{"exactly at cap", 10000, 50, 5000, 5000, nil},
+		{"zero percent", 10000, 0, 5000, 0, nil},
+		{"zero cap", 10000, 50, 0, 0, nil},
+		{"negative percent", 10000, -1, 5000, 0, ErrInvalidPercentage},
+		{"percent over 100", 10000, 101, 5000, 0, ErrInvalidPercentage},
+		{"negative cap", 10000, 20, -1, 0, ErrNegativeCap},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := FromCentavos(tt.amount)
+			result, err := m.Surcharge(tt.percent, FromCentavos(tt.cap))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Surcharge(%d, %d) error = %v, want %v", tt.percent, tt.cap, err, tt.wantErr)
+			}
+			if result.Centavos() != tt.want {
+				t.Errorf("Surcharge(%d, %d) = %d, want %d", tt.percent, tt.cap, result.Centavos(), tt.want)
+			}
+		})
+	}
+
+	t.Run("final price", func(t *testing.T) {
+		t.Parallel()
+		m := FromCentavos(10000)
+		surcharge, err := m.Surcharge(80, FromCentavos(5000))
+		if err != nil {
+			t.Fatalf("Surcharge() error = %v", err)
+		}
+		if got := m.Add(surcharge).Centavos(); got != 15000 {
+			t.Errorf("final price = %d, want 15000", got)
+		}
+	})
+}
+
+func TestMoney_GrossFromNet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		net       int64
+		rate      int
+		wantGross int64
+		wantTax   int64
+		wantErr   error
+	}{
+		{"17% of 100 MZN", 10000, 17, 11700, 1700, nil},
+		{"17% of 1 centavo rounds down", 1, 17, 1, 0, nil},
+		{"17% of 3 centavos rounds up", 3, 17, 4, 1, nil},
+		{"17% of 99.99 MZN", 9999, 17, 11699, 1700, nil},
+		{"17% of 250.50 MZN", 25050, 17, 29309, 4259, nil},
+		{"zero rate", 10000, 0, 10000, 0, nil},
+		{"full rate", 10000, 100, 20000, 10000, nil},
+		{"zero amount", 0, 17, 0, 0, nil},
+		{"negative rate", 10000, -1, 0, 0, ErrInvalidPercentage},
+		{"rate over 100", 10000, 101, 0, 0, ErrInvalidPercentage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			net := FromCentavos(tt.net)
+			gross, tax, err := net.GrossFromNet(tt.rate)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GrossFromNet(%d) error = %v, want %v", tt.rate, err, tt.wantErr)
+			}
+			if gross.Centavos() != tt.wantGross {
+				t.Errorf("GrossFromNet(%d) gross = %d, want %d", tt.rate, gross.Centavos(), tt.wantGross)
+			}
+			if tax.Centavos() != tt.wantTax {
+				t.Errorf("GrossFromNet(%d) tax = %d, want %d", tt.rate, tax.Centavos(), tt.wantTax)
+			}
+		})
+	}
+
+	t.Run("invariant gross minus tax equals net", func(t *testing.T) {
+		t.Parallel()
+		for centavos := int64(-500); centavos <= 5000; centavos += 7 {
+			net := FromCentavos(centavos)
+			for _, rate := range []int{0, 3, 5, 16, 17, 33, 100} {
+				gross, tax, err := net.GrossFromNet(rate)
+				if err != nil {
+					t.Fatalf("GrossFromNet(%d) error = %v", rate, err)
+				}
+				if !gross.Subtract(tax).Equals(net) {
+					t.Fatalf("%d at %d%%: gross %d - tax %d != net", centavos, rate, gross.Centavos(), tax.Centavos())
+				}
+			}
+		}
+	})
+}
+
 func TestMoney_Split(t *testing.T) {
 	t.Parallel()
 
@@ -369,6 +481,209 @@ func TestMoney_Split(t *testing.T) {
 	})
 }
 
+func TestMoney_PerDay(t *testing.T) {
+	tests := []struct {
+		name  string
+		total Money
+		days  int
+		want  Money
+	}{
+		{"1 day", FromMZN(2500), 1, FromMZN(2500)},
+		{"7 days even", FromMZN(7000), 7, FromMZN(1000)},
+		{"7 days rounds down", FromMZN(1000), 7, FromCentavos(14286)},
+		{"30 days even", FromMZN(45000), 30, FromMZN(1500)},
+		{"30 days rounds up", FromCentavos(100015), 30, FromCentavos(3334)},
+		{"30 days rounds half away from zero", FromCentavos(45), 30, FromCentavos(2)},
+		{"negative amount", FromCentavos(-45), 30, FromCentavos(-2)},
+		{"zero", Zero(), 7, Zero()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.total.PerDay(tt.days)
+			if err != nil {
+				t.Fatalf("PerDay() error = %v", err)
+			}
+			if !got.Equals(tt.want) {
+				t.Errorf("PerDay(%d) = %v, want %v", tt.days, got, tt.want)
+			}
+		})
+	}
+
+	for _, days := range []int{0, -1} {
+		if _, err := FromMZN(100).PerDay(days); !errors.Is(err, ErrInvalidPeriod) {
+			t.Errorf("PerDay(%d) error = %v, want ErrInvalidPeriod", days, err)
+		}
+	}
+}
+
+func TestMoney_PerHour(t *testing.T) {
+	got, err := FromMZN(2400).PerHour(8)
+	if err != nil {
+		t.Fatalf("PerHour() error = %v", err)
+	}
+	if !got.Equals(FromMZN(300)) {
+		t.Errorf("PerHour(8) = %v, want 300.00 MZN", got)
+	}
+
+	got, err = FromMZN(1000).PerHour(3)
+	if err != nil {
+		t.Fatalf("PerHour() error = %v", err)
+	}
+	if !got.Equals(FromCentavos(33333)) {
+		t.Errorf("PerHour(3) = %v, want 333.33 MZN", got)
+	}
+
+	if _, err := FromMZN(100).PerHour(0); !errors.Is(err, ErrInvalidPeriod) {
+		t.Errorf("PerHour(0) error = %v, want ErrInvalidPeriod", err)
+	}
+}
+
+func TestRateTotals(t *testing.T) {
+	for _, days := range []int{1, 7, 30} {
+		rate := FromMZN(1500)
+		if got, want := DailyTotal(rate, days), FromMZN(1500*float64(days)); !got.Equals(want) {
+			t.Errorf("DailyTotal(%v, %d) = %v, want %v", rate, days, got, want)
+		}
+
+		total := FromMZN(42000)
+		perDay, err := total.PerDay(days)
+		if err != nil {
+			t.Fatalf("PerDay(%d) error = %v", days, err)
+		}
+		if got := DailyTotal(perDay, days); !got.Equals(total) {
+			t.Errorf("DailyTotal(PerDay(%d)) = %v, want %v", days, got, total)
+		}
+	}
+
+	if got := HourlyTotal(FromMZN(250), 8); !got.Equals(FromMZN(2000)) {
+		t.Errorf("HourlyTotal() = %v, want 2000.00 MZN", got)
+	}
+	if got := DailyTotal(FromMZN(250), 0); !got.IsZero() {
+		t.Errorf("DailyTotal(0 days) = %v, want zero", got)
+	}
+}
+
+func TestMoney_BreakChange(t *testing.T) {
+	// Standard MZN notes and coins, in meticais.
+	var mzn []Money
+	for _, v := range []int64{1000, 500, 200, 100, 50, 20, 10, 5, 2, 1} {
+		mzn = append(mzn, FromCentavos(v*100))
+	}
+
+	tests := []struct {
+		name     string
+		due      Money
+		tendered Money
+		denoms   []Money
+		want     map[int64]int
+		wantErr  error
+	}{
+		{
+			name:     "single note",
+			due:      FromCentavos(30000),
+			tendered: FromCentavos(50000),
+			denoms:   mzn,
+			want:     map[int64]int{20000: 1},
+		},
+		{
+			name:     "mixed notes and coins",
+			due:      FromCentavos(12300),
+			tendered: FromCentavos(100000),
+			denoms:   mzn,
+			want:     map[int64]int{50000: 1, 20000: 1, 10000: 1, 5000: 1, 2000: 1, 500: 1, 200: 1},
+		},
+		{
+			name:     "repeated denomination",
+			due:      FromCentavos(10000),
+			tendered: FromCentavos(50000),
+			denoms:   mzn,
+			want:     map[int64]int{20000: 2},
+		},
+		{
+			name:     "exact payment",
+			due:      FromCentavos(25000),
+			tendered: FromCentavos(25000),
+			denoms:   mzn,
+			want:     map[int64]int{},
+		},
+		{
+			name:     "centavo denominations",
+			due:      FromCentavos(112),
+			tendered: FromCentavos(1000),
+			denoms: []Money{
+				FromCentavos(500), FromCentavos(200), FromCentavos(100), FromCentavos(50),
+				FromCentavos(20), FromCentavos(10), FromCentavos(5), FromCentavos(1),
+			},
+			want: map[int64]int{500: 1, 200: 1, 100: 1, 50: 1, 20: 1, 10: 1, 5: 1, 1: 3},
+		},
+		{
+			name:     "unsorted denominations",
+			due:      FromCentavos(0),
+			tendered: FromCentavos(700),
+			denoms:   []Money{FromCentavos(100), FromCentavos(500), FromCentavos(200)},
+			want:     map[int64]int{500: 1, 200: 1},
+		},
+		{
+			name:     "cannot make exact change",
+			due:      FromCentavos(12350),
+			tendered: FromCentavos(20000),
+			denoms:   mzn,
+			wantErr:  ErrCannotMakeChange,
+		},
+		{
+			name:     "no denominations",
+			due:      FromCentavos(100),
+			tendered: FromCentavos(200),
+			denoms:   nil,
+			wantErr:  ErrCannotMakeChange,
+		},
+		{
+			name:     "insufficient tender",
+			due:      FromCentavos(50000),
+			tendered: FromCentavos(20000),
+			denoms:   mzn,
+			wantErr:  ErrInsufficientTender,
+		},
+		{
+			name:     "negative amount due",
+			due:      FromCentavos(-100),
+			tendered: FromCentavos(200),
+			denoms:   mzn,
+			wantErr:  ErrInvalidAmount,
+		},
+		{
+			name:     "zero denomination",
+			due:      FromCentavos(100),
+			tendered: FromCentavos(200),
+			denoms:   []Money{FromCentavos(100), Zero()},
+			wantErr:  ErrInvalidDenomination,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.due.BreakChange(tt.tendered, tt.denoms)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("BreakChange() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("BreakChange() = %v, want %v", got, tt.want)
+			}
+
+			var total int64
+			for d, n := range got {
+				total += d * int64(n)
+			}
+			if want := tt.tendered.Centavos() - tt.due.Centavos(); total != want {
+				t.Errorf("BreakChange() pieces sum to %d, want %d", total, want)
+			}
+		})
+	}
+}
+
 func TestMoney_Comparisons(t *testing.T) {
 	t.Parallel()
 
@@ -451,6 +766,148 @@ func TestMoney_Comparisons(t *testing.T) {
 	})
 }
 
+func TestMoney_Between(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		amount   int64
+		min, max int64
+		want     bool
+	}{
+		{"positive within", 5000, 1000, 10000, true},
+		{"positive below", 500, 1000, 10000, false},
+		{"positive above", 15000, 1000, 10000, false},
+		{"at min", 1000, 1000, 10000, true},
+		{"at max", 10000, 1000, 10000, true},
+		{"negative within", -5000, -10000, -1000, true},
+		{"negative below", -15000, -10000, -1000, false},
+		{"negative above", -500, -10000, -1000, false},
+		{"zero in range spanning zero", 0, -1000, 1000, true},
+		{"single point range", 1000, 1000, 1000, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := FromCentavos(tt.amount).Between(FromCentavos(tt.min), FromCentavos(tt.max))
+			if got != tt.want {
+				t.Errorf("Between(%d, %d) for %d = %v, want %v", tt.min, tt.max, tt.amount, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("inverted range panics", func(t *testing.T) {
+		t.Parallel()
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Between(max, min) should panic")
+			}
+		}()
+		FromCentavos(5000).Between(FromCentavos(10000), FromCentavos(1000))
+	})
+}
+
+func TestMoney_Constrain(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		amount   int64
+		min, max int64
+		want     int64
+	}{
+		{"positive within", 5000, 1000, 10000, 5000},
+		{"positive below", 500, 1000, 10000, 1000},
+		{"positive above", 15000, 1000, 10000, 10000},
+		{"negative within", -5000, -10000, -1000, -5000},
+		{"negative below", -15000, -10000, -1000, -10000},
+		{"negative above", -500, -10000, -1000, -1000},
+		{"inverted bounds below", 500, 10000, 1000, 1000},
+		{"inverted bounds above", 15000, 10000, 1000, 10000},
+		{"inverted bounds within", 5000, 10000, 1000, 5000},
+		{"single point range", 5000, 1000, 1000, 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := FromCentavos(tt.amount).Constrain(FromCentavos(tt.min), FromCentavos(tt.max))
+			if got.Centavos() != tt.want {
+				t.Errorf("Constrain(%d, %d) for %d = %d, want %d", tt.min, tt.max, tt.amount, got.Centavos(), tt.want)
+			}
+		})
+	}
+}
+
+func TestMoney_GCD(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		a, b int64
+		want int64
+	}{
+		{"common divisor", 15000, 10000, 5000},
+		{"commutative", 10000, 15000, 5000},
+		{"coprime", 17, 5, 1},
+		{"equal", 2500, 2500, 2500},
+		{"with zero", 15000, 0, 15000},
+		{"both zero", 0, 0, 0},
+		{"negative operand", -15000, 10000, 5000},
+		{"both negative", -15000, -10000, 5000},
+		{"min int64", math.MinInt64, 1 << 20, 1 << 20},
+		{"min int64 and max int64", math.MinInt64, math.MaxInt64, 1},
+		// 2^63 is not representable and overflows, as documented.
+		{"min int64 with zero overflows", math.MinInt64, 0, math.MinInt64},
+		{"min int64 with itself overflows", math.MinInt64, math.MinInt64, math.MinInt64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := FromCentavos(tt.a).GCD(FromCentavos(tt.b))
+			if got.Centavos() != tt.want {
+				t.Errorf("GCD(%d, %d) = %d, want %d", tt.a, tt.b, got.Centavos(), tt.want)
+			}
+		})
+	}
+}
+
+func TestMoney_LCM(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		a, b    int64
+		want    int64
+		wantErr error
+	}{
+		{"common multiple", 15000, 10000, 30000, nil},
+		{"coprime", 7, 5, 35, nil},
+		{"equal", 2500, 2500, 2500, nil},
+		{"with zero", 15000, 0, 0, nil},
+		{"negative operand", -4, 6, 12, nil},
+		{"max int64 with one", math.MaxInt64, 1, math.MaxInt64, nil},
+		{"overflow", math.MaxInt64, 2, 0, ErrOverflow},
+		{"overflow coprime", 1 << 40, (1 << 30) + 1, 0, ErrOverflow},
+		{"min int64 overflow", math.MinInt64, 1, 0, ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := FromCentavos(tt.a).LCM(FromCentavos(tt.b))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("LCM(%d, %d) error = %v, want %v", tt.a, tt.b, err, tt.wantErr)
+			}
+			if got.Centavos() != tt.want {
+				t.Errorf("LCM(%d, %d) = %d, want %d", tt.a, tt.b, got.Centavos(), tt.want)
+			}
+		})
+	}
+}
+
 func TestMoney_StateChecks(t *testing.T) {
 	t.Parallel()
 
@@ -795,6 +1252,51 @@ func TestMoney_Text(t *testing.T) {
 	})
 }
 
+func TestMoney_Binary(t *testing.T) {
+	t.Parallel()
+
+	t.Run("little-endian byte order", func(t *testing.T) {
+		t.Parallel()
+		got := FromCentavos(15050).Serialize()
+		want := []byte{0xca, 0x3a, 0, 0, 0, 0, 0, 0}
+		if !bytes.Equal(got, want) {
+			t.Errorf("Serialize() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("negative amount", func(t *testing.T) {
+		t.Parallel()
+		got := FromCentavos(-1).Serialize()
+		want := []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+		if !bytes.Equal(got, want) {
+			t.Errorf("Serialize() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("roundtrip", func(t *testing.T) {
+		t.Parallel()
+		for _, centavos := range []int64{0, 1, -1, 15050, -15050, math.MaxInt64, math.MinInt64} {
+			m := FromCentavos(centavos)
+			got, err := DeserializeMoney(m.Serialize())
+			if err != nil {
+				t.Fatalf("DeserializeMoney() error = %v", err)
+			}
+			if !got.Equals(m) {
+				t.Errorf("roundtrip = %d, want %d", got.Centavos(), centavos)
+			}
+		}
+	})
+
+	t.Run("wrong length", func(t *testing.T) {
+		t.Parallel()
+		for _, b := range [][]byte{nil, {}, make([]byte, 7), make([]byte, 9)} {
+			if _, err := DeserializeMoney(b); !errors.Is(err, ErrInvalidBinaryLength) {
+				t.Errorf("DeserializeMoney(%d bytes) error = %v, want ErrInvalidBinaryLength", len(b), err)
+			}
+		}
+	})
+}
+
 func TestMoney_SQL(t *testing.T) {
 	t.Parallel()
 
@@ -947,3 +1449,240 @@ func TestMoney_PrecisionSafety(t *testing.T) {
 		}
 	})
 }
+
+func TestMaxWalletTopup(t *testing.T) {
+	tests := []struct {
+		status enums.KYCStatus
+		want   int64
+	}{
+		{enums.KYCStatusRejected, 0},
+		{enums.KYCStatusUnverified, 1_000_00},
+		{enums.KYCStatusBasic, 10_000_00},
+		{enums.KYCStatusFull, 50_000_00},
+		{enums.KYCStatus("invalid"), 0},
+	}
+	for _, tt := range tests {
+		if got := MaxWalletTopup(tt.status); got.Centavos() != tt.want {
+			t.Errorf("MaxWalletTopup(%q) = %d, want %d", tt.status, got.Centavos(), tt.want)
+		}
+	}
+
+	for _, status := range enums.KYCStatusValues() {
+		if _, ok := walletTopupLimits[status]; !ok {
+			t.Errorf("walletTopupLimits has no entry for %q", status)
+		}
+	}
+
+	// Limits must not decrease as verification increases.
+	for _, a := range enums.KYCStatusValues() {
+		for _, b := range enums.KYCStatusValues() {
+			if a.AtLeast(b) && MaxWalletTopup(a).LessThan(MaxWalletTopup(b)) {
+				t.Errorf("MaxWalletTopup(%q) < MaxWalletTopup(%q)", a, b)
+			}
+		}
+	}
+
+	t.Run("override", func(t *testing.T) {
+		saved := MaxWalletTopup(enums.KYCStatusBasic)
+		t.Cleanup(func() {
+			if err := SetWalletTopupLimit(enums.KYCStatusBasic, saved); err != nil {
+				t.Errorf("restoring limit: %v", err)
+			}
+		})
+
+		if err := SetWalletTopupLimit(enums.KYCStatusBasic, FromMZN(20000)); err != nil {
+			t.Fatalf("SetWalletTopupLimit() error = %v", err)
+		}
+		if got := MaxWalletTopup(enums.KYCStatusBasic); !got.Equals(FromMZN(20000)) {
+			t.Errorf("MaxWalletTopup(basic) after override = %v, want 20000.00 MZN", got)
+		}
+	})
+
+	t.Run("invalid override", func(t *testing.T) {
+		if err := SetWalletTopupLimit(enums.KYCStatus("invalid"), FromMZN(100)); !errors.Is(err, enums.ErrInvalidKYCStatus) {
+			t.Errorf("SetWalletTopupLimit(invalid) error = %v, want ErrInvalidKYCStatus", err)
+		}
+		if err := SetWalletTopupLimit(enums.KYCStatusFull, FromMZN(-1)); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("SetWalletTopupLimit(negative) error = %v, want ErrInvalidAmount", err)
+		}
+		if got := MaxWalletTopup(enums.KYCStatusFull); got.Centavos() != 50_000_00 {
+			t.Errorf("MaxWalletTopup(full) after rejected override = %v, want unchanged", got)
+		}
+	})
+
+	t.Run("concurrent access", func(t *testing.T) {
+		saved := MaxWalletTopup(enums.KYCStatusUnverified)
+		t.Cleanup(func() {
+			if err := SetWalletTopupLimit(enums.KYCStatusUnverified, saved); err != nil {
+				t.Errorf("restoring limit: %v", err)
+			}
+		})
+
+		var wg sync.WaitGroup
+		for i := range 8 {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				if err := SetWalletTopupLimit(enums.KYCStatusUnverified, FromMZN(float64(1000+i))); err != nil {
+					t.Errorf("SetWalletTopupLimit() error = %v", err)
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				MaxWalletTopup(enums.KYCStatusUnverified)
+			}()
+		}
+		wg.Wait()
+	})
+}
+
+func TestAccumulator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero value", func(t *testing.T) {
+		t.Parallel()
+		var acc Accumulator
+		if !acc.Result().IsZero() {
+			t.Errorf("Result() = %v, want zero", acc.Result())
+		}
+	})
+
+	t.Run("matches Money chain", func(t *testing.T) {
+		t.Parallel()
+		fares := []Money{
+			FromMZN(150),
+			FromCentavos(1),
+			FromMZN(-20.50),
+			FromCentavos(99999),
+			Zero(),
+			FromMZN(0.05),
+		}
+		refunds := []Money{FromMZN(30), FromCentavos(-7)}
+
+		var acc Accumulator
+		want := Zero()
+		for _, f := range fares {
+			acc.Add(f)
+			want = want.Add(f)
+		}
+		for _, r := range refunds {
+			acc.Subtract(r)
+			want = want.Subtract(r)
+		}
+		if got := acc.Result(); !got.Equals(want) {
+			t.Errorf("Result() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("chaining", func(t *testing.T) {
+		t.Parallel()
+		var acc Accumulator
+		got := acc.Add(FromMZN(100)).Add(FromMZN(50)).Subtract(FromMZN(25)).Result()
+		want := FromMZN(100).Add(FromMZN(50)).Subtract(FromMZN(25))
+		if !got.Equals(want) {
+			t.Errorf("chained Result() = %v, want %v", got, want)
+		}
+		if !acc.Result().Equals(want) {
+			t.Errorf("accumulator not updated in place: %v, want %v", acc.Result(), want)
+		}
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		t.Parallel()
+		var acc Accumulator
+		acc.Add(FromMZN(500))
+		acc.Reset()
+		if !acc.Result().IsZero() {
+			t.Errorf("Result() after Reset = %v, want zero", acc.Result())
+		}
+		if got := acc.Add(FromMZN(10)).Result(); !got.Equals(FromMZN(10)) {
+			t.Errorf("Result() after Reset and Add = %v, want 10.00 MZN", got)
+		}
+	})
+
+}
+
+// TestAccumulator_Allocs is not parallel: AllocsPerRun cannot run alongside
+// parallel tests.
+func TestAccumulator_Allocs(t *testing.T) {
+	fares := []Money{FromMZN(150), FromMZN(75), FromMZN(320)}
+	var acc Accumulator
+	allocs := testing.AllocsPerRun(100, func() {
+		acc.Reset()
+		for _, f := range fares {
+			acc.Add(f)
+		}
+		_ = acc.Result()
+	})
+	if allocs != 0 {
+		t.Errorf("allocations per run = %v, want 0", allocs)
+	}
+}
+
+func TestMoney_Currency(t *testing.T) {
+	t.Parallel()
+
+	m := FromMZN(150)
+	if got := m.CurrencyCode(); got != "MZN" {
+		t.Errorf("CurrencyCode() = %q, want MZN", got)
+	}
+	if got := m.CurrencySymbol(); got != "MT" {
+		t.Errorf("CurrencySymbol() = %q, want MT", got)
+	}
+	if got := Zero().CurrencyCode(); got != string(enums.CurrencyMZN) {
+		t.Errorf("Zero().CurrencyCode() = %q, want %q", got, enums.CurrencyMZN)
+	}
+}
+
+func TestPair_JSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("marshal", func(t *testing.T) {
+		t.Parallel()
+		data, err := json.Marshal(Pair{Amount: FromCentavos(15050), Currency: enums.CurrencyUSD})
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		want := `{"amount":15050,"currency":"USD"}`
+		if string(data) != want {
+			t.Errorf("Marshal() = %s, want %s", data, want)
+		}
+	})
+
+	t.Run("roundtrip", func(t *testing.T) {
+		t.Parallel()
+		for _, c := range enums.CurrencyValues() {
+			original := Pair{Amount: FromCentavos(-2599), Currency: c}
+			data, err := json.Marshal(original)
+			if err != nil {
+				t.Fatalf("Marshal(%v) error = %v", original, err)
+			}
+			var decoded Pair
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatalf("Unmarshal(%s) error = %v", data, err)
+			}
+			if decoded != original {
+				t.Errorf("roundtrip = %+v, want %+v", decoded, original)
+			}
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		inputs := []string{
+			`{"amount":100,"currency":"EUR"}`,
+			`{"amount":"100","currency":"MZN"}`,
+			`{"amount":1.5,"currency":"MZN"}`,
+		}
+		for _, input := range inputs {
+			var p Pair
+			if err := json.Unmarshal([]byte(input), &p); err == nil {
+				t.Errorf("Unmarshal(%s) expected error", input)
+			}
+		}
+		var p Pair
+		if err := json.Unmarshal([]byte(`{"amount":100,"currency":"EUR"}`), &p); !errors.Is(err, enums.ErrInvalidCurrency) {
+			t.Errorf("Unmarshal(EUR) error = %v, want ErrInvalidCurrency", err)
+		}
+	})
+}
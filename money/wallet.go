@@ -0,0 +1,46 @@
+package money
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/Dorico-Dynamics/txova-go-types/enums"
+)
+
+// walletTopupLimits maps each KYC status to the maximum single wallet top-up
+// allowed at that verification level. It is guarded by walletTopupMu.
+var (
+	walletTopupMu     sync.RWMutex
+	walletTopupLimits = map[enums.KYCStatus]Money{
+		enums.KYCStatusUnverified: FromCentavos(1_000_00),
+		enums.KYCStatusBasic:      FromCentavos(10_000_00),
+		enums.KYCStatusFull:       FromCentavos(50_000_00),
+		enums.KYCStatusRejected:   Zero(),
+	}
+)
+
+// MaxWalletTopup returns the maximum wallet top-up allowed for the given KYC
+// status. Statuses without a configured limit, including invalid ones, are
+// not allowed to top up and return zero. It is safe for concurrent use with
+// SetWalletTopupLimit.
+func MaxWalletTopup(status enums.KYCStatus) Money {
+	walletTopupMu.RLock()
+	defer walletTopupMu.RUnlock()
+	return walletTopupLimits[status]
+}
+
+// SetWalletTopupLimit overrides the maximum wallet top-up for a KYC status
+// and is safe for concurrent use. Returns enums.ErrInvalidKYCStatus for
+// invalid statuses and ErrInvalidAmount for negative limits.
+func SetWalletTopupLimit(status enums.KYCStatus, limit Money) error {
+	if !status.Valid() {
+		return fmt.Errorf("%w: %q", enums.ErrInvalidKYCStatus, status)
+	}
+	if limit.IsNegative() {
+		return fmt.Errorf("%w: wallet top-up limit %v is negative", ErrInvalidAmount, limit)
+	}
+	walletTopupMu.Lock()
+	defer walletTopupMu.Unlock()
+	walletTopupLimits[status] = limit
+	return nil
+}
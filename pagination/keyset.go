@@ -0,0 +1,156 @@
+package pagination
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// ErrInvalidSortField is returned when a requested sort field is not allowed.
+var ErrInvalidSortField = errors.New("invalid sort field")
+
+// ErrMissingTieBreaker is returned when keyset pagination is requested
+// without a valid tie-breaker column.
+var ErrMissingTieBreaker = errors.New("keyset pagination requires a tie-breaker column")
+
+// ErrInvalidPlaceholder is returned when KeysetOptions.FirstPlaceholder is
+// negative.
+var ErrInvalidPlaceholder = errors.New("invalid first placeholder")
+
+// sqlIdentifier matches a plain or table-qualified SQL column name.
+var sqlIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// KeysetOptions configures KeysetClause.
+type KeysetOptions struct {
+	// SortColumns maps each sort field clients may request to its SQL
+	// column. It is the allowlist: fields not listed are rejected.
+	SortColumns map[string]string
+
+	// DefaultSortField is used when the request has no sort field.
+	DefaultSortField string
+
+	// TieBreaker is a unique column, such as "id", appended to the sort so
+	// rows with equal sort values keep a stable order. Required. A cursor
+	// field named "id" must hold a string, as with NewCursor.
+	TieBreaker string
+
+	// FirstPlaceholder is the number of the first $n placeholder, for
+	// queries with other arguments before the keyset condition. Zero means 1;
+	// negative values are rejected with ErrInvalidPlaceholder.
+	FirstPlaceholder int
+}
+
+// KeysetClause builds the Postgres condition and ORDER BY clause for keyset
+// pagination over the request's sort field and the tie-breaker column:
+//
+//	where, args, order, err := pagination.KeysetClause(req, opts)
+//	// where: "(created_at, id) < ($1, $2)"
+//	// order: "ORDER BY created_at DESC, id DESC"
+//
+// The cursor must hold the last row's values in fields named after the sort
+// field and the TieBreaker, as built by NewCursorFromFields. The condition
+// has no WHERE keyword so it can be combined with other filters; it is empty,
+// with no args, for the first page. LIMIT is left to the caller, at
+// placeholder FirstPlaceholder+len(args).
+//
+// Returns ErrInvalidSortField for fields missing from SortColumns,
+// ErrMissingTieBreaker if TieBreaker is empty or not a column name,
+// ErrInvalidPlaceholder if FirstPlaceholder is negative,
+// ErrInvalidSortDirection for an invalid direction, and ErrInvalidCursor if
+// the cursor lacks a required field.
+func KeysetClause(req CursorRequest, opts KeysetOptions) (whereSQL string, args []any, orderSQL string, err error) {
+	if !sqlIdentifier.MatchString(opts.TieBreaker) {
+		return "", nil, "", ErrMissingTieBreaker
+	}
+	if opts.FirstPlaceholder < 0 {
+		return "", nil, "", fmt.Errorf("%w: %d", ErrInvalidPlaceholder, opts.FirstPlaceholder)
+	}
+
+	field := req.SortField
+	if field == "" {
+		field = opts.DefaultSortField
+	}
+	column, ok := opts.SortColumns[field]
+	if !ok || !sqlIdentifier.MatchString(column) {
+		return "", nil, "", fmt.Errorf("%w: %q", ErrInvalidSortField, field)
+	}
+
+	dir := req.SortDir
+	if dir == "" {
+		dir = SortAsc
+	}
+	if !dir.Valid() {
+		return "", nil, "", ErrInvalidSortDirection
+	}
+	keyword, op := "ASC", ">"
+	if dir == SortDesc {
+		keyword, op = "DESC", "<"
+	}
+
+	// Sorting by the tie-breaker itself needs only one column.
+	columns := []string{column, opts.TieBreaker}
+	keys := []string{field, opts.TieBreaker}
+	if column == opts.TieBreaker {
+		columns, keys = columns[:1], keys[:1]
+	}
+
+	orderSQL = "ORDER BY " + column + " " + keyword
+	if len(columns) > 1 {
+		orderSQL += ", " + opts.TieBreaker + " " + keyword
+	}
+
+	if req.Cursor.IsZero() {
+		return "", nil, orderSQL, nil
+	}
+
+	fields, err := req.Cursor.Fields()
+	if err != nil {
+		return "", nil, "", err
+	}
+	first := opts.FirstPlaceholder
+	if first == 0 {
+		first = 1
+	}
+	placeholders := make([]string, len(keys))
+	args = make([]any, len(keys))
+	for i, key := range keys {
+		value, err := keysetArg(fields, key)
+		if err != nil {
+			return "", nil, "", err
+		}
+		args[i] = value
+		placeholders[i] = "$" + strconv.Itoa(first+i)
+	}
+
+	if len(columns) == 1 {
+		whereSQL = columns[0] + " " + op + " " + placeholders[0]
+	} else {
+		whereSQL = "(" + columns[0] + ", " + columns[1] + ") " + op +
+			" (" + placeholders[0] + ", " + placeholders[1] + ")"
+	}
+	return whereSQL, args, orderSQL, nil
+}
+
+// keysetArg returns the cursor field as a query argument. Numbers become
+// int64 when integral and float64 otherwise.
+func keysetArg(fields map[string]any, key string) (any, error) {
+	switch v := fields[key].(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n, nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field %q is not a number", ErrInvalidCursor, key)
+		}
+		return f, nil
+	case bool:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("%w: missing field %q", ErrInvalidCursor, key)
+	}
+}
@@ -0,0 +1,209 @@
+package pagination
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func testKeysetOptions() KeysetOptions {
+	return KeysetOptions{
+		SortColumns: map[string]string{
+			"created_at": "created_at",
+			"rating":     "r.rating",
+			"id":         "id",
+		},
+		DefaultSortField: "created_at",
+		TieBreaker:       "id",
+	}
+}
+
+func mustFieldsCursor(t *testing.T, fields map[string]any) Cursor {
+	t.Helper()
+	c, err := NewCursorFromFields(fields)
+	if err != nil {
+		t.Fatalf("NewCursorFromFields() error = %v", err)
+	}
+	return c
+}
+
+func TestKeysetClause(t *testing.T) {
+	cursor := mustFieldsCursor(t, map[string]any{"created_at": "2024-01-15T10:30:00Z", "id": "ride-42"})
+
+	tests := []struct {
+		name      string
+		req       CursorRequest
+		opts      func(*KeysetOptions)
+		wantWhere string
+		wantArgs  []any
+		wantOrder string
+	}{
+		{
+			name:      "desc",
+			req:       NewCursorRequest().WithSort("created_at", SortDesc).WithCursor(cursor),
+			wantWhere: "(created_at, id) < ($1, $2)",
+			wantArgs:  []any{"2024-01-15T10:30:00Z", "ride-42"},
+			wantOrder: "ORDER BY created_at DESC, id DESC",
+		},
+		{
+			name:      "asc",
+			req:       NewCursorRequest().WithSort("created_at", SortAsc).WithCursor(cursor),
+			wantWhere: "(created_at, id) > ($1, $2)",
+			wantArgs:  []any{"2024-01-15T10:30:00Z", "ride-42"},
+			wantOrder: "ORDER BY created_at ASC, id ASC",
+		},
+		{
+			name:      "first page",
+			req:       NewCursorRequest().WithSort("created_at", SortDesc),
+			wantWhere: "",
+			wantArgs:  nil,
+			wantOrder: "ORDER BY created_at DESC, id DESC",
+		},
+		{
+			name:      "default sort field and direction",
+			req:       CursorRequest{Limit: 20, Cursor: cursor},
+			wantWhere: "(created_at, id) > ($1, $2)",
+			wantArgs:  []any{"2024-01-15T10:30:00Z", "ride-42"},
+			wantOrder: "ORDER BY created_at ASC, id ASC",
+		},
+		{
+			name: "mapped column with numeric cursor values",
+			req: NewCursorRequest().WithSort("rating", SortDesc).
+				WithCursor(mustFieldsCursor(t, map[string]any{"rating": int64(1700000000123456789), "id": "ride-42"})),
+			wantWhere: "(r.rating, id) < ($1, $2)",
+			wantArgs:  []any{int64(1700000000123456789), "ride-42"},
+			wantOrder: "ORDER BY r.rating DESC, id DESC",
+		},
+		{
+			name:      "sort by tie-breaker",
+			req:       NewCursorRequest().WithSort("id", SortDesc).WithCursor(NewCursor("ride-42")),
+			wantWhere: "id < $1",
+			wantArgs:  []any{"ride-42"},
+			wantOrder: "ORDER BY id DESC",
+		},
+		{
+			name:      "first placeholder",
+			req:       NewCursorRequest().WithSort("created_at", SortDesc).WithCursor(cursor),
+			opts:      func(o *KeysetOptions) { o.FirstPlaceholder = 3 },
+			wantWhere: "(created_at, id) < ($3, $4)",
+			wantArgs:  []any{"2024-01-15T10:30:00Z", "ride-42"},
+			wantOrder: "ORDER BY created_at DESC, id DESC",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := testKeysetOptions()
+			if tt.opts != nil {
+				tt.opts(&opts)
+			}
+			where, args, order, err := KeysetClause(tt.req, opts)
+			if err != nil {
+				t.Fatalf("KeysetClause() error = %v", err)
+			}
+			if where != tt.wantWhere {
+				t.Errorf("where = %q, want %q", where, tt.wantWhere)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("args = %#v, want %#v", args, tt.wantArgs)
+			}
+			if order != tt.wantOrder {
+				t.Errorf("order = %q, want %q", order, tt.wantOrder)
+			}
+		})
+	}
+}
+
+func TestKeysetClause_Errors(t *testing.T) {
+	cursor := mustFieldsCursor(t, map[string]any{"created_at": "2024-01-15T10:30:00Z", "id": "ride-42"})
+
+	tests := []struct {
+		name    string
+		req     CursorRequest
+		opts    func(*KeysetOptions)
+		wantErr error
+	}{
+		{
+			name:    "sort field not allowed",
+			req:     NewCursorRequest().WithSort("password_hash", SortAsc),
+			wantErr: ErrInvalidSortField,
+		},
+		{
+			name:    "injection via sort field",
+			req:     NewCursorRequest().WithSort("created_at; DROP TABLE rides; --", SortAsc),
+			wantErr: ErrInvalidSortField,
+		},
+		{
+			name:    "injection via subquery",
+			req:     NewCursorRequest().WithSort("(SELECT 1)", SortDesc).WithCursor(cursor),
+			wantErr: ErrInvalidSortField,
+		},
+		{
+			name:    "no sort field and no default",
+			req:     NewCursorRequest(),
+			opts:    func(o *KeysetOptions) { o.DefaultSortField = "" },
+			wantErr: ErrInvalidSortField,
+		},
+		{
+			name:    "unsafe configured column",
+			req:     NewCursorRequest().WithSort("name", SortAsc),
+			opts:    func(o *KeysetOptions) { o.SortColumns["name"] = "name DESC, 1" },
+			wantErr: ErrInvalidSortField,
+		},
+		{
+			name:    "missing tie-breaker",
+			req:     NewCursorRequest().WithSort("created_at", SortAsc),
+			opts:    func(o *KeysetOptions) { o.TieBreaker = "" },
+			wantErr: ErrMissingTieBreaker,
+		},
+		{
+			name:    "invalid tie-breaker",
+			req:     NewCursorRequest().WithSort("created_at", SortAsc),
+			opts:    func(o *KeysetOptions) { o.TieBreaker = "id; --" },
+			wantErr: ErrMissingTieBreaker,
+		},
+		{
+			name:    "negative first placeholder",
+			req:     NewCursorRequest().WithSort("created_at", SortDesc),
+			opts:    func(o *KeysetOptions) { o.FirstPlaceholder = -1 },
+			wantErr: ErrInvalidPlaceholder,
+		},
+		{
+			name:    "invalid direction",
+			req:     NewCursorRequest().WithSort("created_at", "sideways"),
+			wantErr: ErrInvalidSortDirection,
+		},
+		{
+			name:    "cursor missing sort value",
+			req:     NewCursorRequest().WithSort("created_at", SortDesc).WithCursor(NewCursor("ride-42")),
+			wantErr: ErrInvalidCursor,
+		},
+		{
+			name: "cursor missing tie-breaker value",
+			req: NewCursorRequest().WithSort("created_at", SortDesc).
+				WithCursor(mustFieldsCursor(t, map[string]any{"created_at": "2024-01-15T10:30:00Z"})),
+			wantErr: ErrInvalidCursor,
+		},
+		{
+			name:    "malformed cursor",
+			req:     NewCursorRequest().WithSort("created_at", SortDesc).WithCursor(Cursor{value: "invalid-base64!!!"}),
+			wantErr: ErrInvalidCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := testKeysetOptions()
+			if tt.opts != nil {
+				tt.opts(&opts)
+			}
+			where, args, order, err := KeysetClause(tt.req, opts)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("KeysetClause() error = %v, want %v", err, tt.wantErr)
+			}
+			if where != "" || args != nil || order != "" {
+				t.Errorf("KeysetClause() = %q, %v, %q on error, want empty", where, args, order)
+			}
+		})
+	}
+}
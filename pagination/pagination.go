@@ -2,11 +2,15 @@
 package pagination
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 // Default and maximum pagination limits.
@@ -16,6 +20,10 @@ const (
 	MinLimit     = 1
 )
 
+// MaxSearchQueryLength is the maximum search query length, in characters,
+// accepted by Validate.
+const MaxSearchQueryLength = 256
+
 // SortDirection represents the sort order.
 type SortDirection string
 
@@ -33,9 +41,15 @@ var ErrInvalidLimit = errors.New("invalid limit: must be between 1 and 100")
 // ErrInvalidOffset is returned when offset is negative.
 var ErrInvalidOffset = errors.New("invalid offset: must be non-negative")
 
+// ErrSearchQueryTooLong is returned when a search query exceeds the maximum length.
+var ErrSearchQueryTooLong = errors.New("invalid search query: too long")
+
 // ErrInvalidCursor is returned when a cursor cannot be decoded.
 var ErrInvalidCursor = errors.New("invalid cursor")
 
+// ErrCursorTooLarge is returned when an encoded cursor exceeds MaxCursorSize.
+var ErrCursorTooLarge = errors.New("cursor too large")
+
 // ParseSortDirection parses a string into a SortDirection.
 func ParseSortDirection(s string) (SortDirection, error) {
 	switch strings.ToLower(strings.TrimSpace(s)) {
@@ -67,10 +81,11 @@ func (s SortDirection) Valid() bool {
 
 // PageRequest represents a pagination request for offset-based pagination.
 type PageRequest struct {
-	Limit     int           `json:"limit"`
-	Offset    int           `json:"offset"`
-	SortField string        `json:"sort_field,omitempty"`
-	SortDir   SortDirection `json:"sort_dir,omitempty"`
+	Limit       int           `json:"limit"`
+	Offset      int           `json:"offset"`
+	SortField   string        `json:"sort_field,omitempty"`
+	SortDir     SortDirection `json:"sort_dir,omitempty"`
+	SearchQuery string        `json:"search_query,omitempty"`
 }
 
 // NewPageRequest creates a new PageRequest with default values.
@@ -110,7 +125,20 @@ func (p PageRequest) WithSort(field string, dir SortDirection) PageRequest {
 	return p
 }
 
+// WithSearchQuery sets the search query, trimming surrounding whitespace.
+func (p PageRequest) WithSearchQuery(q string) PageRequest {
+	p.SearchQuery = strings.TrimSpace(q)
+	return p
+}
+
+// ValidateSearchQuery checks that the search query is at most maxLen characters.
+// An empty query is always valid.
+func (p PageRequest) ValidateSearchQuery(maxLen int) error {
+	return validateSearchQuery(p.SearchQuery, maxLen)
+}
+
 // Validate checks if the PageRequest is valid.
+// The search query is checked against MaxSearchQueryLength.
 func (p PageRequest) Validate() error {
 	if p.Limit < MinLimit || p.Limit > MaxLimit {
 		return ErrInvalidLimit
@@ -121,7 +149,7 @@ func (p PageRequest) Validate() error {
 	if p.SortDir != "" && !p.SortDir.Valid() {
 		return ErrInvalidSortDirection
 	}
-	return nil
+	return p.ValidateSearchQuery(MaxSearchQueryLength)
 }
 
 // Normalize ensures all values are within valid ranges and returns a normalized copy.
@@ -180,12 +208,59 @@ func (p PageResponse[T]) NextOffset() int {
 	return p.Offset + len(p.Items)
 }
 
+// PrevOffset returns the offset for the previous page, or -1 on the first
+// page. It never goes below 0, so a page that started mid-way returns to the
+// start. Returns 0 when Limit is not positive.
+func (p PageResponse[T]) PrevOffset() int {
+	if p.Limit <= 0 {
+		return 0
+	}
+	if p.Offset <= 0 {
+		return -1
+	}
+	if p.Offset < p.Limit {
+		return 0
+	}
+	return p.Offset - p.Limit
+}
+
+// TotalPages returns the number of pages needed to show Total items at
+// Limit items per page, counting a partial last page. Returns 0 when there
+// are no items or Limit is not positive.
+func (p PageResponse[T]) TotalPages() int {
+	if p.Limit <= 0 || p.Total <= 0 {
+		return 0
+	}
+	return (p.Total + p.Limit - 1) / p.Limit
+}
+
+// CurrentPage returns the 1-based page number containing Offset.
+// Returns 0 when there are no items or Limit is not positive, matching
+// TotalPages, so an empty result never reads "page 1 of 0".
+func (p PageResponse[T]) CurrentPage() int {
+	if p.Limit <= 0 || p.Total <= 0 {
+		return 0
+	}
+	return p.Offset/p.Limit + 1
+}
+
+// MaxCursorSize is the maximum length, in bytes, of an encoded cursor.
+// Larger cursors are rejected when built from fields and when parsed.
+const MaxCursorSize = 1024
+
 // Cursor represents an opaque cursor for cursor-based pagination.
 // It encodes the position information in a base64 string.
 type Cursor struct {
 	value string
 }
 
+// Field names used by the built-in cursor constructors.
+const (
+	cursorKeyID        = "id"
+	cursorKeyTimestamp = "ts"
+	cursorKeyOffset    = "o"
+)
+
 // cursorData is the internal structure encoded in the cursor.
 type cursorData struct {
 	ID        string `json:"id,omitempty"`
@@ -206,6 +281,11 @@ func mustMarshalCursor(data cursorData) []byte {
 }
 
 // NewCursor creates a new cursor from an ID.
+//
+// NewCursor, NewCursorWithTimestamp and NewCursorWithOffset do not enforce
+// MaxCursorSize: an ID longer than about 750 bytes yields a cursor that
+// ParseCursor and SignedCursorCodec.Decode reject with ErrCursorTooLarge.
+// Use NewCursorFromFields to get that error when the cursor is built.
 func NewCursor(id string) Cursor {
 	data := cursorData{ID: id}
 	jsonBytes := mustMarshalCursor(data)
@@ -213,6 +293,7 @@ func NewCursor(id string) Cursor {
 }
 
 // NewCursorWithTimestamp creates a cursor with both ID and timestamp.
+// Like NewCursor, it does not enforce MaxCursorSize.
 func NewCursorWithTimestamp(id string, timestamp int64) Cursor {
 	data := cursorData{ID: id, Timestamp: timestamp}
 	jsonBytes := mustMarshalCursor(data)
@@ -226,21 +307,112 @@ func NewCursorWithOffset(offset int) Cursor {
 	return Cursor{value: base64.URLEncoding.EncodeToString(jsonBytes)}
 }
 
-// ParseCursor parses a cursor string.
-func ParseCursor(s string) (Cursor, error) {
-	if s == "" {
+// NewCursorFromFields creates a cursor encoding an arbitrary set of named fields.
+// This supports keyset pagination over several sort columns. Fields are
+// encoded in sorted key order, so equal maps always produce equal cursors.
+// An empty map produces the zero cursor. Returns ErrInvalidCursor if "id" is not a string
+// or "ts" or "o" is not an integer, and ErrCursorTooLarge if the encoded
+// cursor exceeds MaxCursorSize.
+func NewCursorFromFields(fields map[string]any) (Cursor, error) {
+	if len(fields) == 0 {
 		return Cursor{}, nil
 	}
+	return encodeCursorFields(fields)
+}
+
+// NewCursorWithFields is the map[string]string convenience form of
+// NewCursorFromFields, for cursors holding only string sort key values.
+func NewCursorWithFields(fields map[string]string) (Cursor, error) {
+	anyFields := make(map[string]any, len(fields))
+	for name, value := range fields {
+		anyFields[name] = value
+	}
+	return NewCursorFromFields(anyFields)
+}
+
+// encodeCursorFields encodes a map of fields as a cursor. encoding/json
+// writes map keys in sorted order, which keeps the encoding stable.
+func encodeCursorFields(fields any) (Cursor, error) {
+	jsonBytes, err := json.Marshal(fields)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("%w: %s", ErrInvalidCursor, err.Error())
+	}
+	value := base64.URLEncoding.EncodeToString(jsonBytes)
+	if len(value) > MaxCursorSize {
+		return Cursor{}, fmt.Errorf("%w: %d bytes, max %d", ErrCursorTooLarge, len(value), MaxCursorSize)
+	}
+	// Reject cursors that ParseCursor would not accept back.
+	if _, err := decodeCursorFields(value); err != nil {
+		return Cursor{}, err
+	}
+	return Cursor{value: value}, nil
+}
 
-	// Verify it's valid base64 and valid JSON
+// decodeCursorFields decodes the base64 JSON object held in a cursor string.
+// Numbers are decoded as json.Number to preserve int64 precision.
+func decodeCursorFields(s string) (map[string]any, error) {
 	decoded, err := base64.URLEncoding.DecodeString(s)
 	if err != nil {
-		return Cursor{}, ErrInvalidCursor
+		return nil, ErrInvalidCursor
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(decoded))
+	dec.UseNumber()
+
+	var fields map[string]any
+	if err := dec.Decode(&fields); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	// The object must be the whole payload.
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, ErrInvalidCursor
+	}
+	if err := validateCursorFields(fields); err != nil {
+		return nil, err
+	}
+	if fields == nil {
+		fields = map[string]any{}
+	}
+	return fields, nil
+}
+
+// validateCursorFields checks that the fields read by the built-in accessors
+// have the expected JSON types: a string ID, an int64 timestamp and an int
+// offset. Other fields may hold any JSON value.
+func validateCursorFields(fields map[string]any) error {
+	if v, ok := fields[cursorKeyID]; ok {
+		if _, isString := v.(string); !isString {
+			return fmt.Errorf("%w: field %q must be a string", ErrInvalidCursor, cursorKeyID)
+		}
+	}
+	if v, ok := fields[cursorKeyTimestamp]; ok {
+		n, isNumber := v.(json.Number)
+		if _, err := n.Int64(); !isNumber || err != nil {
+			return fmt.Errorf("%w: field %q must be an integer", ErrInvalidCursor, cursorKeyTimestamp)
+		}
+	}
+	if v, ok := fields[cursorKeyOffset]; ok {
+		n, isNumber := v.(json.Number)
+		if _, err := strconv.Atoi(n.String()); !isNumber || err != nil {
+			return fmt.Errorf("%w: field %q must be an integer", ErrInvalidCursor, cursorKeyOffset)
+		}
+	}
+	return nil
+}
+
+// ParseCursor parses a cursor string.
+// Returns ErrCursorTooLarge if s exceeds MaxCursorSize.
+func ParseCursor(s string) (Cursor, error) {
+	if s == "" {
+		return Cursor{}, nil
+	}
+	if len(s) > MaxCursorSize {
+		return Cursor{}, fmt.Errorf("%w: %d bytes, max %d", ErrCursorTooLarge, len(s), MaxCursorSize)
 	}
 
-	var data cursorData
-	if err := json.Unmarshal(decoded, &data); err != nil {
-		return Cursor{}, ErrInvalidCursor
+	// Verify it's valid base64 and a valid JSON object
+	if _, err := decodeCursorFields(s); err != nil {
+		return Cursor{}, err
 	}
 
 	return Cursor{value: s}, nil
@@ -256,61 +428,75 @@ func (c Cursor) IsZero() bool {
 	return c.value == ""
 }
 
-// ID extracts the ID from the cursor.
-func (c Cursor) ID() string {
+// Fields decodes all fields stored in the cursor.
+// Numeric values are returned as json.Number. The zero cursor has no fields.
+func (c Cursor) Fields() (map[string]any, error) {
 	if c.value == "" {
-		return ""
+		return map[string]any{}, nil
 	}
+	return decodeCursorFields(c.value)
+}
 
-	decoded, err := base64.URLEncoding.DecodeString(c.value)
+// Field returns the named field from the cursor as a string.
+// Numeric fields are returned in their JSON form. Returns false if the field
+// is missing, is not a string or number, or the cursor is invalid.
+func (c Cursor) Field(name string) (string, bool) {
+	fields, err := c.Fields()
 	if err != nil {
-		return ""
+		return "", false
+	}
+	switch v := fields[name].(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	default:
+		return "", false
 	}
+}
 
-	var data cursorData
-	if err := json.Unmarshal(decoded, &data); err != nil {
+// ID extracts the ID from the cursor.
+func (c Cursor) ID() string {
+	fields, err := c.Fields()
+	if err != nil {
 		return ""
 	}
-
-	return data.ID
+	id, _ := fields[cursorKeyID].(string)
+	return id
 }
 
 // Timestamp extracts the timestamp from the cursor.
 func (c Cursor) Timestamp() int64 {
-	if c.value == "" {
+	fields, err := c.Fields()
+	if err != nil {
 		return 0
 	}
-
-	decoded, err := base64.URLEncoding.DecodeString(c.value)
-	if err != nil {
+	n, ok := fields[cursorKeyTimestamp].(json.Number)
+	if !ok {
 		return 0
 	}
-
-	var data cursorData
-	if err := json.Unmarshal(decoded, &data); err != nil {
+	ts, err := n.Int64()
+	if err != nil {
 		return 0
 	}
-
-	return data.Timestamp
+	return ts
 }
 
 // Offset extracts the offset from the cursor.
 func (c Cursor) Offset() int {
-	if c.value == "" {
+	fields, err := c.Fields()
+	if err != nil {
 		return 0
 	}
-
-	decoded, err := base64.URLEncoding.DecodeString(c.value)
-	if err != nil {
+	n, ok := fields[cursorKeyOffset].(json.Number)
+	if !ok {
 		return 0
 	}
-
-	var data cursorData
-	if err := json.Unmarshal(decoded, &data); err != nil {
+	offset, err := strconv.Atoi(n.String())
+	if err != nil {
 		return 0
 	}
-
-	return data.Offset
+	return offset
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -357,10 +543,11 @@ func (c *Cursor) UnmarshalText(data []byte) error {
 
 // CursorRequest represents a cursor-based pagination request.
 type CursorRequest struct {
-	Cursor    Cursor        `json:"cursor,omitempty"`
-	Limit     int           `json:"limit"`
-	SortField string        `json:"sort_field,omitempty"`
-	SortDir   SortDirection `json:"sort_dir,omitempty"`
+	Cursor      Cursor        `json:"cursor,omitempty"`
+	Limit       int           `json:"limit"`
+	SortField   string        `json:"sort_field,omitempty"`
+	SortDir     SortDirection `json:"sort_dir,omitempty"`
+	SearchQuery string        `json:"search_query,omitempty"`
 }
 
 // NewCursorRequest creates a new CursorRequest with default values.
@@ -396,7 +583,20 @@ func (c CursorRequest) WithSort(field string, dir SortDirection) CursorRequest {
 	return c
 }
 
+// WithSearchQuery sets the search query, trimming surrounding whitespace.
+func (c CursorRequest) WithSearchQuery(q string) CursorRequest {
+	c.SearchQuery = strings.TrimSpace(q)
+	return c
+}
+
+// ValidateSearchQuery checks that the search query is at most maxLen characters.
+// An empty query is always valid.
+func (c CursorRequest) ValidateSearchQuery(maxLen int) error {
+	return validateSearchQuery(c.SearchQuery, maxLen)
+}
+
 // Validate checks if the CursorRequest is valid.
+// The search query is checked against MaxSearchQueryLength.
 func (c CursorRequest) Validate() error {
 	if c.Limit < MinLimit || c.Limit > MaxLimit {
 		return ErrInvalidLimit
@@ -404,7 +604,7 @@ func (c CursorRequest) Validate() error {
 	if c.SortDir != "" && !c.SortDir.Valid() {
 		return ErrInvalidSortDirection
 	}
-	return nil
+	return c.ValidateSearchQuery(MaxSearchQueryLength)
 }
 
 // Normalize ensures all values are within valid ranges.
@@ -421,6 +621,14 @@ func (c CursorRequest) Normalize() CursorRequest {
 	return c
 }
 
+// validateSearchQuery reports whether q is at most maxLen characters long.
+func validateSearchQuery(q string, maxLen int) error {
+	if utf8.RuneCountInString(q) > maxLen {
+		return ErrSearchQueryTooLong
+	}
+	return nil
+}
+
 // CursorResponse represents a cursor-based paginated response.
 type CursorResponse[T any] struct {
 	Items      []T    `json:"items"`
@@ -1,7 +1,10 @@
 package pagination
 
 import (
+	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -222,6 +225,47 @@ func TestPageRequest(t *testing.T) {
 			t.Errorf("JSON roundtrip failed: got %+v, want %+v", decoded, p)
 		}
 	})
+
+	t.Run("SearchQuery", func(t *testing.T) {
+		p := NewPageRequest().WithSearchQuery("  maputo airport  ")
+		if p.SearchQuery != "maputo airport" {
+			t.Errorf("WithSearchQuery() = %q, want %q", p.SearchQuery, "maputo airport")
+		}
+
+		tests := []struct {
+			name    string
+			query   string
+			maxLen  int
+			wantErr error
+		}{
+			{"empty", "", 10, nil},
+			{"normal", "maputo", 10, nil},
+			{"exact length", "ção ção ç", 9, nil},
+			{"too long", "maputo airport", 10, ErrSearchQueryTooLong},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := NewPageRequest().WithSearchQuery(tt.query).ValidateSearchQuery(tt.maxLen)
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ValidateSearchQuery() error = %v, want %v", err, tt.wantErr)
+				}
+			})
+		}
+
+		long := NewPageRequest().WithSearchQuery(strings.Repeat("a", MaxSearchQueryLength+1))
+		if err := long.Validate(); !errors.Is(err, ErrSearchQueryTooLong) {
+			t.Errorf("Validate() error = %v, want %v", err, ErrSearchQueryTooLong)
+		}
+
+		data, err := json.Marshal(NewPageRequest().WithSearchQuery("maputo"))
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		if !strings.Contains(string(data), `"search_query":"maputo"`) {
+			t.Errorf("Marshal() = %s, want search_query field", data)
+		}
+	})
 }
 
 func TestPageResponse(t *testing.T) {
@@ -314,6 +358,66 @@ func TestPageResponse(t *testing.T) {
 		}
 	})
 
+	t.Run("PrevOffset", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			limit  int
+			offset int
+			want   int
+		}{
+			{"first page", 10, 0, -1},
+			{"second page", 10, 10, 0},
+			{"later page", 10, 30, 20},
+			{"mid-way start", 10, 5, 0},
+			{"unaligned", 10, 25, 15},
+			{"zero limit", 0, 30, 0},
+			{"zero limit first page", 0, 0, 0},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				resp := NewPageResponse([]int{1}, 100, tt.limit, tt.offset)
+				if got := resp.PrevOffset(); got != tt.want {
+					t.Errorf("PrevOffset() = %d, want %d", got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("TotalPages and CurrentPage", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			total     int
+			limit     int
+			offset    int
+			wantPages int
+			wantPage  int
+		}{
+			{"exact multiple", 100, 10, 0, 10, 1},
+			{"exact multiple last page", 100, 10, 90, 10, 10},
+			{"partial last page", 101, 10, 100, 11, 11},
+			{"partial single page", 7, 10, 0, 1, 1},
+			{"one item", 1, 20, 0, 1, 1},
+			{"middle page", 95, 20, 40, 5, 3},
+			{"unaligned offset", 100, 10, 15, 10, 2},
+			{"no items", 0, 10, 0, 0, 0},
+			{"zero limit", 100, 0, 0, 0, 0},
+			{"negative limit", 100, -5, 10, 0, 0},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				resp := NewPageResponse([]int{}, tt.total, tt.limit, tt.offset)
+				if got := resp.TotalPages(); got != tt.wantPages {
+					t.Errorf("TotalPages() = %d, want %d", got, tt.wantPages)
+				}
+				if got := resp.CurrentPage(); got != tt.wantPage {
+					t.Errorf("CurrentPage() = %d, want %d", got, tt.wantPage)
+				}
+			})
+		}
+	})
+
 	t.Run("JSON", func(t *testing.T) {
 		resp := NewPageResponse([]string{"a", "b"}, 10, 2, 0)
 		data, err := json.Marshal(resp)
@@ -393,6 +497,244 @@ func TestCursor(t *testing.T) {
 		}
 	})
 
+	t.Run("NewCursorFromFields round-trip", func(t *testing.T) {
+		c, err := NewCursorFromFields(map[string]any{
+			"rating":     4.5,
+			"created_at": int64(1700000000123456789),
+			"id":         "ride-42",
+		})
+		if err != nil {
+			t.Fatalf("NewCursorFromFields() error = %v", err)
+		}
+
+		parsed, err := ParseCursor(c.String())
+		if err != nil {
+			t.Fatalf("ParseCursor() error = %v", err)
+		}
+		fields, err := parsed.Fields()
+		if err != nil {
+			t.Fatalf("Fields() error = %v", err)
+		}
+		if len(fields) != 3 {
+			t.Fatalf("Fields() returned %d fields, want 3", len(fields))
+		}
+		if fields["id"] != "ride-42" {
+			t.Errorf("Fields()[id] = %v, want ride-42", fields["id"])
+		}
+		if fields["rating"] != json.Number("4.5") {
+			t.Errorf("Fields()[rating] = %v, want 4.5", fields["rating"])
+		}
+		if fields["created_at"] != json.Number("1700000000123456789") {
+			t.Errorf("Fields()[created_at] = %v, want 1700000000123456789", fields["created_at"])
+		}
+		if parsed.ID() != "ride-42" {
+			t.Errorf("ID() = %v, want ride-42", parsed.ID())
+		}
+	})
+
+	t.Run("NewCursorFromFields empty", func(t *testing.T) {
+		c, err := NewCursorFromFields(nil)
+		if err != nil {
+			t.Fatalf("NewCursorFromFields(nil) error = %v", err)
+		}
+		if !c.IsZero() {
+			t.Error("NewCursorFromFields(nil) should return zero cursor")
+		}
+	})
+
+	t.Run("NewCursorFromFields unsupported value", func(t *testing.T) {
+		_, err := NewCursorFromFields(map[string]any{"ch": make(chan int)})
+		if !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("NewCursorFromFields(chan) error = %v, want ErrInvalidCursor", err)
+		}
+	})
+
+	t.Run("NewCursorWithFields round-trip", func(t *testing.T) {
+		want := map[string]string{
+			"rating":     "4.5",
+			"created_at": "2024-01-15T10:30:00Z",
+			"id":         "ride-42",
+		}
+		c, err := NewCursorWithFields(want)
+		if err != nil {
+			t.Fatalf("NewCursorWithFields() error = %v", err)
+		}
+
+		parsed, err := ParseCursor(c.String())
+		if err != nil {
+			t.Fatalf("ParseCursor() error = %v", err)
+		}
+		for name, value := range want {
+			got, ok := parsed.Field(name)
+			if !ok || got != value {
+				t.Errorf("Field(%q) = %q, %v, want %q, true", name, got, ok, value)
+			}
+		}
+		if _, ok := parsed.Field("missing"); ok {
+			t.Error("Field(missing) ok = true, want false")
+		}
+		if parsed.ID() != "ride-42" {
+			t.Errorf("ID() = %v, want ride-42", parsed.ID())
+		}
+	})
+
+	t.Run("NewCursorFromFields stable encoding", func(t *testing.T) {
+		a, err := NewCursorFromFields(map[string]any{"b": "2", "a": "1", "c": "3"})
+		if err != nil {
+			t.Fatalf("NewCursorFromFields() error = %v", err)
+		}
+		for range 20 {
+			b, err := NewCursorFromFields(map[string]any{"c": "3", "a": "1", "b": "2"})
+			if err != nil {
+				t.Fatalf("NewCursorFromFields() error = %v", err)
+			}
+			if a != b {
+				t.Fatalf("encodings differ: %s vs %s", a, b)
+			}
+		}
+		decoded, err := base64.URLEncoding.DecodeString(a.String())
+		if err != nil {
+			t.Fatalf("DecodeString() error = %v", err)
+		}
+		if string(decoded) != `{"a":"1","b":"2","c":"3"}` {
+			t.Errorf("encoded form = %s, want keys in sorted order", decoded)
+		}
+	})
+
+	t.Run("NewCursorWithFields empty", func(t *testing.T) {
+		c, err := NewCursorWithFields(map[string]string{})
+		if err != nil || !c.IsZero() {
+			t.Errorf("NewCursorWithFields(empty) = %v, %v, want zero cursor", c, err)
+		}
+	})
+
+	t.Run("Field", func(t *testing.T) {
+		c, err := NewCursorFromFields(map[string]any{"ts": int64(1700000000123456789), "flag": true, "id": "x"})
+		if err != nil {
+			t.Fatalf("NewCursorFromFields() error = %v", err)
+		}
+		if got, ok := c.Field("ts"); !ok || got != "1700000000123456789" {
+			t.Errorf("Field(ts) = %q, %v, want number as string", got, ok)
+		}
+		if _, ok := c.Field("flag"); ok {
+			t.Error("Field(flag) ok = true, want false for non-string value")
+		}
+		if _, ok := (Cursor{}).Field("id"); ok {
+			t.Error("zero cursor Field(id) ok = true, want false")
+		}
+		if _, ok := (Cursor{value: "invalid-base64!!!"}).Field("id"); ok {
+			t.Error("invalid cursor Field(id) ok = true, want false")
+		}
+	})
+
+	t.Run("size limit", func(t *testing.T) {
+		// Base64 encodes 3 bytes as 4, so 768 bytes of JSON fill the limit exactly.
+		fits := strings.Repeat("a", MaxCursorSize*3/4-len(`{"k":""}`))
+		c, err := NewCursorFromFields(map[string]any{"k": fits})
+		if err != nil {
+			t.Fatalf("NewCursorFromFields(at limit) error = %v", err)
+		}
+		if len(c.String()) > MaxCursorSize {
+			t.Fatalf("cursor length = %d, want <= %d", len(c.String()), MaxCursorSize)
+		}
+		if _, err := ParseCursor(c.String()); err != nil {
+			t.Errorf("ParseCursor(at limit) error = %v", err)
+		}
+
+		if _, err := NewCursorFromFields(map[string]any{"k": fits + "aaaa"}); !errors.Is(err, ErrCursorTooLarge) {
+			t.Errorf("NewCursorFromFields(too big) error = %v, want ErrCursorTooLarge", err)
+		}
+
+		// NewCursor does not enforce the limit, but IDs up to it round-trip.
+		longest := NewCursor(strings.Repeat("x", MaxCursorSize*3/4-len(`{"id":""}`)))
+		if len(longest.String()) != MaxCursorSize {
+			t.Fatalf("cursor length = %d, want %d", len(longest.String()), MaxCursorSize)
+		}
+		if got, err := ParseCursor(longest.String()); err != nil || got != longest {
+			t.Errorf("ParseCursor(longest NewCursor) = %v, %v, want round trip", got, err)
+		}
+
+		oversized := NewCursor(strings.Repeat("x", MaxCursorSize)).String()
+		if _, err := ParseCursor(oversized); !errors.Is(err, ErrCursorTooLarge) {
+			t.Errorf("ParseCursor(oversized) error = %v, want ErrCursorTooLarge", err)
+		}
+		var fromJSON Cursor
+		if err := json.Unmarshal([]byte(`"`+oversized+`"`), &fromJSON); !errors.Is(err, ErrCursorTooLarge) {
+			t.Errorf("UnmarshalJSON(oversized) error = %v, want ErrCursorTooLarge", err)
+		}
+	})
+
+	t.Run("Fields from built-in constructors", func(t *testing.T) {
+		c := NewCursorWithTimestamp("test-id", 1234567890)
+		fields, err := c.Fields()
+		if err != nil {
+			t.Fatalf("Fields() error = %v", err)
+		}
+		if fields["id"] != "test-id" || fields["ts"] != json.Number("1234567890") {
+			t.Errorf("Fields() = %v, want id and ts", fields)
+		}
+	})
+
+	t.Run("Fields from zero cursor", func(t *testing.T) {
+		var c Cursor
+		fields, err := c.Fields()
+		if err != nil {
+			t.Fatalf("Fields() error = %v", err)
+		}
+		if len(fields) != 0 {
+			t.Errorf("Fields() = %v, want empty", fields)
+		}
+	})
+
+	t.Run("Fields from invalid cursor", func(t *testing.T) {
+		c := Cursor{value: "invalid-base64!!!"}
+		if _, err := c.Fields(); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("Fields() error = %v, want ErrInvalidCursor", err)
+		}
+	})
+
+	t.Run("rejects mistyped fields", func(t *testing.T) {
+		for _, fields := range []map[string]any{
+			{"id": 7},
+			{"ts": "soon"},
+			{"ts": 1.5},
+			{"o": 1.5},
+			{"o": "3"},
+		} {
+			if _, err := NewCursorFromFields(fields); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("NewCursorFromFields(%v) error = %v, want ErrInvalidCursor", fields, err)
+			}
+		}
+	})
+
+	t.Run("ParseCursor rejects malformed payloads", func(t *testing.T) {
+		for _, payload := range []string{
+			`{"id":"a"}garbage`,
+			`{"id":"a"}{"id":"b"}`,
+			`{"id":123}`,
+			`{"id":null}`,
+			`{"ts":"soon"}`,
+			`{"ts":1.5}`,
+			`{"o":99999999999999999999}`,
+		} {
+			s := base64.URLEncoding.EncodeToString([]byte(payload))
+			if _, err := ParseCursor(s); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("ParseCursor(%s) error = %v, want ErrInvalidCursor", payload, err)
+			}
+		}
+	})
+
+	t.Run("ParseCursor allows trailing whitespace", func(t *testing.T) {
+		s := base64.URLEncoding.EncodeToString([]byte(`{"id":"a"}` + "\n"))
+		c, err := ParseCursor(s)
+		if err != nil {
+			t.Fatalf("ParseCursor() error = %v", err)
+		}
+		if c.ID() != "a" {
+			t.Errorf("ID() = %q, want %q", c.ID(), "a")
+		}
+	})
+
 	t.Run("IsZero", func(t *testing.T) {
 		var zero Cursor
 		if !zero.IsZero() {
@@ -627,6 +969,47 @@ func TestCursorRequest(t *testing.T) {
 			t.Errorf("Normalize().Limit = %d, want %d", c2.Limit, MaxLimit)
 		}
 	})
+
+	t.Run("SearchQuery", func(t *testing.T) {
+		c := NewCursorRequest().WithSearchQuery("  maputo airport  ")
+		if c.SearchQuery != "maputo airport" {
+			t.Errorf("WithSearchQuery() = %q, want %q", c.SearchQuery, "maputo airport")
+		}
+
+		tests := []struct {
+			name    string
+			query   string
+			maxLen  int
+			wantErr error
+		}{
+			{"empty", "", 10, nil},
+			{"normal", "maputo", 10, nil},
+			{"exact length", "ção ção ç", 9, nil},
+			{"too long", "maputo airport", 10, ErrSearchQueryTooLong},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := NewCursorRequest().WithSearchQuery(tt.query).ValidateSearchQuery(tt.maxLen)
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ValidateSearchQuery() error = %v, want %v", err, tt.wantErr)
+				}
+			})
+		}
+
+		long := NewCursorRequest().WithSearchQuery(strings.Repeat("a", MaxSearchQueryLength+1))
+		if err := long.Validate(); !errors.Is(err, ErrSearchQueryTooLong) {
+			t.Errorf("Validate() error = %v, want %v", err, ErrSearchQueryTooLong)
+		}
+
+		data, err := json.Marshal(NewCursorRequest().WithSearchQuery("maputo"))
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		if !strings.Contains(string(data), `"search_query":"maputo"`) {
+			t.Errorf("Marshal() = %s, want search_query field", data)
+		}
+	})
 }
 
 func TestCursorResponse(t *testing.T) {
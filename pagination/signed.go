@@ -0,0 +1,96 @@
+package pagination
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+// MinCursorKeyLength is the minimum HMAC key length, in bytes, accepted by
+// NewSignedCursorCodec.
+const MinCursorKeyLength = 32
+
+// cursorSignatureSep separates the cursor from its signature. It is not part
+// of the base64 URL alphabet, so it cannot appear in an unsigned cursor.
+const cursorSignatureSep = "."
+
+// ErrInvalidCursorSignature is returned when a signed cursor has been
+// tampered with, was signed with a different key, or is missing its
+// signature.
+var ErrInvalidCursorSignature = errors.New("invalid cursor signature")
+
+// ErrCursorKeyTooShort is returned when a cursor signing key is shorter than
+// MinCursorKeyLength.
+var ErrCursorKeyTooShort = errors.New("cursor signing key too short")
+
+// SignedCursorCodec signs cursors with HMAC-SHA256 so clients cannot edit
+// the position they encode. Encode the cursor before returning it to a
+// client and Decode what the client sends back:
+//
+//	codec, err := pagination.NewSignedCursorCodec(key)
+//	next := codec.Encode(pagination.NewCursor(lastID))
+//	cursor, err := codec.Decode(r.URL.Query().Get("cursor"))
+//
+// A codec is safe for concurrent use.
+type SignedCursorCodec struct {
+	key []byte
+
+	// AllowUnsigned makes Decode accept unsigned cursors, so cursors issued
+	// before signing was enabled stay readable during a migration window.
+	// Cursors carrying a signature are always verified.
+	AllowUnsigned bool
+}
+
+// NewSignedCursorCodec creates a codec signing with key, which must be at
+// least MinCursorKeyLength bytes. The key is copied.
+func NewSignedCursorCodec(key []byte) (*SignedCursorCodec, error) {
+	if len(key) < MinCursorKeyLength {
+		return nil, ErrCursorKeyTooShort
+	}
+	return &SignedCursorCodec{key: append([]byte(nil), key...)}, nil
+}
+
+// Encode returns the cursor followed by its signature.
+// The zero cursor encodes as an empty string. Decode accepts the result
+// unless the cursor itself exceeds MaxCursorSize, which only cursors from
+// NewCursor or NewCursorWithTimestamp with very long IDs can.
+func (s *SignedCursorCodec) Encode(c Cursor) string {
+	if c.IsZero() {
+		return ""
+	}
+	return c.value + cursorSignatureSep + base64.RawURLEncoding.EncodeToString(s.sign(c.value))
+}
+
+// Decode verifies a signed cursor and returns the cursor it carries.
+// An empty string decodes as the zero cursor. Returns
+// ErrInvalidCursorSignature if the signature does not match, or is missing
+// and AllowUnsigned is false, and ErrInvalidCursor if the cursor itself is
+// malformed.
+func (s *SignedCursorCodec) Decode(token string) (Cursor, error) {
+	if token == "" {
+		return Cursor{}, nil
+	}
+
+	payload, sig, signed := strings.Cut(token, cursorSignatureSep)
+	if !signed {
+		if !s.AllowUnsigned {
+			return Cursor{}, ErrInvalidCursorSignature
+		}
+		return ParseCursor(token)
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(mac, s.sign(payload)) {
+		return Cursor{}, ErrInvalidCursorSignature
+	}
+	return ParseCursor(payload)
+}
+
+// sign returns the HMAC-SHA256 of the cursor value.
+func (s *SignedCursorCodec) sign(value string) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(value))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,150 @@
+package pagination
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+var (
+	testCursorKey  = bytes.Repeat([]byte("k"), MinCursorKeyLength)
+	otherCursorKey = bytes.Repeat([]byte("o"), MinCursorKeyLength)
+)
+
+func mustSignedCursorCodec(t *testing.T, key []byte) *SignedCursorCodec {
+	t.Helper()
+	codec, err := NewSignedCursorCodec(key)
+	if err != nil {
+		t.Fatalf("NewSignedCursorCodec() error = %v", err)
+	}
+	return codec
+}
+
+func TestNewSignedCursorCodec(t *testing.T) {
+	if _, err := NewSignedCursorCodec(nil); !errors.Is(err, ErrCursorKeyTooShort) {
+		t.Errorf("NewSignedCursorCodec(nil) error = %v, want ErrCursorKeyTooShort", err)
+	}
+	if _, err := NewSignedCursorCodec(testCursorKey[:MinCursorKeyLength-1]); !errors.Is(err, ErrCursorKeyTooShort) {
+		t.Errorf("NewSignedCursorCodec(short) error = %v, want ErrCursorKeyTooShort", err)
+	}
+
+	key := bytes.Clone(testCursorKey)
+	codec := mustSignedCursorCodec(t, key)
+	token := codec.Encode(NewCursor("ride-1"))
+	key[0] = 'x'
+	if _, err := codec.Decode(token); err != nil {
+		t.Errorf("Decode() after caller modified key: error = %v, want key to be copied", err)
+	}
+}
+
+func TestSignedCursorCodec_RoundTrip(t *testing.T) {
+	codec := mustSignedCursorCodec(t, testCursorKey)
+	multi, err := NewCursorFromFields(map[string]any{"rating": 5, "id": "abc"})
+	if err != nil {
+		t.Fatalf("NewCursorFromFields() error = %v", err)
+	}
+	atLimit, err := NewCursorFromFields(map[string]any{"k": strings.Repeat("a", MaxCursorSize*3/4-len(`{"k":""}`))})
+	if err != nil {
+		t.Fatalf("NewCursorFromFields(at limit) error = %v", err)
+	}
+
+	cursors := []Cursor{
+		NewCursor("ride-123"),
+		NewCursorWithTimestamp("ride-456", 1700000000),
+		NewCursorWithOffset(40),
+		multi,
+		atLimit,
+		NewCursor(strings.Repeat("x", MaxCursorSize*3/4-len(`{"id":""}`))),
+	}
+	for _, c := range cursors {
+		token := codec.Encode(c)
+		if !strings.HasPrefix(token, c.String()+cursorSignatureSep) {
+			t.Errorf("Encode(%s) = %s, want cursor followed by signature", c, token)
+		}
+		got, err := codec.Decode(token)
+		if err != nil {
+			t.Fatalf("Decode(%s) error = %v", token, err)
+		}
+		if got != c {
+			t.Errorf("Decode(Encode(%s)) = %s", c, got)
+		}
+	}
+
+	if got := codec.Encode(Cursor{}); got != "" {
+		t.Errorf("Encode(zero) = %q, want empty", got)
+	}
+	if got, err := codec.Decode(""); err != nil || !got.IsZero() {
+		t.Errorf("Decode(\"\") = %v, %v, want zero cursor", got, err)
+	}
+}
+
+func TestSignedCursorCodec_Tampering(t *testing.T) {
+	codec := mustSignedCursorCodec(t, testCursorKey)
+	token := codec.Encode(NewCursorWithOffset(20))
+	payload, sig, _ := strings.Cut(token, cursorSignatureSep)
+
+	forged := NewCursorWithOffset(10000).String()
+	flipped := []byte(sig)
+	if flipped[0] == 'A' {
+		flipped[0] = 'B'
+	} else {
+		flipped[0] = 'A'
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"edited payload", forged + cursorSignatureSep + sig},
+		{"edited signature", payload + cursorSignatureSep + string(flipped)},
+		{"truncated signature", payload + cursorSignatureSep + sig[:len(sig)-2]},
+		{"empty signature", payload + cursorSignatureSep},
+		{"signature not base64", payload + cursorSignatureSep + "!!!"},
+		{"wrong key", mustSignedCursorCodec(t, otherCursorKey).Encode(NewCursorWithOffset(20))},
+		{"unsigned", payload},
+		{"unsigned forged", forged},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := codec.Decode(tt.token); !errors.Is(err, ErrInvalidCursorSignature) {
+				t.Errorf("Decode(%q) error = %v, want ErrInvalidCursorSignature", tt.token, err)
+			}
+		})
+	}
+}
+
+func TestSignedCursorCodec_AllowUnsigned(t *testing.T) {
+	codec := mustSignedCursorCodec(t, testCursorKey)
+	codec.AllowUnsigned = true
+
+	legacy := NewCursor("ride-789")
+	got, err := codec.Decode(legacy.String())
+	if err != nil {
+		t.Fatalf("Decode(unsigned) error = %v", err)
+	}
+	if got.ID() != "ride-789" {
+		t.Errorf("Decode(unsigned).ID() = %q, want ride-789", got.ID())
+	}
+
+	// Signed cursors are still verified.
+	token := mustSignedCursorCodec(t, otherCursorKey).Encode(legacy)
+	if _, err := codec.Decode(token); !errors.Is(err, ErrInvalidCursorSignature) {
+		t.Errorf("Decode(wrong key) error = %v, want ErrInvalidCursorSignature", err)
+	}
+
+	// Malformed unsigned cursors are rejected as invalid cursors.
+	if _, err := codec.Decode("not-base64!"); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("Decode(garbage) error = %v, want ErrInvalidCursor", err)
+	}
+}
+
+func TestSignedCursorCodec_InvalidPayload(t *testing.T) {
+	codec := mustSignedCursorCodec(t, testCursorKey)
+	payload := base64.URLEncoding.EncodeToString([]byte("not json"))
+	token := payload + cursorSignatureSep + base64.RawURLEncoding.EncodeToString(codec.sign(payload))
+	if _, err := codec.Decode(token); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("Decode(signed garbage) error = %v, want ErrInvalidCursor", err)
+	}
+}
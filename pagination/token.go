@@ -0,0 +1,100 @@
+package pagination
+
+// TokenPageRequest represents a pagination request for APIs that return
+// opaque page tokens instead of cursors or offsets. The token is passed
+// through unchanged; only the issuing service interprets it.
+type TokenPageRequest struct {
+	PageToken string        `json:"page_token,omitempty"`
+	Limit     int           `json:"limit"`
+	SortField string        `json:"sort_field,omitempty"`
+	SortDir   SortDirection `json:"sort_dir,omitempty"`
+}
+
+// NewTokenPageRequest creates a new TokenPageRequest with default values.
+func NewTokenPageRequest() TokenPageRequest {
+	return TokenPageRequest{
+		Limit:   DefaultLimit,
+		SortDir: SortAsc,
+	}
+}
+
+// WithToken sets the page token. An empty token requests the first page.
+func (r TokenPageRequest) WithToken(token string) TokenPageRequest {
+	r.PageToken = token
+	return r
+}
+
+// WithLimit sets the limit, clamping to valid range.
+func (r TokenPageRequest) WithLimit(limit int) TokenPageRequest {
+	if limit < MinLimit {
+		limit = MinLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+	r.Limit = limit
+	return r
+}
+
+// WithSort sets the sort field and direction.
+func (r TokenPageRequest) WithSort(field string, dir SortDirection) TokenPageRequest {
+	r.SortField = field
+	r.SortDir = dir
+	return r
+}
+
+// Validate checks if the TokenPageRequest is valid.
+func (r TokenPageRequest) Validate() error {
+	if r.Limit < MinLimit || r.Limit > MaxLimit {
+		return ErrInvalidLimit
+	}
+	if r.SortDir != "" && !r.SortDir.Valid() {
+		return ErrInvalidSortDirection
+	}
+	return nil
+}
+
+// Normalize ensures all values are within valid ranges.
+func (r TokenPageRequest) Normalize() TokenPageRequest {
+	if r.Limit < MinLimit {
+		r.Limit = DefaultLimit
+	}
+	if r.Limit > MaxLimit {
+		r.Limit = MaxLimit
+	}
+	if r.SortDir == "" {
+		r.SortDir = SortAsc
+	}
+	return r
+}
+
+// TokenPageResponse represents a page-token paginated response.
+// NextToken and PrevToken are empty when there is no such page.
+type TokenPageResponse[T any] struct {
+	Items     []T    `json:"items"`
+	NextToken string `json:"next_token,omitempty"`
+	PrevToken string `json:"prev_token,omitempty"`
+	HasMore   bool   `json:"has_more"`
+	Limit     int    `json:"limit"`
+}
+
+// NewTokenPageResponse creates a new TokenPageResponse.
+func NewTokenPageResponse[T any](items []T, nextToken, prevToken string, hasMore bool, limit int) TokenPageResponse[T] {
+	return TokenPageResponse[T]{
+		Items:     items,
+		NextToken: nextToken,
+		PrevToken: prevToken,
+		HasMore:   hasMore,
+		Limit:     limit,
+	}
+}
+
+// Empty returns true if the response has no items.
+func (r TokenPageResponse[T]) Empty() bool {
+	return len(r.Items) == 0
+}
+
+// Count returns the number of items in this page.
+func (r TokenPageResponse[T]) Count() int {
+	return len(r.Items)
+}
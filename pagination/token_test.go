@@ -0,0 +1,183 @@
+package pagination
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestTokenPageRequest(t *testing.T) {
+	t.Run("NewTokenPageRequest", func(t *testing.T) {
+		r := NewTokenPageRequest()
+		if r.Limit != DefaultLimit {
+			t.Errorf("Limit = %d, want %d", r.Limit, DefaultLimit)
+		}
+		if r.SortDir != SortAsc {
+			t.Errorf("SortDir = %v, want %v", r.SortDir, SortAsc)
+		}
+		if r.PageToken != "" {
+			t.Errorf("PageToken = %q, want empty", r.PageToken)
+		}
+	})
+
+	t.Run("builder", func(t *testing.T) {
+		r := NewTokenPageRequest().
+			WithToken("CiAKGjBpNDd2Nmp2Zml2cXRwYjBpOXA").
+			WithLimit(50).
+			WithSort("created_at", SortDesc)
+		if r.PageToken != "CiAKGjBpNDd2Nmp2Zml2cXRwYjBpOXA" {
+			t.Errorf("PageToken = %q", r.PageToken)
+		}
+		if r.Limit != 50 {
+			t.Errorf("Limit = %d, want 50", r.Limit)
+		}
+		if r.SortField != "created_at" || r.SortDir != SortDesc {
+			t.Errorf("sort = %q %v, want created_at desc", r.SortField, r.SortDir)
+		}
+
+		if got := r.WithToken("").PageToken; got != "" {
+			t.Errorf("WithToken(\"\").PageToken = %q, want empty", got)
+		}
+	})
+
+	t.Run("WithLimit", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			limit int
+			want  int
+		}{
+			{"normal", 50, 50},
+			{"below min", 0, MinLimit},
+			{"above max", 200, MaxLimit},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				r := NewTokenPageRequest().WithLimit(tt.limit)
+				if r.Limit != tt.want {
+					t.Errorf("WithLimit(%d).Limit = %d, want %d", tt.limit, r.Limit, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			request TokenPageRequest
+			wantErr error
+		}{
+			{"valid", NewTokenPageRequest(), nil},
+			{"valid with token", NewTokenPageRequest().WithToken("abc"), nil},
+			{"empty sort dir", TokenPageRequest{Limit: 20}, nil},
+			{"invalid limit", TokenPageRequest{Limit: 0}, ErrInvalidLimit},
+			{"limit too large", TokenPageRequest{Limit: MaxLimit + 1}, ErrInvalidLimit},
+			{"invalid sort", TokenPageRequest{Limit: 20, SortDir: "invalid"}, ErrInvalidSortDirection},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := tt.request.Validate()
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+				}
+			})
+		}
+	})
+
+	t.Run("Normalize", func(t *testing.T) {
+		r := TokenPageRequest{PageToken: "abc", Limit: 0, SortDir: ""}.Normalize()
+		if r.Limit != DefaultLimit {
+			t.Errorf("Normalize().Limit = %d, want %d", r.Limit, DefaultLimit)
+		}
+		if r.SortDir != SortAsc {
+			t.Errorf("Normalize().SortDir = %v, want %v", r.SortDir, SortAsc)
+		}
+		if r.PageToken != "abc" {
+			t.Errorf("Normalize().PageToken = %q, want abc", r.PageToken)
+		}
+
+		r2 := TokenPageRequest{Limit: 200, SortDir: SortDesc}.Normalize()
+		if r2.Limit != MaxLimit {
+			t.Errorf("Normalize().Limit = %d, want %d", r2.Limit, MaxLimit)
+		}
+		if r2.SortDir != SortDesc {
+			t.Errorf("Normalize().SortDir = %v, want %v", r2.SortDir, SortDesc)
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		r := NewTokenPageRequest().WithToken("tok").WithSort("name", SortDesc)
+		data, err := json.Marshal(r)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		want := `{"page_token":"tok","limit":20,"sort_field":"name","sort_dir":"desc"}`
+		if string(data) != want {
+			t.Errorf("Marshal() = %s, want %s", data, want)
+		}
+
+		var decoded TokenPageRequest
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if decoded != r {
+			t.Errorf("roundtrip = %+v, want %+v", decoded, r)
+		}
+
+		first, err := json.Marshal(NewTokenPageRequest())
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		if string(first) != `{"limit":20,"sort_dir":"asc"}` {
+			t.Errorf("Marshal() first page = %s", first)
+		}
+	})
+}
+
+func TestTokenPageResponse(t *testing.T) {
+	t.Run("NewTokenPageResponse", func(t *testing.T) {
+		resp := NewTokenPageResponse([]string{"a", "b", "c"}, "next", "prev", true, 10)
+		if resp.Count() != 3 {
+			t.Errorf("Count() = %d, want 3", resp.Count())
+		}
+		if resp.NextToken != "next" || resp.PrevToken != "prev" {
+			t.Errorf("tokens = %q/%q, want next/prev", resp.NextToken, resp.PrevToken)
+		}
+		if !resp.HasMore {
+			t.Error("HasMore = false, want true")
+		}
+		if resp.Limit != 10 {
+			t.Errorf("Limit = %d, want 10", resp.Limit)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		if !NewTokenPageResponse([]string{}, "", "", false, 20).Empty() {
+			t.Error("Empty() = false for empty response")
+		}
+		if NewTokenPageResponse([]string{"a"}, "", "", false, 20).Empty() {
+			t.Error("Empty() = true for non-empty response")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		resp := NewTokenPageResponse([]int{1, 2}, "n2", "", true, 2)
+		data, err := json.Marshal(resp)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		want := `{"items":[1,2],"next_token":"n2","has_more":true,"limit":2}`
+		if string(data) != want {
+			t.Errorf("Marshal() = %s, want %s", data, want)
+		}
+
+		var decoded TokenPageResponse[int]
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if decoded.NextToken != "n2" || decoded.PrevToken != "" || !decoded.HasMore || decoded.Limit != 2 || decoded.Count() != 2 {
+			t.Errorf("roundtrip = %+v, want %+v", decoded, resp)
+		}
+	})
+}
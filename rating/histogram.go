@@ -0,0 +1,137 @@
+package rating
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ErrEmptyHistogram is returned when a statistic is requested from a
+// histogram that has no ratings.
+var ErrEmptyHistogram = errors.New("histogram has no ratings")
+
+// Histogram counts ratings per star for building distribution charts.
+// Counts[0] holds the number of 1-star ratings and Counts[4] the number
+// of 5-star ratings. The zero value is an empty histogram ready to use.
+type Histogram struct {
+	Counts [MaxRating]int
+}
+
+// Add records a rating in the histogram. Zero (unset) ratings are ignored.
+func (h *Histogram) Add(r Rating) {
+	if r.IsZero() {
+		return
+	}
+	h.Counts[r.value-MinRating]++
+}
+
+// Total returns the number of ratings in the histogram.
+func (h Histogram) Total() int {
+	total := 0
+	for _, c := range h.Counts {
+		total += c
+	}
+	return total
+}
+
+// Percentage returns the share of ratings with the given number of stars,
+// between 0 and 100. Returns 0 for an empty histogram or a star value
+// outside 1-5.
+func (h Histogram) Percentage(star int) float64 {
+	if star < MinRating || star > MaxRating {
+		return 0
+	}
+	total := h.Total()
+	if total == 0 {
+		return 0
+	}
+	return float64(h.Counts[star-MinRating]) * 100 / float64(total)
+}
+
+// MostCommon returns the rating with the highest count.
+// Ties are resolved in favor of the higher rating.
+// Returns ErrEmptyHistogram if no ratings have been added.
+func (h Histogram) MostCommon() (Rating, error) {
+	if h.Total() == 0 {
+		return Rating{}, ErrEmptyHistogram
+	}
+	best := MaxRating
+	for star := MaxRating - 1; star >= MinRating; star-- {
+		if h.Counts[star-MinRating] > h.Counts[best-MinRating] {
+			best = star
+		}
+	}
+	return Rating{value: best}, nil
+}
+
+// LeastCommon returns the rating with the lowest count, including ratings
+// that were never given. Ties are resolved in favor of the lower rating.
+// Returns ErrEmptyHistogram if no ratings have been added.
+func (h Histogram) LeastCommon() (Rating, error) {
+	if h.Total() == 0 {
+		return Rating{}, ErrEmptyHistogram
+	}
+	least := MinRating
+	for star := MinRating + 1; star <= MaxRating; star++ {
+		if h.Counts[star-MinRating] < h.Counts[least-MinRating] {
+			least = star
+		}
+	}
+	return Rating{value: least}, nil
+}
+
+// Median returns the median rating. For an even number of ratings the lower
+// of the two middle ratings is returned, so the result is always a valid
+// Rating. Returns ErrEmptyHistogram if no ratings have been added.
+func (h Histogram) Median() (Rating, error) {
+	total := h.Total()
+	if total == 0 {
+		return Rating{}, ErrEmptyHistogram
+	}
+	// Zero-based position of the lower middle rating.
+	target := (total - 1) / 2
+	seen := 0
+	for star := MinRating; star <= MaxRating; star++ {
+		seen += h.Counts[star-MinRating]
+		if seen > target {
+			return Rating{value: star}, nil
+		}
+	}
+	// Unreachable: seen reaches total on the last iteration.
+	return Rating{value: MaxRating}, nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// The histogram is encoded as {"1":n,"2":n,"3":n,"4":n,"5":n}.
+func (h Histogram) MarshalJSON() ([]byte, error) {
+	m := make(map[string]int, MaxRating)
+	for star := MinRating; star <= MaxRating; star++ {
+		m[strconv.Itoa(star)] = h.Counts[star-MinRating]
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// Missing stars are treated as zero; keys outside 1-5 and negative counts
+// are rejected.
+func (h *Histogram) UnmarshalJSON(data []byte) error {
+	var m map[string]int
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	var parsed Histogram
+	for key, count := range m {
+		star, err := strconv.Atoi(key)
+		if err != nil || star < MinRating || star > MaxRating {
+			return fmt.Errorf("invalid histogram star %q: %w", key, ErrInvalidRating)
+		}
+		if count < 0 {
+			return fmt.Errorf("negative count %d for star %d", count, star)
+		}
+		parsed.Counts[star-MinRating] = count
+	}
+	*h = parsed
+	return nil
+}
@@ -0,0 +1,192 @@
+package rating
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func newHistogram(ratings ...int) Histogram {
+	var h Histogram
+	for _, r := range ratings {
+		h.Add(MustNewRating(r))
+	}
+	return h
+}
+
+func TestHistogram_Add(t *testing.T) {
+	h := newHistogram(5, 5, 4, 1)
+	h.Add(Rating{})
+
+	want := [MaxRating]int{1, 0, 0, 1, 2}
+	if h.Counts != want {
+		t.Errorf("Counts = %v, want %v", h.Counts, want)
+	}
+	if got := h.Total(); got != 4 {
+		t.Errorf("Total() = %d, want 4", got)
+	}
+}
+
+func TestHistogram_Percentage(t *testing.T) {
+	h := newHistogram(5, 5, 5, 4, 4, 3, 1)
+
+	sum := 0.0
+	for star := MinRating; star <= MaxRating; star++ {
+		sum += h.Percentage(star)
+	}
+	if math.Abs(sum-100) > 1e-9 {
+		t.Errorf("percentages sum to %v, want ~100", sum)
+	}
+
+	if got, want := h.Percentage(5), 300.0/7; math.Abs(got-want) > 1e-9 {
+		t.Errorf("Percentage(5) = %v, want %v", got, want)
+	}
+	if got := h.Percentage(2); got != 0 {
+		t.Errorf("Percentage(2) = %v, want 0", got)
+	}
+
+	for _, star := range []int{0, 6, -1} {
+		if got := h.Percentage(star); got != 0 {
+			t.Errorf("Percentage(%d) = %v, want 0", star, got)
+		}
+	}
+
+	var empty Histogram
+	if got := empty.Percentage(3); got != 0 {
+		t.Errorf("empty Percentage(3) = %v, want 0", got)
+	}
+}
+
+func TestHistogram_MostLeastCommon(t *testing.T) {
+	tests := []struct {
+		name      string
+		ratings   []int
+		wantMost  int
+		wantLeast int
+	}{
+		{"single rating", []int{3}, 3, 1},
+		{"clear winner", []int{5, 5, 5, 4, 1, 2, 3}, 5, 1},
+		{"tie prefers extremes", []int{1, 1, 4, 4, 2, 3, 5}, 4, 2},
+		{"all equal", []int{1, 2, 3, 4, 5}, 5, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newHistogram(tt.ratings...)
+			most, err := h.MostCommon()
+			if err != nil {
+				t.Fatalf("MostCommon() error = %v", err)
+			}
+			if most.Int() != tt.wantMost {
+				t.Errorf("MostCommon() = %d, want %d", most.Int(), tt.wantMost)
+			}
+			least, err := h.LeastCommon()
+			if err != nil {
+				t.Fatalf("LeastCommon() error = %v", err)
+			}
+			if least.Int() != tt.wantLeast {
+				t.Errorf("LeastCommon() = %d, want %d", least.Int(), tt.wantLeast)
+			}
+		})
+	}
+}
+
+func TestHistogram_Median(t *testing.T) {
+	tests := []struct {
+		name    string
+		ratings []int
+		want    int
+	}{
+		{"single", []int{4}, 4},
+		{"odd count", []int{1, 3, 5}, 3},
+		{"even count takes lower middle", []int{2, 4}, 2},
+		{"skewed", []int{5, 5, 5, 5, 1}, 5},
+		{"spans buckets", []int{1, 1, 2, 5, 5, 5}, 2},
+		{"all same", []int{3, 3, 3, 3}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newHistogram(tt.ratings...)
+			got, err := h.Median()
+			if err != nil {
+				t.Fatalf("Median() error = %v", err)
+			}
+			if got.Int() != tt.want {
+				t.Errorf("Median() = %d, want %d", got.Int(), tt.want)
+			}
+		})
+	}
+}
+
+func TestHistogram_Empty(t *testing.T) {
+	var h Histogram
+	if h.Total() != 0 {
+		t.Errorf("Total() = %d, want 0", h.Total())
+	}
+	if _, err := h.MostCommon(); !errors.Is(err, ErrEmptyHistogram) {
+		t.Errorf("MostCommon() error = %v, want ErrEmptyHistogram", err)
+	}
+	if _, err := h.LeastCommon(); !errors.Is(err, ErrEmptyHistogram) {
+		t.Errorf("LeastCommon() error = %v, want ErrEmptyHistogram", err)
+	}
+	if _, err := h.Median(); !errors.Is(err, ErrEmptyHistogram) {
+		t.Errorf("Median() error = %v, want ErrEmptyHistogram", err)
+	}
+}
+
+func TestHistogram_JSON(t *testing.T) {
+	t.Run("marshal", func(t *testing.T) {
+		h := newHistogram(5, 5, 4, 1)
+		data, err := json.Marshal(h)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		want := `{"1":1,"2":0,"3":0,"4":1,"5":2}`
+		if string(data) != want {
+			t.Errorf("Marshal() = %s, want %s", data, want)
+		}
+	})
+
+	t.Run("roundtrip", func(t *testing.T) {
+		original := newHistogram(1, 2, 2, 3, 3, 3, 5)
+		data, err := json.Marshal(original)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		var decoded Histogram
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if decoded != original {
+			t.Errorf("roundtrip = %v, want %v", decoded, original)
+		}
+	})
+
+	t.Run("missing stars are zero", func(t *testing.T) {
+		var h Histogram
+		if err := json.Unmarshal([]byte(`{"5":3}`), &h); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		want := [MaxRating]int{0, 0, 0, 0, 3}
+		if h.Counts != want {
+			t.Errorf("Counts = %v, want %v", h.Counts, want)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		inputs := []string{
+			`{"0":1}`,
+			`{"6":1}`,
+			`{"x":1}`,
+			`{"3":-1}`,
+			`[1,2,3,4,5]`,
+			`{"3":"many"}`,
+		}
+		for _, input := range inputs {
+			var h Histogram
+			if err := json.Unmarshal([]byte(input), &h); err == nil {
+				t.Errorf("Unmarshal(%s) expected error", input)
+			}
+		}
+	})
+}
@@ -16,11 +16,19 @@ const (
 	MinRating = 1
 	// MaxRating is the maximum valid rating value.
 	MaxRating = 5
+
+	// MinNPSScore is the minimum valid Net Promoter Score.
+	MinNPSScore = 0
+	// MaxNPSScore is the maximum valid Net Promoter Score.
+	MaxNPSScore = 10
 )
 
 var (
 	// ErrInvalidRating is returned when a rating is out of the valid range.
 	ErrInvalidRating = errors.New("rating must be between 1 and 5")
+
+	// ErrInvalidNPSScore is returned when a Net Promoter Score is out of range.
+	ErrInvalidNPSScore = errors.New("NPS score must be between 0 and 10")
 )
 
 // Rating represents a validated rating value (1-5).
@@ -60,6 +68,22 @@ func ParseRating(s string) (Rating, error) {
 	return NewRating(value)
 }
 
+// FromNPS converts a Net Promoter Score (0-10) to a Rating.
+// Detractors (0-6) map to 1 star, passives (7-8) to 3 stars and
+// promoters (9-10) to 5 stars.
+func FromNPS(score int) (Rating, error) {
+	switch {
+	case score < MinNPSScore || score > MaxNPSScore:
+		return Rating{}, ErrInvalidNPSScore
+	case score <= 6:
+		return Rating{value: 1}, nil
+	case score <= 8:
+		return Rating{value: 3}, nil
+	default:
+		return Rating{value: 5}, nil
+	}
+}
+
 // Int returns the integer value of the rating.
 func (r Rating) Int() int {
 	return r.value
@@ -93,6 +117,22 @@ func (r Rating) IsPoor() bool {
 	return r.value > 0 && r.value <= 2
 }
 
+// ToNPSRange returns the inclusive range of Net Promoter Scores that FromNPS
+// maps to this rating. Returns (-1, -1) for ratings that no NPS score maps
+// to (2 and 4 stars, or the zero value).
+func (r Rating) ToNPSRange() (minScore, maxScore int) {
+	switch r.value {
+	case 1:
+		return 0, 6
+	case 3:
+		return 7, 8
+	case 5:
+		return 9, 10
+	default:
+		return -1, -1
+	}
+}
+
 // MarshalJSON implements json.Marshaler.
 func (r Rating) MarshalJSON() ([]byte, error) {
 	if r.IsZero() {
@@ -2,6 +2,7 @@ package rating
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 )
 
@@ -99,6 +100,70 @@ func TestParseRating(t *testing.T) {
 	}
 }
 
+func TestFromNPS(t *testing.T) {
+	tests := []struct {
+		name    string
+		score   int
+		want    int
+		wantErr error
+	}{
+		{"below range", -1, 0, ErrInvalidNPSScore},
+		{"detractor lower bound", 0, 1, nil},
+		{"detractor upper bound", 6, 1, nil},
+		{"passive lower bound", 7, 3, nil},
+		{"passive upper bound", 8, 3, nil},
+		{"promoter lower bound", 9, 5, nil},
+		{"promoter upper bound", 10, 5, nil},
+		{"above range", 11, 0, ErrInvalidNPSScore},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromNPS(tt.score)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FromNPS(%d) error = %v, want %v", tt.score, err, tt.wantErr)
+			}
+			if got.Int() != tt.want {
+				t.Errorf("FromNPS(%d) = %d, want %d", tt.score, got.Int(), tt.want)
+			}
+		})
+	}
+}
+
+func TestRating_ToNPSRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		rating  Rating
+		wantMin int
+		wantMax int
+	}{
+		{"1 star", MustNewRating(1), 0, 6},
+		{"2 stars", MustNewRating(2), -1, -1},
+		{"3 stars", MustNewRating(3), 7, 8},
+		{"4 stars", MustNewRating(4), -1, -1},
+		{"5 stars", MustNewRating(5), 9, 10},
+		{"zero value", Rating{}, -1, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMin, gotMax := tt.rating.ToNPSRange()
+			if gotMin != tt.wantMin || gotMax != tt.wantMax {
+				t.Errorf("ToNPSRange() = (%d, %d), want (%d, %d)", gotMin, gotMax, tt.wantMin, tt.wantMax)
+			}
+			if gotMin < 0 {
+				return
+			}
+			for _, score := range []int{gotMin, gotMax} {
+				r, err := FromNPS(score)
+				if err != nil || r != tt.rating {
+					t.Errorf("FromNPS(%d) = %v, %v; want %v", score, r, err, tt.rating)
+				}
+			}
+		})
+	}
+}
+
 func TestRating_String(t *testing.T) {
 	tests := []struct {
 		name   string
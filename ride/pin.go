@@ -3,6 +3,7 @@ package ride
 
 import (
 	"crypto/rand"
+	"crypto/subtle"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
@@ -20,6 +21,9 @@ var (
 
 	// ErrRepeatedPIN is returned when the PIN contains all repeated digits.
 	ErrRepeatedPIN = errors.New("PIN cannot contain all repeated digits")
+
+	// ErrMalformedPINCandidate is returned when a candidate PIN is not exactly 4 digits.
+	ErrMalformedPINCandidate = errors.New("malformed PIN candidate: must be exactly 4 digits")
 )
 
 // PIN represents a validated 4-digit ride verification code.
@@ -110,6 +114,21 @@ func (p PIN) IsZero() bool {
 	return p.value == ""
 }
 
+// Matches reports whether candidate equals the PIN using a constant-time
+// comparison. A candidate that is not exactly 4 digits returns
+// ErrMalformedPINCandidate, so callers can tell bad input from a wrong PIN.
+// A well-formed but incorrect candidate returns false and a nil error.
+// The zero PIN matches no candidate.
+func (p PIN) Matches(candidate string) (bool, error) {
+	if !pinRegex.MatchString(candidate) {
+		return false, ErrMalformedPINCandidate
+	}
+	if p.IsZero() {
+		return false, nil
+	}
+	return subtle.ConstantTimeCompare([]byte(p.value), []byte(candidate)) == 1, nil
+}
+
 // MarshalJSON implements json.Marshaler.
 func (p PIN) MarshalJSON() ([]byte, error) {
 	return json.Marshal(p.value)
@@ -2,6 +2,7 @@ package ride
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 )
 
@@ -166,6 +167,43 @@ func TestPIN_IsZero(t *testing.T) {
 	}
 }
 
+// TestPIN_Matches documents the security contract of Matches: malformed
+// input is an error, a wrong but well-formed PIN is simply false.
+func TestPIN_Matches(t *testing.T) {
+	pin := MustParsePIN("7392")
+
+	tests := []struct {
+		name      string
+		pin       PIN
+		candidate string
+		want      bool
+		wantErr   error
+	}{
+		{"correct PIN", pin, "7392", true, nil},
+		{"wrong PIN", pin, "7393", false, nil},
+		{"wrong sequential PIN", pin, "1234", false, nil},
+		{"wrong repeated PIN", pin, "0000", false, nil},
+		{"empty", pin, "", false, ErrMalformedPINCandidate},
+		{"too short", pin, "739", false, ErrMalformedPINCandidate},
+		{"too long", pin, "73920", false, ErrMalformedPINCandidate},
+		{"letters", pin, "73a2", false, ErrMalformedPINCandidate},
+		{"surrounding whitespace", pin, " 7392", false, ErrMalformedPINCandidate},
+		{"zero PIN", PIN{}, "7392", false, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.pin.Matches(tt.candidate)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Matches(%q) error = %v, want %v", tt.candidate, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Matches(%q) = %v, want %v", tt.candidate, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestPIN_JSON(t *testing.T) {
 	t.Run("marshal", func(t *testing.T) {
 		p := MustParsePIN("7392")
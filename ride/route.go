@@ -0,0 +1,95 @@
+package ride
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/Dorico-Dynamics/txova-go-types/geo"
+)
+
+var (
+	// ErrEmptyInstruction is returned when a route step has no instruction.
+	ErrEmptyInstruction = errors.New("route step instruction cannot be empty")
+
+	// ErrNegativeDistance is returned when a route step has a negative distance.
+	ErrNegativeDistance = errors.New("route step distance cannot be negative")
+
+	// ErrNegativeDuration is returned when a route step has a negative duration.
+	ErrNegativeDuration = errors.New("route step duration cannot be negative")
+)
+
+// RouteStep represents a single navigation instruction along a ride's route,
+// such as "Turn left onto Av. Julius Nyerere".
+type RouteStep struct {
+	Location        geo.Location `json:"location"`
+	Instruction     string       `json:"instruction"`
+	DistanceKM      float64      `json:"distance_km"`
+	DurationSeconds int          `json:"duration_seconds"`
+}
+
+// NewRouteStep creates a new RouteStep with validation.
+// The instruction is trimmed and must not be empty; distance and duration
+// must not be negative.
+func NewRouteStep(loc geo.Location, instruction string, distKM float64, durSec int) (RouteStep, error) {
+	instruction = strings.TrimSpace(instruction)
+	if instruction == "" {
+		return RouteStep{}, ErrEmptyInstruction
+	}
+	if distKM < 0 {
+		return RouteStep{}, ErrNegativeDistance
+	}
+	if durSec < 0 {
+		return RouteStep{}, ErrNegativeDuration
+	}
+	return RouteStep{
+		Location:        loc,
+		Instruction:     instruction,
+		DistanceKM:      distKM,
+		DurationSeconds: durSec,
+	}, nil
+}
+
+// routeStepJSON is used for JSON unmarshaling without recursion.
+type routeStepJSON struct {
+	Location        geo.Location `json:"location"`
+	Instruction     string       `json:"instruction"`
+	DistanceKM      float64      `json:"distance_km"`
+	DurationSeconds int          `json:"duration_seconds"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// The decoded step is validated with the same rules as NewRouteStep.
+func (r *RouteStep) UnmarshalJSON(data []byte) error {
+	var rj routeStepJSON
+	if err := json.Unmarshal(data, &rj); err != nil {
+		return err
+	}
+	step, err := NewRouteStep(rj.Location, rj.Instruction, rj.DistanceKM, rj.DurationSeconds)
+	if err != nil {
+		return err
+	}
+	*r = step
+	return nil
+}
+
+// Route is an ordered sequence of navigation steps.
+type Route []RouteStep
+
+// TotalDistanceKM returns the sum of all step distances in kilometers.
+func (r Route) TotalDistanceKM() float64 {
+	var total float64
+	for _, step := range r {
+		total += step.DistanceKM
+	}
+	return total
+}
+
+// TotalDurationSeconds returns the sum of all step durations in seconds.
+func (r Route) TotalDurationSeconds() int {
+	total := 0
+	for _, step := range r {
+		total += step.DurationSeconds
+	}
+	return total
+}
@@ -0,0 +1,161 @@
+package ride
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/Dorico-Dynamics/txova-go-types/geo"
+)
+
+func TestNewRouteStep(t *testing.T) {
+	loc := geo.MustNewLocation(-25.9692, 32.5732)
+
+	tests := []struct {
+		name        string
+		instruction string
+		distKM      float64
+		durSec      int
+		wantErr     error
+	}{
+		{"valid", "Turn left onto Av. Julius Nyerere", 0.4, 60, nil},
+		{"zero distance and duration", "Arrive at destination", 0, 0, nil},
+		{"empty instruction", "", 0.4, 60, ErrEmptyInstruction},
+		{"whitespace instruction", "   ", 0.4, 60, ErrEmptyInstruction},
+		{"negative distance", "Continue straight", -0.1, 60, ErrNegativeDistance},
+		{"negative duration", "Continue straight", 0.4, -1, ErrNegativeDuration},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, err := NewRouteStep(loc, tt.instruction, tt.distKM, tt.durSec)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewRouteStep() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if step.Location != loc {
+				t.Errorf("Location = %v, want %v", step.Location, loc)
+			}
+			if step.Instruction != tt.instruction {
+				t.Errorf("Instruction = %q, want %q", step.Instruction, tt.instruction)
+			}
+			if step.DistanceKM != tt.distKM {
+				t.Errorf("DistanceKM = %v, want %v", step.DistanceKM, tt.distKM)
+			}
+			if step.DurationSeconds != tt.durSec {
+				t.Errorf("DurationSeconds = %v, want %v", step.DurationSeconds, tt.durSec)
+			}
+		})
+	}
+
+	t.Run("instruction is trimmed", func(t *testing.T) {
+		step, err := NewRouteStep(loc, "  Turn right  ", 1, 1)
+		if err != nil {
+			t.Fatalf("NewRouteStep() error = %v", err)
+		}
+		if step.Instruction != "Turn right" {
+			t.Errorf("Instruction = %q, want %q", step.Instruction, "Turn right")
+		}
+	})
+}
+
+func TestRouteStep_JSON(t *testing.T) {
+	loc := geo.MustNewLocation(-25.9692, 32.5732)
+	step, err := NewRouteStep(loc, "Turn left", 0.5, 90)
+	if err != nil {
+		t.Fatalf("NewRouteStep() error = %v", err)
+	}
+
+	t.Run("marshal", func(t *testing.T) {
+		data, err := json.Marshal(step)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		want := `{"location":{"latitude":-25.9692,"longitude":32.5732},"instruction":"Turn left","distance_km":0.5,"duration_seconds":90}`
+		if string(data) != want {
+			t.Errorf("Marshal() = %s, want %s", data, want)
+		}
+	})
+
+	t.Run("roundtrip", func(t *testing.T) {
+		data, _ := json.Marshal(step)
+		var decoded RouteStep
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if decoded != step {
+			t.Errorf("roundtrip = %+v, want %+v", decoded, step)
+		}
+	})
+
+	t.Run("unmarshal invalid step", func(t *testing.T) {
+		data := []byte(`{"location":{"latitude":0,"longitude":0},"instruction":"Go","distance_km":-1,"duration_seconds":0}`)
+		var decoded RouteStep
+		if err := json.Unmarshal(data, &decoded); !errors.Is(err, ErrNegativeDistance) {
+			t.Errorf("Unmarshal() error = %v, want ErrNegativeDistance", err)
+		}
+	})
+
+	t.Run("unmarshal invalid location", func(t *testing.T) {
+		data := []byte(`{"location":{"latitude":100,"longitude":0},"instruction":"Go"}`)
+		var decoded RouteStep
+		if err := json.Unmarshal(data, &decoded); err == nil {
+			t.Error("Unmarshal() should return error for invalid location")
+		}
+	})
+
+	t.Run("unmarshal invalid JSON", func(t *testing.T) {
+		var decoded RouteStep
+		if err := json.Unmarshal([]byte(`"step"`), &decoded); err == nil {
+			t.Error("Unmarshal() should return error for invalid JSON")
+		}
+	})
+}
+
+func TestRoute_Totals(t *testing.T) {
+	loc := geo.MustNewLocation(-25.9692, 32.5732)
+
+	t.Run("empty route", func(t *testing.T) {
+		var route Route
+		if got := route.TotalDistanceKM(); got != 0 {
+			t.Errorf("TotalDistanceKM() = %v, want 0", got)
+		}
+		if got := route.TotalDurationSeconds(); got != 0 {
+			t.Errorf("TotalDurationSeconds() = %v, want 0", got)
+		}
+	})
+
+	t.Run("multiple steps", func(t *testing.T) {
+		route := Route{
+			{Location: loc, Instruction: "Head north", DistanceKM: 1.2, DurationSeconds: 180},
+			{Location: loc, Instruction: "Turn right", DistanceKM: 0.3, DurationSeconds: 45},
+			{Location: loc, Instruction: "Arrive", DistanceKM: 0, DurationSeconds: 0},
+		}
+		if got := route.TotalDistanceKM(); math.Abs(got-1.5) > 1e-9 {
+			t.Errorf("TotalDistanceKM() = %v, want 1.5", got)
+		}
+		if got := route.TotalDurationSeconds(); got != 225 {
+			t.Errorf("TotalDurationSeconds() = %v, want 225", got)
+		}
+	})
+
+	t.Run("JSON roundtrip", func(t *testing.T) {
+		route := Route{
+			{Location: loc, Instruction: "Head north", DistanceKM: 1.2, DurationSeconds: 180},
+		}
+		data, err := json.Marshal(route)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		var decoded Route
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if len(decoded) != 1 || decoded[0] != route[0] {
+			t.Errorf("roundtrip = %+v, want %+v", decoded, route)
+		}
+	})
+}
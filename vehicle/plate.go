@@ -58,6 +58,38 @@ func (p ProvinceCode) ProvinceName() string {
 	return validProvinceCodes[p]
 }
 
+// IsUrban returns true if the code is for a city-level registration area
+// (currently only Maputo City).
+func (p ProvinceCode) IsUrban() bool {
+	return p == ProvinceCodeMaputoCity
+}
+
+// IsProvince returns true if the code is a valid provincial (non-city) code.
+func (p ProvinceCode) IsProvince() bool {
+	return p.Valid() && !p.IsUrban()
+}
+
+// UrbanProvinceCodes returns the city-level province codes.
+func UrbanProvinceCodes() []ProvinceCode {
+	return []ProvinceCode{ProvinceCodeMaputoCity}
+}
+
+// RuralProvinceCodes returns the provincial (non-city) province codes.
+func RuralProvinceCodes() []ProvinceCode {
+	return []ProvinceCode{
+		ProvinceCodeMaputoProvince,
+		ProvinceCodeGaza,
+		ProvinceCodeInhambane,
+		ProvinceCodeSofala,
+		ProvinceCodeManica,
+		ProvinceCodeTete,
+		ProvinceCodeZambezia,
+		ProvinceCodeNampula,
+		ProvinceCodeCaboDelgado,
+		ProvinceCodeNiassa,
+	}
+}
+
 var (
 	// ErrInvalidLicensePlate is returned when a license plate cannot be parsed.
 	ErrInvalidLicensePlate = errors.New("invalid license plate")
@@ -0,0 +1,159 @@
+package vehicle
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// PlateState represents the registration state of a license plate.
+type PlateState string
+
+const (
+	PlateStateValid     PlateState = "valid"
+	PlateStateExpired   PlateState = "expired"
+	PlateStateSuspended PlateState = "suspended"
+)
+
+// ErrInvalidPlateState is returned when parsing an invalid plate state.
+var ErrInvalidPlateState = errors.New("invalid plate state")
+
+// ParsePlateState parses a string into a PlateState.
+func ParsePlateState(s string) (PlateState, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "valid":
+		return PlateStateValid, nil
+	case "expired":
+		return PlateStateExpired, nil
+	case "suspended":
+		return PlateStateSuspended, nil
+	default:
+		return "", ErrInvalidPlateState
+	}
+}
+
+// String returns the string representation.
+func (s PlateState) String() string {
+	return string(s)
+}
+
+// Valid returns true if the PlateState is valid.
+func (s PlateState) Valid() bool {
+	switch s {
+	case PlateStateValid, PlateStateExpired, PlateStateSuspended:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s PlateState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(s))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *PlateState) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	parsed, err := ParsePlateState(str)
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PlateState) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PlateState) UnmarshalText(data []byte) error {
+	parsed, err := ParsePlateState(string(data))
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
+
+// Scan implements sql.Scanner.
+func (s *PlateState) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		parsed, err := ParsePlateState(v)
+		if err != nil {
+			return err
+		}
+		*s = parsed
+		return nil
+	case []byte:
+		parsed, err := ParsePlateState(string(v))
+		if err != nil {
+			return err
+		}
+		*s = parsed
+		return nil
+	case nil:
+		*s = ""
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into PlateState", src)
+	}
+}
+
+// Value implements driver.Valuer.
+func (s PlateState) Value() (driver.Value, error) {
+	if s == "" {
+		return nil, nil
+	}
+	return string(s), nil
+}
+
+// PlateWithState pairs a license plate with its registration state.
+// In SQL the two fields map to separate columns; LicensePlate and PlateState
+// each implement sql.Scanner and driver.Valuer.
+type PlateWithState struct {
+	Plate LicensePlate `json:"plate"`
+	State PlateState   `json:"state"`
+}
+
+// NewPlateWithState creates a PlateWithState.
+// Returns ErrInvalidLicensePlate for a zero plate and ErrInvalidPlateState
+// for an invalid state.
+func NewPlateWithState(plate LicensePlate, state PlateState) (PlateWithState, error) {
+	if plate.IsZero() {
+		return PlateWithState{}, ErrInvalidLicensePlate
+	}
+	if !state.Valid() {
+		return PlateWithState{}, ErrInvalidPlateState
+	}
+	return PlateWithState{Plate: plate, State: state}, nil
+}
+
+// IsActive returns true if the plate is registered and in good standing.
+func (p PlateWithState) IsActive() bool {
+	return p.State == PlateStateValid
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating both fields with
+// the same rules as NewPlateWithState.
+func (p *PlateWithState) UnmarshalJSON(data []byte) error {
+	type plateWithState PlateWithState
+	var raw plateWithState
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	parsed, err := NewPlateWithState(raw.Plate, raw.State)
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
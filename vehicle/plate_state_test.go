@@ -0,0 +1,188 @@
+package vehicle
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParsePlateState(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    PlateState
+		wantErr bool
+	}{
+		{"valid", "valid", PlateStateValid, false},
+		{"expired", "expired", PlateStateExpired, false},
+		{"suspended", "suspended", PlateStateSuspended, false},
+		{"uppercase with spaces", " EXPIRED ", PlateStateExpired, false},
+		{"empty", "", "", true},
+		{"invalid", "stolen", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePlateState(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParsePlateState(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParsePlateState(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlateState_Valid(t *testing.T) {
+	for _, s := range []PlateState{PlateStateValid, PlateStateExpired, PlateStateSuspended} {
+		if !s.Valid() {
+			t.Errorf("%q.Valid() = false, want true", s)
+		}
+		if s.String() != string(s) {
+			t.Errorf("String() = %q, want %q", s.String(), string(s))
+		}
+	}
+	if PlateState("stolen").Valid() {
+		t.Error(`PlateState("stolen").Valid() = true, want false`)
+	}
+}
+
+func TestPlateState_JSON(t *testing.T) {
+	data, err := json.Marshal(PlateStateSuspended)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if string(data) != `"suspended"` {
+		t.Errorf("Marshal() = %s, want %q", data, "suspended")
+	}
+
+	var s PlateState
+	if err := json.Unmarshal(data, &s); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if s != PlateStateSuspended {
+		t.Errorf("Unmarshal() = %v, want %v", s, PlateStateSuspended)
+	}
+
+	if err := json.Unmarshal([]byte(`"stolen"`), &s); !errors.Is(err, ErrInvalidPlateState) {
+		t.Errorf("Unmarshal(invalid) error = %v, want %v", err, ErrInvalidPlateState)
+	}
+}
+
+func TestPlateState_SQL(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     interface{}
+		want    PlateState
+		wantErr bool
+	}{
+		{"string", "expired", PlateStateExpired, false},
+		{"bytes", []byte("valid"), PlateStateValid, false},
+		{"nil", nil, "", false},
+		{"invalid string", "stolen", "", true},
+		{"invalid type", 42, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s PlateState
+			err := s.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan(%v) error = %v, wantErr %v", tt.src, err, tt.wantErr)
+				return
+			}
+			if s != tt.want {
+				t.Errorf("Scan(%v) = %v, want %v", tt.src, s, tt.want)
+			}
+		})
+	}
+
+	v, err := PlateStateExpired.Value()
+	if err != nil || v != "expired" {
+		t.Errorf("Value() = %v, %v, want expired, nil", v, err)
+	}
+	v, err = PlateState("").Value()
+	if err != nil || v != nil {
+		t.Errorf("zero Value() = %v, %v, want nil, nil", v, err)
+	}
+}
+
+func TestNewPlateWithState(t *testing.T) {
+	plate := MustParseLicensePlate("AAA-123-MC")
+
+	tests := []struct {
+		name    string
+		plate   LicensePlate
+		state   PlateState
+		wantErr error
+	}{
+		{"valid", plate, PlateStateValid, nil},
+		{"expired", plate, PlateStateExpired, nil},
+		{"suspended", plate, PlateStateSuspended, nil},
+		{"zero plate", LicensePlate{}, PlateStateValid, ErrInvalidLicensePlate},
+		{"empty state", plate, "", ErrInvalidPlateState},
+		{"invalid state", plate, PlateState("stolen"), ErrInvalidPlateState},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewPlateWithState(tt.plate, tt.state)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewPlateWithState() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.Plate != tt.plate || got.State != tt.state {
+				t.Errorf("NewPlateWithState() = %+v, want plate %v state %v", got, tt.plate, tt.state)
+			}
+			if got.IsActive() != (tt.state == PlateStateValid) {
+				t.Errorf("IsActive() = %v for state %v", got.IsActive(), tt.state)
+			}
+		})
+	}
+}
+
+func TestPlateWithState_JSON(t *testing.T) {
+	p, err := NewPlateWithState(MustParseLicensePlate("AAA-123-MC"), PlateStateExpired)
+	if err != nil {
+		t.Fatalf("NewPlateWithState() error = %v", err)
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"plate":"AAA-123-MC","state":"expired"}`
+	if string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	var decoded PlateWithState
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if decoded != p {
+		t.Errorf("Unmarshal() = %+v, want %+v", decoded, p)
+	}
+
+	invalid := []struct {
+		name    string
+		json    string
+		wantErr error
+	}{
+		{"invalid state", `{"plate":"AAA-123-MC","state":"stolen"}`, ErrInvalidPlateState},
+		{"missing state", `{"plate":"AAA-123-MC"}`, ErrInvalidPlateState},
+		{"missing plate", `{"state":"valid"}`, ErrInvalidLicensePlate},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			var p PlateWithState
+			if err := json.Unmarshal([]byte(tt.json), &p); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Unmarshal(%s) error = %v, want %v", tt.json, err, tt.wantErr)
+			}
+		})
+	}
+}
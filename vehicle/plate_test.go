@@ -87,6 +87,70 @@ func TestProvinceCode_ProvinceName(t *testing.T) {
 	}
 }
 
+func TestProvinceCode_IsUrban(t *testing.T) {
+	tests := []struct {
+		name         string
+		code         ProvinceCode
+		wantUrban    bool
+		wantProvince bool
+	}{
+		{"MC is urban", ProvinceCodeMaputoCity, true, false},
+		{"MP is provincial", ProvinceCodeMaputoProvince, false, true},
+		{"GZ is provincial", ProvinceCodeGaza, false, true},
+		{"NS is provincial", ProvinceCodeNiassa, false, true},
+		{"invalid is neither", ProvinceCode("XX"), false, false},
+		{"empty is neither", ProvinceCode(""), false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.code.IsUrban(); got != tt.wantUrban {
+				t.Errorf("IsUrban() = %v, want %v", got, tt.wantUrban)
+			}
+			if got := tt.code.IsProvince(); got != tt.wantProvince {
+				t.Errorf("IsProvince() = %v, want %v", got, tt.wantProvince)
+			}
+		})
+	}
+}
+
+func TestUrbanAndRuralProvinceCodes(t *testing.T) {
+	urban := UrbanProvinceCodes()
+	rural := RuralProvinceCodes()
+
+	seen := make(map[ProvinceCode]bool)
+	for _, code := range urban {
+		if !code.IsUrban() {
+			t.Errorf("UrbanProvinceCodes() contains non-urban code %s", code)
+		}
+		seen[code] = true
+	}
+	for _, code := range rural {
+		if !code.IsProvince() {
+			t.Errorf("RuralProvinceCodes() contains non-provincial code %s", code)
+		}
+		if seen[code] {
+			t.Errorf("code %s appears in both urban and rural lists", code)
+		}
+		seen[code] = true
+	}
+
+	if len(seen) != 11 || len(seen) != len(validProvinceCodes) {
+		t.Errorf("urban + rural codes = %d, want all %d codes", len(seen), len(validProvinceCodes))
+	}
+	for code := range validProvinceCodes {
+		if !seen[code] {
+			t.Errorf("code %s missing from urban and rural lists", code)
+		}
+	}
+
+	// Returned slices must be independent copies.
+	urban[0] = "XX"
+	if UrbanProvinceCodes()[0] != ProvinceCodeMaputoCity {
+		t.Error("UrbanProvinceCodes() returned shared slice")
+	}
+}
+
 func TestParseLicensePlate(t *testing.T) {
 	tests := []struct {
 		name    string